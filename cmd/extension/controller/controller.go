@@ -5,18 +5,23 @@
 package controller
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	glogger "github.com/gardener/gardener/pkg/logger"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/urfave/cli/v3"
 	"go.opentelemetry.io/collector/processor/batchprocessor"
 	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
@@ -26,6 +31,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/component-base/featuregate"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -42,24 +48,26 @@ const defaultExtensionName = "gardener-extension-otelcol"
 
 // flags stores the manager flags as provided from the command-line
 type flags struct {
-	extensionName             string
-	metricsBindAddr           string
-	healthProbeBindAddr       string
-	heartbeatRenewInterval    time.Duration
-	heartbeatNamespace        string
-	leaderElection            bool
-	leaderElectionID          string
-	leaderElectionNamespace   string
-	ignoreOperationAnnotation bool
-	maxConcurrentReconciles   int
-	reconciliationTimeout     time.Duration
-	kubeconfig                string
-	zapLogLevel               string
-	zapLogFormat              string
-	resyncInterval            time.Duration
-	pprofBindAddr             string
-	clientConnQPS             float32
-	clientConnBurst           int32
+	extensionName                  string
+	metricsBindAddr                string
+	healthProbeBindAddr            string
+	heartbeatRenewInterval         time.Duration
+	heartbeatNamespace             string
+	leaderElection                 bool
+	leaderElectionID               string
+	leaderElectionNamespace        string
+	ignoreOperationAnnotation      bool
+	maxConcurrentReconciles        int
+	otelcolMaxConcurrentReconciles int
+	reconciliationTimeout          time.Duration
+	kubeconfig                     string
+	zapLogLevel                    string
+	zapLogFormat                   string
+	resyncInterval                 time.Duration
+	pprofBindAddr                  string
+	pprofBearerTokenFile           string
+	clientConnQPS                  float32
+	clientConnBurst                int32
 
 	// Memory Limiter Processor flags
 	memLimiterCheckInterval        time.Duration
@@ -72,6 +80,79 @@ type flags struct {
 	batchProcessorTimeout      time.Duration
 	batchProcessorBatchSize    uint32
 	batchProcessorBatchMaxSize uint32
+	batchProcessorAutoTimeout  bool
+
+	// extensionClasses specifies the extension classes the controller is
+	// responsible for.
+	extensionClasses []string
+
+	// managedResourceFailureThreshold specifies the number of consecutive
+	// failures of the managed resource operations for a cluster, after
+	// which the extension is reported as degraded.
+	managedResourceFailureThreshold int
+
+	// managedResourceClass specifies the class of the ManagedResource for
+	// the collector resources in the seed cluster.
+	managedResourceClass string
+
+	// managedResourceHealthTimeout specifies the time to wait for the
+	// ManagedResource of the collector resources in the seed cluster to
+	// become healthy on reconciliation.
+	managedResourceHealthTimeout time.Duration
+
+	// imagePullSecret specifies the secret with the credentials for pulling
+	// the images of the collector and Target Allocator in the form of
+	// <namespace>/<name>.
+	imagePullSecret string
+
+	// defaultExporterEndpoint specifies the endpoint of the OTLP HTTP
+	// exporter, which is added to every collector.
+	defaultExporterEndpoint string
+
+	// defaultExporterSecret specifies the secret with the bearer token of
+	// the default exporter in the form of <namespace>/<name>.
+	defaultExporterSecret string
+
+	// allowInsecureSkipVerify specifies whether shoot owners may disable
+	// TLS certificate verification for the exporters.
+	allowInsecureSkipVerify bool
+
+	// requireExplicitCA specifies whether exporters over https must
+	// reference a CA instead of relying on the system roots.
+	requireExplicitCA bool
+
+	// allowAnonymousOTLPReceiver specifies whether shoot owners may
+	// configure the OTLP receiver without client authentication.
+	allowAnonymousOTLPReceiver bool
+
+	// allowHostMetricsReceiver specifies whether seed-class extensions may
+	// enable the host metrics receiver.
+	allowHostMetricsReceiver bool
+
+	// allowReferencedReceivers specifies whether referenced collector
+	// configurations may contain receivers.
+	allowReferencedReceivers bool
+
+	// allowReferencedExtensions specifies whether referenced collector
+	// configurations may contain extensions.
+	allowReferencedExtensions bool
+
+	// shootUIDLabel specifies whether the resources deployed into the seed
+	// are labeled with the UID of the shoot cluster.
+	shootUIDLabel bool
+
+	// targetAllocatorMTLS specifies whether the collector and the target
+	// allocator communicate via mTLS.
+	targetAllocatorMTLS bool
+
+	// targetAllocatorConfigAnnotation specifies whether the extension
+	// resources are annotated with a summary of the target allocator
+	// configuration.
+	targetAllocatorConfigAnnotation bool
+
+	// ownerReferences specifies whether the resources deployed into the
+	// seed are owned by the extension resources.
+	ownerReferences bool
 
 	// The following flags are meant to be specified by the Helm chart,
 	// which gardenlet will invoke during deployment. The value of each flag
@@ -92,6 +173,7 @@ func (f *flags) getManager(ctx context.Context) (ctrl.Manager, error) {
 		mgr.WithAddToScheme(clientgoscheme.AddToScheme),
 		mgr.WithAddToScheme(extensionscontroller.AddToScheme),
 		mgr.WithAddToScheme(resourcesv1alpha1.AddToScheme),
+		mgr.WithAddToScheme(monitoringv1.AddToScheme),
 		mgr.WithInstallScheme(configinstall.Install),
 		mgr.WithMetricsAddress(f.metricsBindAddr),
 		mgr.WithHealthProbeAddress(f.healthProbeBindAddr),
@@ -103,6 +185,7 @@ func (f *flags) getManager(ctx context.Context) (ctrl.Manager, error) {
 		mgr.WithHealthzCheck("healthz", healthz.Ping),
 		mgr.WithReadyzCheck("readyz", healthz.Ping),
 		mgr.WithPprofAddress(f.pprofBindAddr),
+		mgr.WithPprofBearerTokenFile(f.pprofBearerTokenFile),
 		mgr.WithConnectionConfiguration(&componentbaseconfigv1alpha1.ClientConnectionConfiguration{
 			QPS:   f.clientConnQPS,
 			Burst: f.clientConnBurst,
@@ -175,6 +258,12 @@ func New() *cli.Command {
 				Sources:     cli.EnvVars("PPROF_BIND_ADDRESS"),
 				Destination: &flags.pprofBindAddr,
 			},
+			&cli.StringFlag{
+				Name:        "pprof-bearer-token-file",
+				Usage:       "path to a file with a bearer token, which is required for accessing pprof",
+				Sources:     cli.EnvVars("PPROF_BEARER_TOKEN_FILE"),
+				Destination: &flags.pprofBearerTokenFile,
+			},
 			&cli.StringFlag{
 				Name:        "health-probe-bind-address",
 				Usage:       "the address the probe endpoint binds to",
@@ -231,6 +320,59 @@ func New() *cli.Command {
 				Sources:     cli.EnvVars("MAX_CONCURRENT_RECONCILES"),
 				Destination: &flags.maxConcurrentReconciles,
 			},
+			&cli.StringSliceFlag{
+				Name:        "extension-class",
+				Usage:       "extension class the controller is responsible for, either shoot or seed",
+				Value:       []string{string(extensionsv1alpha1.ExtensionClassShoot)},
+				Sources:     cli.EnvVars("EXTENSION_CLASSES"),
+				Destination: &flags.extensionClasses,
+			},
+			&cli.IntFlag{
+				Name:        "managed-resource-failure-threshold",
+				Usage:       "number of consecutive managed resource failures, after which the extension is reported as degraded",
+				Value:       actuator.DefaultManagedResourceFailureThreshold,
+				Sources:     cli.EnvVars("MANAGED_RESOURCE_FAILURE_THRESHOLD"),
+				Destination: &flags.managedResourceFailureThreshold,
+			},
+			&cli.StringFlag{
+				Name:        "managed-resource-class",
+				Usage:       "class of the managed resource for the collector resources in the seed cluster",
+				Value:       v1beta1constants.SeedResourceManagerClass,
+				Sources:     cli.EnvVars("MANAGED_RESOURCE_CLASS"),
+				Destination: &flags.managedResourceClass,
+			},
+			&cli.DurationFlag{
+				Name:        "managed-resource-health-timeout",
+				Usage:       "time to wait for the managed resource of the collector resources to become healthy on reconciliation, 0 disables the wait",
+				Value:       0,
+				Sources:     cli.EnvVars("MANAGED_RESOURCE_HEALTH_TIMEOUT"),
+				Destination: &flags.managedResourceHealthTimeout,
+			},
+			&cli.StringFlag{
+				Name:        "image-pull-secret",
+				Usage:       "secret with the image pull credentials for the collector and target allocator, specified as <namespace>/<name>",
+				Sources:     cli.EnvVars("IMAGE_PULL_SECRET"),
+				Destination: &flags.imagePullSecret,
+			},
+			&cli.StringFlag{
+				Name:        "default-exporter-endpoint",
+				Usage:       "endpoint of an otlp http exporter, which is added to every collector in addition to the configured exporters",
+				Sources:     cli.EnvVars("DEFAULT_EXPORTER_ENDPOINT"),
+				Destination: &flags.defaultExporterEndpoint,
+			},
+			&cli.StringFlag{
+				Name:        "default-exporter-secret",
+				Usage:       "secret with the bearer token of the default exporter in the `token' data key, specified as <namespace>/<name>",
+				Sources:     cli.EnvVars("DEFAULT_EXPORTER_SECRET"),
+				Destination: &flags.defaultExporterSecret,
+			},
+			&cli.IntFlag{
+				Name:        "otelcol-max-concurrent-reconciles",
+				Usage:       "max number of concurrent reconciliations of the otelcol controller, defaults to --max-concurrent-reconciles",
+				Value:       0,
+				Sources:     cli.EnvVars("OTELCOL_MAX_CONCURRENT_RECONCILES"),
+				Destination: &flags.otelcolMaxConcurrentReconciles,
+			},
 			&cli.DurationFlag{
 				Name:        "reconciliation-timeout",
 				Usage:       "reconcile timeout duration",
@@ -374,6 +516,83 @@ func New() *cli.Command {
 				Sources:     cli.EnvVars("BATCH_PROCESSOR_BATCH_MAX_SIZE"),
 				Destination: &flags.batchProcessorBatchMaxSize,
 			},
+			&cli.BoolFlag{
+				Name:        "batch-processor-auto-timeout",
+				Usage:       "raise the batch timeout to the shortest scrape interval of the collector",
+				Value:       false,
+				Sources:     cli.EnvVars("BATCH_PROCESSOR_AUTO_TIMEOUT"),
+				Destination: &flags.batchProcessorAutoTimeout,
+			},
+			&cli.BoolFlag{
+				Name:        "allow-insecure-skip-verify",
+				Usage:       "allow shoot owners to disable TLS certificate verification for exporters",
+				Value:       false,
+				Sources:     cli.EnvVars("ALLOW_INSECURE_SKIP_VERIFY"),
+				Destination: &flags.allowInsecureSkipVerify,
+			},
+			&cli.BoolFlag{
+				Name:        "require-explicit-ca",
+				Usage:       "require a CA for the OTLP HTTP exporter over https instead of relying on the system roots",
+				Value:       false,
+				Sources:     cli.EnvVars("REQUIRE_EXPLICIT_CA"),
+				Destination: &flags.requireExplicitCA,
+			},
+			&cli.BoolFlag{
+				Name:        "allow-anonymous-otlp-receiver",
+				Usage:       "allow shoot owners to configure the otlp receiver without client authentication",
+				Value:       false,
+				Sources:     cli.EnvVars("ALLOW_ANONYMOUS_OTLP_RECEIVER"),
+				Destination: &flags.allowAnonymousOTLPReceiver,
+			},
+			&cli.BoolFlag{
+				Name:        "allow-hostmetrics-receiver",
+				Usage:       "allow seed-class extensions to enable the hostmetrics receiver, which mounts the root filesystem of the seed nodes",
+				Value:       false,
+				Sources:     cli.EnvVars("ALLOW_HOSTMETRICS_RECEIVER"),
+				Destination: &flags.allowHostMetricsReceiver,
+			},
+			&cli.BoolFlag{
+				Name:        "allow-referenced-receivers",
+				Usage:       "allow the referenced collector configurations of shoot owners to contain receivers",
+				Value:       false,
+				Sources:     cli.EnvVars("ALLOW_REFERENCED_RECEIVERS"),
+				Destination: &flags.allowReferencedReceivers,
+			},
+			&cli.BoolFlag{
+				Name:        "allow-referenced-extensions",
+				Usage:       "allow the referenced collector configurations of shoot owners to contain extensions",
+				Value:       false,
+				Sources:     cli.EnvVars("ALLOW_REFERENCED_EXTENSIONS"),
+				Destination: &flags.allowReferencedExtensions,
+			},
+			&cli.BoolFlag{
+				Name:        "shoot-uid-label",
+				Usage:       "label the resources deployed into the seed with the uid of the shoot",
+				Value:       false,
+				Sources:     cli.EnvVars("SHOOT_UID_LABEL"),
+				Destination: &flags.shootUIDLabel,
+			},
+			&cli.BoolFlag{
+				Name:        "target-allocator-mtls",
+				Usage:       "use mtls between the collector and the target allocator, disable for development clusters only",
+				Value:       true,
+				Sources:     cli.EnvVars("TARGET_ALLOCATOR_MTLS"),
+				Destination: &flags.targetAllocatorMTLS,
+			},
+			&cli.BoolFlag{
+				Name:        "target-allocator-config-annotation",
+				Usage:       "annotate the extension resources with a summary of the target allocator configuration for troubleshooting",
+				Value:       false,
+				Sources:     cli.EnvVars("TARGET_ALLOCATOR_CONFIG_ANNOTATION"),
+				Destination: &flags.targetAllocatorConfigAnnotation,
+			},
+			&cli.BoolFlag{
+				Name:        "owner-references",
+				Usage:       "set owner references of the extension resources on the resources deployed into the seed",
+				Value:       false,
+				Sources:     cli.EnvVars("OWNER_REFERENCES"),
+				Destination: &flags.ownerReferences,
+			},
 		},
 		Before: func(ctx context.Context, c *cli.Command) (context.Context, error) {
 			ctrllog.SetLogger(glogger.MustNewZapLogger(flags.zapLogLevel, flags.zapLogFormat))
@@ -413,31 +632,78 @@ func runManager(ctx context.Context, cmd *cli.Command) error {
 		SendBatchMaxSize: flags.batchProcessorBatchMaxSize,
 	}
 
+	extensionClasses := make([]extensionsv1alpha1.ExtensionClass, 0, len(flags.extensionClasses))
+	for _, class := range flags.extensionClasses {
+		extensionClasses = append(extensionClasses, extensionsv1alpha1.ExtensionClass(class))
+	}
+
 	decoder := serializer.NewCodecFactory(m.GetScheme(), serializer.EnableStrict).UniversalDecoder()
-	act, err := actuator.New(
-		m.GetClient(),
+	actuatorOpts := []actuator.Option{
 		actuator.WithDecoder(decoder),
 		actuator.WithGardenerVersion(flags.gardenerVersion),
 		actuator.WithGardenletFeatures(flags.gardenletFeatureGates),
 		actuator.WithMemoryLimiterProcessorConfig(memLimiterConfig),
 		actuator.WithBatchProcessorConfig(batchProcessorConfig),
-	)
+		actuator.WithBatchTimeoutAutoTuning(flags.batchProcessorAutoTimeout),
+		actuator.WithAllowInsecureSkipVerify(flags.allowInsecureSkipVerify),
+		actuator.WithRequireExplicitCA(flags.requireExplicitCA),
+		actuator.WithAllowAnonymousOTLPReceiver(flags.allowAnonymousOTLPReceiver),
+		actuator.WithAllowHostMetricsReceiver(flags.allowHostMetricsReceiver),
+		actuator.WithAllowReferencedReceivers(flags.allowReferencedReceivers),
+		actuator.WithAllowReferencedExtensions(flags.allowReferencedExtensions),
+		actuator.WithShootUIDLabel(flags.shootUIDLabel),
+		actuator.WithTargetAllocatorMTLS(flags.targetAllocatorMTLS),
+		actuator.WithTargetAllocatorConfigAnnotation(flags.targetAllocatorConfigAnnotation),
+		actuator.WithOwnerReferences(flags.ownerReferences),
+		actuator.WithExtensionClasses(extensionClasses...),
+		actuator.WithManagedResourceFailureThreshold(flags.managedResourceFailureThreshold),
+		actuator.WithManagedResourceClass(flags.managedResourceClass),
+		actuator.WithManagedResourceHealthTimeout(flags.managedResourceHealthTimeout),
+	}
+
+	if flags.imagePullSecret != "" {
+		namespace, name, ok := strings.Cut(flags.imagePullSecret, "/")
+		if !ok {
+			return fmt.Errorf("invalid image pull secret specified: %q", flags.imagePullSecret)
+		}
+		key := client.ObjectKey{Namespace: namespace, Name: name}
+		actuatorOpts = append(actuatorOpts, actuator.WithImagePullSecret(key))
+	}
+
+	if flags.defaultExporterEndpoint != "" {
+		actuatorOpts = append(actuatorOpts, actuator.WithDefaultExporter(flags.defaultExporterEndpoint))
+	}
+
+	if flags.defaultExporterSecret != "" {
+		namespace, name, ok := strings.Cut(flags.defaultExporterSecret, "/")
+		if !ok {
+			return fmt.Errorf("invalid default exporter secret specified: %q", flags.defaultExporterSecret)
+		}
+		key := client.ObjectKey{Namespace: namespace, Name: name}
+		actuatorOpts = append(actuatorOpts, actuator.WithDefaultExporterSecret(key))
+	}
+
+	act, err := actuator.New(m.GetClient(), actuatorOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to create actuator: %w", err)
 	}
 
 	logger.Info("creating controllers")
-	c, err := controller.New(
+	controllerOpts := []controller.Option{
 		controller.WithActuator(act),
 		controller.WithName(act.Name()),
 		controller.WithExtensionType(act.ExtensionType()),
 		controller.WithFinalizerSuffix(act.FinalizerSuffix()),
-		controller.WithExtensionClass(act.ExtensionClass()),
 		controller.WithIgnoreOperationAnnotation(flags.ignoreOperationAnnotation),
 		controller.WithResyncInterval(flags.resyncInterval),
-		controller.WithMaxConcurrentReconciles(flags.maxConcurrentReconciles),
+		controller.WithMaxConcurrentReconciles(cmp.Or(flags.otelcolMaxConcurrentReconciles, flags.maxConcurrentReconciles)),
 		controller.WithReconciliationTimeout(flags.reconciliationTimeout),
-	)
+	}
+	for _, class := range act.ExtensionClasses() {
+		controllerOpts = append(controllerOpts, controller.WithExtensionClass(class))
+	}
+
+	c, err := controller.New(controllerOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to create a controller: %w", err)
 	}
@@ -12,6 +12,7 @@ import (
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	controllercmd "github.com/gardener/gardener-extension-otelcol/cmd/extension/controller"
+	rendercmd "github.com/gardener/gardener-extension-otelcol/cmd/extension/render"
 	webhookcmd "github.com/gardener/gardener-extension-otelcol/cmd/extension/webhook"
 	"github.com/gardener/gardener-extension-otelcol/pkg/version"
 )
@@ -25,6 +26,7 @@ func main() {
 		Commands: []*cli.Command{
 			controllercmd.New(),
 			webhookcmd.New(),
+			rendercmd.New(),
 		},
 	}
 
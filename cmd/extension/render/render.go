@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/andybalholm/brotli"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/urfave/cli/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	configinstall "github.com/gardener/gardener-extension-otelcol/pkg/apis/config/install"
+)
+
+// flags stores the render flags as provided from the command-line
+type flags struct {
+	configFile          string
+	clusterName         string
+	targetAllocatorMTLS bool
+}
+
+// New creates a new [cli.Command] for rendering the resources of the
+// extension.
+func New() *cli.Command {
+	flags := flags{}
+
+	cmd := &cli.Command{
+		Name:  "render",
+		Usage: "render the resources of the extension for a provider config without a cluster",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "config",
+				Usage:       "path to the provider config file",
+				Required:    true,
+				Destination: &flags.configFile,
+			},
+			&cli.StringFlag{
+				Name:        "cluster-name",
+				Usage:       "name of the cluster, which is the namespace of the resources",
+				Value:       "shoot--local--local",
+				Destination: &flags.clusterName,
+			},
+			&cli.BoolFlag{
+				Name:        "target-allocator-mtls",
+				Usage:       "use mtls between the collector and the target allocator",
+				Value:       true,
+				Destination: &flags.targetAllocatorMTLS,
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			return runRender(c.Writer, flags)
+		},
+	}
+
+	return cmd
+}
+
+// runRender renders the resources of the extension for the provider config
+// specified by the given flags and writes them as YAML to the given writer.
+func runRender(w io.Writer, flags flags) error {
+	data, err := os.ReadFile(flags.configFile)
+	if err != nil {
+		return fmt.Errorf("failed to read provider config: %w", err)
+	}
+
+	scheme := runtime.NewScheme()
+	configinstall.Install(scheme)
+	decoder := serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder()
+
+	var cfg config.CollectorConfig
+	if err := runtime.DecodeInto(decoder, data, &cfg); err != nil {
+		return fmt.Errorf("invalid provider spec configuration: %w", err)
+	}
+
+	// The policies of the operator are not known when rendering, hence
+	// anonymous clients of the OTLP receiver are accepted.
+	act, err := actuator.New(
+		fake.NewClientBuilder().Build(),
+		actuator.WithDecoder(decoder),
+		actuator.WithAllowAnonymousOTLPReceiver(true),
+		actuator.WithTargetAllocatorMTLS(flags.targetAllocatorMTLS),
+	)
+	if err != nil {
+		return err
+	}
+
+	seedObjects, shootObjects, err := act.RenderResources(flags.clusterName, cfg)
+	if err != nil {
+		return err
+	}
+
+	seedRegistry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+	if err := writeObjects(w, seedRegistry, seedObjects); err != nil {
+		return err
+	}
+
+	shootRegistry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+
+	return writeObjects(w, shootRegistry, shootObjects)
+}
+
+// writeObjects serializes the given objects via the given registry and writes
+// them as a multi-document YAML to the given writer.
+func writeObjects(w io.Writer, registry *managedresources.Registry, objects []client.Object) error {
+	data, err := registry.AddAllAndSerialize(objects...)
+	if err != nil {
+		return err
+	}
+
+	// The registry concatenates and compresses the objects in the same way
+	// as for the data of a managed resource.
+	if _, err := io.WriteString(w, "---\n"); err != nil {
+		return err
+	}
+
+	r := brotli.NewReader(bytes.NewReader(data[resourcesv1alpha1.CompressedDataKey]))
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("failed to decompress objects: %w", err)
+	}
+
+	return nil
+}
@@ -52,6 +52,7 @@ type flags struct {
 	zapLogLevel                 string
 	zapLogFormat                string
 	pprofBindAddr               string
+	pprofBearerTokenFile        string
 	clientConnQPS               float32
 	clientConnBurst             int32
 	webhookServerHost           string
@@ -80,6 +81,7 @@ func (f *flags) getLogger() logr.Logger {
 // getManager creates a new [ctrl.Manager] based on the parsed [flags].
 func (f *flags) getManager(ctx context.Context) (ctrl.Manager, error) {
 	logger := f.getLogger()
+
 	webhookOpts := webhook.Options{
 		Host:     f.webhookServerHost,
 		Port:     f.webhookServerPort,
@@ -144,6 +146,7 @@ func (f *flags) getManager(ctx context.Context) (ctrl.Manager, error) {
 		mgr.WithHealthzCheck("healthz", healthz.Ping),
 		mgr.WithReadyzCheck("readyz", healthz.Ping),
 		mgr.WithPprofAddress(f.pprofBindAddr),
+		mgr.WithPprofBearerTokenFile(f.pprofBearerTokenFile),
 		mgr.WithConnectionConfiguration(&componentbaseconfigv1alpha1.ClientConnectionConfiguration{
 			QPS:   f.clientConnQPS,
 			Burst: f.clientConnBurst,
@@ -237,6 +240,12 @@ func New() *cli.Command {
 				Sources:     cli.EnvVars("PPROF_BIND_ADDRESS"),
 				Destination: &flags.pprofBindAddr,
 			},
+			&cli.StringFlag{
+				Name:        "pprof-bearer-token-file",
+				Usage:       "path to a file with a bearer token, which is required for accessing pprof",
+				Sources:     cli.EnvVars("PPROF_BEARER_TOKEN_FILE"),
+				Destination: &flags.pprofBearerTokenFile,
+			},
 			&cli.StringFlag{
 				Name:        "health-probe-bind-address",
 				Usage:       "the address the probe endpoint binds to",
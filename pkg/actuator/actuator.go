@@ -7,10 +7,14 @@
 package actuator
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
+	"net"
+	"net/url"
 	"path/filepath"
 	"slices"
 	"strconv"
@@ -25,6 +29,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	gardenerfeatures "github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -36,17 +41,23 @@ import (
 	otelv1alpha1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1alpha1"
 	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
 	"go.opentelemetry.io/collector/processor/batchprocessor"
 	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
 	"go.yaml.in/yaml/v4"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -55,12 +66,78 @@ import (
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/validation"
 	"github.com/gardener/gardener-extension-otelcol/pkg/imagevector"
+	"github.com/gardener/gardener-extension-otelcol/pkg/metrics"
 )
 
 // ErrInvalidActuator is an error which is returned when creating an [Actuator]
 // with invalid config settings.
 var ErrInvalidActuator = errors.New("invalid actuator")
 
+// ErrInsecureSkipVerifyNotAllowed is an error which is returned when the
+// provider config disables TLS certificate verification, but the operator has
+// not allowed it.
+var ErrInsecureSkipVerifyNotAllowed = errors.New("insecureSkipVerify is not allowed by the extension policy")
+
+// ErrExplicitCARequired is an error which is returned when the provider config
+// specifies an exporter over https without a CA, but the operator requires an
+// explicit CA.
+var ErrExplicitCARequired = errors.New("explicit CA is required by the extension policy")
+
+// ErrAnonymousOTLPReceiverNotAllowed is an error which is returned when the
+// provider config does not require the clients of the OTLP receiver to
+// authenticate, but the operator has not allowed anonymous clients.
+var ErrAnonymousOTLPReceiverNotAllowed = errors.New("anonymous OTLP receiver is not allowed by the extension policy")
+
+// ErrHostMetricsReceiverNotAllowed is an error which is returned when the
+// provider config enables the host metrics receiver, but the operator has not
+// allowed it or the extension is not a seed-class extension.
+var ErrHostMetricsReceiverNotAllowed = errors.New("hostmetrics receiver is not allowed by the extension policy")
+
+// ErrReferencedComponentNotAllowed is an error which is returned when the
+// referenced collector configuration contains a component, which the operator
+// has not allowed.
+var ErrReferencedComponentNotAllowed = errors.New("referenced component is not allowed by the extension policy")
+
+// ErrMissingDataKey is an error which is returned when the provider config
+// references a data key, which does not exist in the referenced secret.
+var ErrMissingDataKey = errors.New("data key does not exist in the referenced secret")
+
+// ErrInvalidHtpasswd is an error which is returned when the provider config
+// references an htpasswd file for the basic authentication of the clients,
+// which contains no valid credentials.
+var ErrInvalidHtpasswd = errors.New("invalid htpasswd file in the referenced secret")
+
+// ErrManagedResourceObjectTooLarge is an error which is returned when a single
+// object of the seed ManagedResource exceeds the maximum size of a Secret.
+var ErrManagedResourceObjectTooLarge = errors.New("object exceeds the maximum size of a managed resource secret")
+
+// LabelShootUID is the label, which specifies the UID of the shoot cluster a
+// resource managed by the extension belongs to.
+const LabelShootUID = "otelcol.extensions.gardener.cloud/shoot-uid"
+
+// AnnotationConfigHash is the annotation of an extension resource, which
+// specifies the hash of the resources applied during the last successful
+// reconciliation. Reconciliations with an unchanged hash skip applying the
+// managed resources.
+const AnnotationConfigHash = "otelcol.extensions.gardener.cloud/config-hash"
+
+// AnnotationTargetAllocatorConfig is the annotation of an extension resource,
+// which specifies a summary of the configuration of the Target Allocator
+// generated during the last successful reconciliation, e.g. the allocation
+// strategy and the selectors. The annotation is set only, if enabled via
+// [WithTargetAllocatorConfigAnnotation].
+const AnnotationTargetAllocatorConfig = "otelcol.extensions.gardener.cloud/target-allocator-config"
+
+// AnnotationChecksumSecretReferences is the annotation of the collector pods,
+// which specifies the checksum of the referenced secrets of the shoot. Changes
+// of the checksum roll out the collector pods.
+const AnnotationChecksumSecretReferences = "otelcol.extensions.gardener.cloud/checksum-secret-references"
+
+// AnnotationDisableDefaultExporter is the annotation of a shoot cluster, which
+// opts the shoot out of the default exporter configured by the operator, when
+// set to `true'.
+const AnnotationDisableDefaultExporter = "otelcol.extensions.gardener.cloud/disable-default-exporter"
+
 const (
 	// Name is the name of the actuator
 	Name = "otelcol"
@@ -85,14 +162,111 @@ const (
 	// exposes it's internal metrics.
 	otelCollectorMetricsPort = 8888
 	// otelCollectorReplicas specifies the number of replicas of the OTel
-	// Collector.
+	// Collector. The number is not configurable, since the Target
+	// Allocator has no collectors to assign the scrape targets to without
+	// replicas. Multiple replicas are supported by the consistent-hashing
+	// allocation strategy of the Target Allocator.
 	otelCollectorReplicas int32 = 1
 	// otelCollectorServiceAccountName is the name of the service account
 	// for the OTel Collector.
 	otelCollectorServiceAccountName = otelCollectorName + "-collector"
+	// otelCollectorServiceName is the name of the service created by the
+	// OTel Operator for the OTel Collector.
+	otelCollectorServiceName = otelCollectorName + "-collector"
+
+	// imagePullSecretName is the name of the image pull secret, which is
+	// copied into the namespace of the cluster.
+	imagePullSecretName = baseResourceName + "-image-pull-secret" // #nosec: G101
+
+	// defaultExporterName is the name of the exporter, which forwards the
+	// signals to the default backend configured by the operator.
+	defaultExporterName = config.ExporterNameOTLPHTTP + "/default"
+	// defaultExporterSecretName is the name of the secret with the bearer
+	// token of the default exporter, which is copied into the namespace of
+	// the cluster.
+	defaultExporterSecretName = baseResourceName + "-default-exporter" // #nosec: G101
+	// defaultExporterSecretDataKey is the data key of the bearer token in
+	// the secret of the default exporter.
+	defaultExporterSecretDataKey = "token"
+
+	// selfScrapeInterval is the scrape interval of the self-monitoring
+	// scrape jobs of the collector and the Target Allocator.
+	selfScrapeInterval = 15 * time.Second
+	// targetAllocatorScrapeInterval is the default scrape interval of the
+	// scrape jobs discovered by the Target Allocator.
+	targetAllocatorScrapeInterval = 30 * time.Second
+	// defaultScrapeInterval is the default scrape interval of Prometheus,
+	// which applies to the additional scrape jobs without an interval.
+	defaultScrapeInterval = time.Minute
+	// batchTimeoutWarningRatio is the ratio between the shortest scrape
+	// interval and the timeout of the Batch processor, above which a
+	// warning about partial batches is logged.
+	batchTimeoutWarningRatio = 4
+
+	// sendingQueueBatchFlushTimeout is the flush timeout of the batches in
+	// the sending queue of the exporters, which split the requests above
+	// a maximum size. It is never reached, since there is no minimum size
+	// of the batches, but it is required by the exporters.
+	sendingQueueBatchFlushTimeout = 200 * time.Millisecond
+
+	// referencedSecretRequeueInterval is the interval after which the
+	// reconciliation is requeued, when a referenced secret has not been
+	// copied into the namespace of the cluster yet.
+	referencedSecretRequeueInterval = 10 * time.Second
+
+	// managedResourceHealthRequeueInterval is the interval after which the
+	// reconciliation is requeued, when the managed resource of the seed did
+	// not become healthy within the configured timeout.
+	managedResourceHealthRequeueInterval = 30 * time.Second
+
+	// otelCollectorGatewayName is the name of the
+	// [otelv1beta1.OpenTelemetryCollector] resource of the OTLP gateway,
+	// which forwards the received data to the OTel Collector.
+	otelCollectorGatewayName = baseResourceName + "-gateway"
+	// gatewayExporterName is the name of the exporter of the OTLP gateway,
+	// which forwards the received data to the OTel Collector.
+	gatewayExporterName = config.ExporterNameOTLPGRPC + "/processing"
 	// otelCollectorGRPCReceiverPort is the port on which the OTel collector
 	// binds the gRPC receiver.
 	otelCollectorGRPCReceiverPort = 4317
+	// otlpReceiverNetworkPolicyName is the name of the NetworkPolicy, which
+	// allows the traffic from the allowed clients to the OTLP receiver. The
+	// NetworkPolicies, which allow the egress traffic of the clients, are
+	// suffixed with the index of the client.
+	otlpReceiverNetworkPolicyName = baseResourceName + "-otlp-receiver"
+
+	// otelCollectorHostMetricsName is the name of the
+	// [otelv1beta1.OpenTelemetryCollector] resource, which runs the host
+	// metrics receiver on every node of the seed cluster.
+	otelCollectorHostMetricsName = baseResourceName + "-hostmetrics"
+	// hostMetricsReceiverName is the name of the internal OTLP receiver of
+	// the OTel Collector, which receives the host metrics.
+	hostMetricsReceiverName = "otlp/hostmetrics"
+	// hostMetricsExporterName is the name of the exporter of the host
+	// metrics collector, which forwards the metrics to the OTel Collector.
+	hostMetricsExporterName = config.ExporterNameOTLPGRPC + "/processing"
+	// otelCollectorHostMetricsReceiverPort is the port on which the OTel
+	// collector binds the internal OTLP receiver for the host metrics.
+	otelCollectorHostMetricsReceiverPort = 4319
+	// hostMetricsVolumeName is the name of the volume with the root
+	// filesystem of the node.
+	hostMetricsVolumeName = "hostfs"
+	// hostMetricsVolumeMountPath is the path, where the root filesystem of
+	// the node is mounted into the host metrics collector.
+	hostMetricsVolumeMountPath = "/hostfs"
+	// otelCollectorHealthCheckPort is the port on which the health_check
+	// extension of the OTel collector binds. The OTel Operator derives the
+	// probes of the collector container from it.
+	otelCollectorHealthCheckPort = 13133
+	// otelCollectorPreStopSleepSeconds is the number of seconds the
+	// collector pods keep running after being marked as terminating, so that
+	// they are removed from the endpoints of the services, before the
+	// collector is shut down.
+	otelCollectorPreStopSleepSeconds = 5
+	// otelCollectorTerminationGracePeriodSeconds is the default number of
+	// seconds the collector pods are given to terminate, which includes
+	// draining the sending queues of the exporters on shutdown.
+	otelCollectorTerminationGracePeriodSeconds int64 = 60
 
 	// secretsManagerIdentity is the identity used for secrets management.
 	secretsManagerIdentity = "gardener-extension-" + Name
@@ -112,26 +286,58 @@ const (
 	// targetAllocatorHTTPSPort is the port on which Target Allocator's
 	// HTTPS service listens to.
 	targetAllocatorHTTPSPort = 8443
+	// targetAllocatorMetricsServiceName is the name of the Kubernetes
+	// service, which exposes the metrics of the Target Allocator.
+	targetAllocatorMetricsServiceName = baseResourceName + "-targetallocator-metrics"
+	// targetAllocatorMetricsPort is the port on which the Target Allocator
+	// exposes its metrics.
+	targetAllocatorMetricsPort = 8080
 	// targetAllocatorServiceAccountName is the name of the service account
 	// for the Target Allocator.
 	targetAllocatorServiceAccountName = baseResourceName + "-targetallocator"
 	// targetAllocatorReplicas specifies the number of replicas of the Target Allocator.
 	targetAllocatorReplicas int32 = 1
+	// targetAllocatorRevisionHistoryLimit specifies the default number of
+	// old ReplicaSets of the Target Allocator deployment, which are
+	// retained.
+	targetAllocatorRevisionHistoryLimit int32 = 2
 	// targetAllocatorRoleName is the name of the Role and RoleBinding
 	// resource for the Target Allocator.
 	targetAllocatorRoleName = baseResourceName + "-targetallocator"
 	// targetAllocatorConfigMapName is the name of the ConfigMap for the
 	// Target Allocator.
 	targetAllocatorConfigMapName = baseResourceName + "-targetallocator-config"
+	// targetAllocatorServiceName is the name of the Kubernetes service of
+	// the Target Allocator managed by the OTel Operator, which is used
+	// when mTLS between the Collector and Target Allocator is disabled.
+	targetAllocatorServiceName = otelCollectorName + "-targetallocator"
+	// targetAllocatorHTTPPort is the port of the service of the Target
+	// Allocator managed by the OTel Operator.
+	targetAllocatorHTTPPort = 80
+	// targetAllocatorContainerPort is the port on which the Target
+	// Allocator managed by the OTel Operator serves the scrape targets and
+	// its metrics.
+	targetAllocatorContainerPort = 8080
 
 	// transformEventsProcessorName is the name of the transform processor for
 	// the k8sobjects/events pipeline.
 	transformEventsProcessorName = "transform/events"
+	// transformMetricNamePrefixProcessorName is the name of the transform
+	// processor, which prepends the configured prefix to the metric names.
+	transformMetricNamePrefixProcessorName = "transform/metric_name_prefix"
+	// metricsTransformProcessorName is the name of the metricstransform
+	// processor, which renames and aggregates the metrics.
+	metricsTransformProcessorName = "metricstransform"
 
 	// shootAccessSecretName is the name of the shoot access secret used by the
 	// k8sobjects/events receiver to authenticate to the shoot cluster.
 	shootAccessSecretName = "shoot-access-" + otelCollectorName // #nosec: G101
 
+	// seedEventsClusterRoleName is the name of the ClusterRole and
+	// ClusterRoleBinding, which grant the OTel Collector of a seed-class
+	// extension access to the events of the seed cluster.
+	seedEventsClusterRoleName = "gardener-extension-" + Name + "-events"
+
 	// shootManagedResourceName is the name of the ManagedResource that deploys
 	// RBAC into the shoot cluster for the k8sobjects/events receiver.
 	shootManagedResourceName = baseResourceName + "-shoot"
@@ -145,6 +351,19 @@ const (
 	httpExporterBearerTokenAuthName = baseBearerTokenAuthName + "/exporter-otlp-http"
 	grpcExporterBearerTokenAuthName = baseBearerTokenAuthName + "/exporter-otlp-grpc"
 
+	// Authentication settings of the default exporter.
+	defaultExporterBearerTokenAuthName        = baseBearerTokenAuthName + "/exporter-default"
+	defaultExporterVolumeNameBearerToken      = "bearer-token-auth-exporter-default" // #nosec: G101
+	defaultExporterVolumeMountPathBearerToken = "/etc/auth/bearer-exporter-default"  // #nosec: G101
+
+	// Authentication settings of the OTLP receiver.
+	receiverBearerTokenAuthName           = baseBearerTokenAuthName + "/receiver-otlp"
+	receiverVolumeNameBearerToken         = "bearer-token-auth-receiver-otlp" // #nosec: G101
+	receiverVolumeMountPathBearerToken    = "/etc/auth/bearer-receiver-otlp"  // #nosec: G101
+	receiverBasicAuthName                 = "basicauth/receiver-otlp"
+	receiverVolumeNameBasicAuthHtpasswd   = "basic-auth-receiver-otlp"
+	receiverVolumeMountPathBasicAuthFiles = "/etc/auth/basic-receiver-otlp"
+
 	// TLS volume names for the exporters.
 	baseVolumeNameTLS         = "tls"
 	httpExporterVolumeNameTLS = baseVolumeNameTLS + "-exporter-otlp-http"
@@ -156,20 +375,33 @@ const (
 	grpcExporterVolumeMountPathTLS = baseVolumeMountPathTLS + "-exporter-otlp-grpc"
 
 	// batchProcessorName is the name of the OpenTelemetry Batch processor.
-	batchProcessorName = "batch"
+	batchProcessorName = config.ProcessorNameBatch
 
 	// memoryLimiterProcessorName is the name of the OpenTelemetry Memory
 	// Limiter processor name.
-	memoryLimiterProcessorName = "memory_limiter"
+	memoryLimiterProcessorName = config.ProcessorNameMemoryLimiter
 
 	// resourceProcessorName is the name of the OpenTelemetry Resource processor.
 	resourceProcessorName = "resource"
 
+	// healthCheckExtensionName is the name of the OpenTelemetry Health
+	// Check extension.
+	healthCheckExtensionName = "health_check"
+
+	// nativeHistogramsFeatureGate is the feature gate of the collector,
+	// which enables the ingestion of native histograms by the Prometheus
+	// receiver.
+	nativeHistogramsFeatureGate = "receiver.prometheusreceiver.EnableNativeHistograms"
+
 	// labelKeyComponent is the standard kubernetes app component label key.
 	labelKeyComponent = "app.kubernetes.io/component"
 	// labelValueTargetAllocator is the component label value identifying the
 	// Target Allocator workload.
 	labelValueTargetAllocator = "opentelemetry-targetallocator"
+	// labelKeyTargetAllocator is the label of the Collector, which
+	// references the TargetAllocator custom resource managed by the OTel
+	// Operator.
+	labelKeyTargetAllocator = "opentelemetry.io/target-allocator"
 
 	// keys used in OTel/Target Allocator config maps.
 	configKeyEnabled    = "enabled"
@@ -209,6 +441,88 @@ type Actuator struct {
 	// https://github.com/gardener/gardener/blob/d5071c800378616eb6bb2c7662b4b28f4cfe7406/pkg/gardenlet/controller/controllerinstallation/controllerinstallation/reconciler.go#L236-L263
 	gardenerVersion       string
 	gardenletFeatureGates map[featuregate.Feature]bool
+
+	// allowInsecureSkipVerify specifies whether shoot owners are allowed
+	// to disable TLS certificate verification for the exporters.
+	allowInsecureSkipVerify bool
+
+	// requireExplicitCA specifies whether exporters over https must
+	// reference a CA instead of relying on the system roots.
+	requireExplicitCA bool
+
+	// allowAnonymousOTLPReceiver specifies whether shoot owners are allowed
+	// to configure the OTLP receiver without client authentication.
+	allowAnonymousOTLPReceiver bool
+
+	// allowHostMetricsReceiver specifies whether seed-class extensions are
+	// allowed to enable the host metrics receiver, which mounts the root
+	// filesystem of the seed nodes.
+	allowHostMetricsReceiver bool
+
+	// allowReferencedReceivers specifies whether the referenced collector
+	// configurations of shoot owners may contain receivers.
+	allowReferencedReceivers bool
+
+	// allowReferencedExtensions specifies whether the referenced collector
+	// configurations of shoot owners may contain extensions.
+	allowReferencedExtensions bool
+
+	// shootUIDLabel specifies whether the resources deployed into the seed
+	// cluster are labeled with the UID of the shoot cluster.
+	shootUIDLabel bool
+
+	// targetAllocatorMTLS specifies whether the Collector and the Target
+	// Allocator communicate via mTLS. When disabled, the Target Allocator
+	// is managed by the OTel Operator and serves plain HTTP.
+	targetAllocatorMTLS bool
+
+	// targetAllocatorConfigAnnotation specifies whether the extension
+	// resource is annotated with a summary of the configuration of the
+	// Target Allocator.
+	targetAllocatorConfigAnnotation bool
+
+	// ownerReferences specifies whether the resources deployed into the
+	// seed cluster are owned by the extension resource.
+	ownerReferences bool
+
+	// batchTimeoutAutoTuning specifies whether the timeout of the Batch
+	// processor of the collector is raised to the shortest scrape interval
+	// of the Prometheus receiver.
+	batchTimeoutAutoTuning bool
+
+	// extensionClasses specifies the extension classes the actuator is
+	// responsible for.
+	extensionClasses []extensionsv1alpha1.ExtensionClass
+
+	// managedResourceFailureThreshold specifies the number of consecutive
+	// failures of the managed resource operations for a cluster, after
+	// which the extension is reported as degraded.
+	managedResourceFailureThreshold int
+
+	// mrBackoff tracks the consecutive failures of the managed resource
+	// operations per cluster.
+	mrBackoff *managedResourceBackoff
+
+	// managedResourceClass specifies the class of the ManagedResource,
+	// which deploys the collector resources into the seed cluster.
+	managedResourceClass string
+
+	// managedResourceHealthTimeout specifies the time to wait for the
+	// ManagedResource of the seed to become healthy on reconciliation. The
+	// reconciliation does not wait, if zero.
+	managedResourceHealthTimeout time.Duration
+
+	// imagePullSecret specifies the secret with the credentials for
+	// pulling the images of the collector and Target Allocator.
+	imagePullSecret client.ObjectKey
+
+	// defaultExporterEndpoint specifies the endpoint of the OTLP HTTP
+	// exporter, which is added to every collector by the operator.
+	defaultExporterEndpoint string
+
+	// defaultExporterSecret specifies the secret with the bearer token of
+	// the default exporter.
+	defaultExporterSecret client.ObjectKey
 }
 
 var _ extension.Actuator = &Actuator{}
@@ -223,8 +537,12 @@ func New(c client.Client, opts ...Option) (*Actuator, error) {
 	}
 
 	act := &Actuator{
-		client:                c,
-		gardenletFeatureGates: make(map[featuregate.Feature]bool),
+		client:                          c,
+		gardenletFeatureGates:           make(map[featuregate.Feature]bool),
+		extensionClasses:                []extensionsv1alpha1.ExtensionClass{extensionsv1alpha1.ExtensionClassShoot},
+		managedResourceFailureThreshold: DefaultManagedResourceFailureThreshold,
+		managedResourceClass:            v1beta1constants.SeedResourceManagerClass,
+		targetAllocatorMTLS:             true,
 		memoryLimiterConfig: &memorylimiterprocessor.Config{
 			CheckInterval:         time.Second,
 			MemoryLimitPercentage: 75,
@@ -250,6 +568,8 @@ func New(c client.Client, opts ...Option) (*Actuator, error) {
 		act.decoder = serializer.NewCodecFactory(c.Scheme(), serializer.EnableStrict).UniversalDecoder()
 	}
 
+	act.mrBackoff = newManagedResourceBackoff(act.managedResourceFailureThreshold)
+
 	return act, nil
 }
 
@@ -329,6 +649,312 @@ func WithBatchProcessorConfig(cfg *batchprocessor.Config) Option {
 	return opt
 }
 
+// WithBatchTimeoutAutoTuning is an [Option], which configures the [Actuator]
+// whether to raise the timeout of the Batch processor of the collector to the
+// shortest scrape interval of the Prometheus receiver, so that a batch contains
+// the samples of a full scrape of the targets. The auto-tuning is disabled by
+// default.
+func WithBatchTimeoutAutoTuning(enabled bool) Option {
+	opt := func(a *Actuator) error {
+		a.batchTimeoutAutoTuning = enabled
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithAllowInsecureSkipVerify is an [Option], which configures the [Actuator]
+// whether to accept provider configs, which disable TLS certificate
+// verification for the exporters. By default such configs are rejected.
+func WithAllowInsecureSkipVerify(allow bool) Option {
+	opt := func(a *Actuator) error {
+		a.allowInsecureSkipVerify = allow
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithRequireExplicitCA is an [Option], which configures the [Actuator]
+// whether to reject provider configs, which specify an OTLP HTTP exporter over
+// https without a CA. By default the system roots are used in such cases.
+func WithRequireExplicitCA(require bool) Option {
+	opt := func(a *Actuator) error {
+		a.requireExplicitCA = require
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithAllowAnonymousOTLPReceiver is an [Option], which configures the
+// [Actuator] whether to accept provider configs, which do not require the
+// clients of the OTLP receiver to authenticate. By default such configs are
+// rejected.
+func WithAllowAnonymousOTLPReceiver(allow bool) Option {
+	opt := func(a *Actuator) error {
+		a.allowAnonymousOTLPReceiver = allow
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithAllowHostMetricsReceiver is an [Option], which configures the [Actuator]
+// whether to accept provider configs of seed-class extensions, which enable the
+// host metrics receiver. The receiver runs on every node of the seed cluster
+// and mounts the root filesystem of the node, hence such configs are rejected
+// by default. The namespace of the extension must enforce the privileged level
+// of the Pod Security Admission for the hostPath volume.
+//
+// Shoot-class extensions cannot enable the receiver regardless of this option,
+// since the metrics of the seed nodes belong to the control planes of all
+// shoots on the seed.
+func WithAllowHostMetricsReceiver(allow bool) Option {
+	opt := func(a *Actuator) error {
+		a.allowHostMetricsReceiver = allow
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithAllowReferencedReceivers is an [Option], which configures the [Actuator]
+// whether to accept referenced collector configurations, which contain
+// receivers. Such receivers open additional endpoints in the seed cluster,
+// hence they are rejected by default. Only the types of
+// [referencedComponents] are supported either way.
+func WithAllowReferencedReceivers(allow bool) Option {
+	opt := func(a *Actuator) error {
+		a.allowReferencedReceivers = allow
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithAllowReferencedExtensions is an [Option], which configures the
+// [Actuator] whether to accept referenced collector configurations, which
+// contain extensions. Such extensions run alongside the extensions managed by
+// the extension, hence they are rejected by default. Only the types of
+// [referencedComponents] are supported either way.
+func WithAllowReferencedExtensions(allow bool) Option {
+	opt := func(a *Actuator) error {
+		a.allowReferencedExtensions = allow
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithShootUIDLabel is an [Option], which configures the [Actuator] whether to
+// label the resources deployed into the seed cluster with the UID of the shoot
+// cluster via the [LabelShootUID] label.
+func WithShootUIDLabel(enabled bool) Option {
+	opt := func(a *Actuator) error {
+		a.shootUIDLabel = enabled
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithTargetAllocatorMTLS is an [Option], which configures the [Actuator]
+// whether the Collector and the Target Allocator communicate via mTLS, which
+// is enabled by default.
+//
+// When disabled, the upstream TargetAllocator custom resource is used instead
+// of the Target Allocator managed by the extension, and the Collector fetches
+// its scrape targets over plain HTTP. This is meant for local and development
+// clusters only, because the Target Allocator does not deliver the secrets of
+// the scrape targets over HTTP, i.e. scraping targets, which require
+// authentication, fails.
+func WithTargetAllocatorMTLS(enabled bool) Option {
+	opt := func(a *Actuator) error {
+		a.targetAllocatorMTLS = enabled
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithTargetAllocatorConfigAnnotation is an [Option], which configures the
+// [Actuator] whether to annotate the extension resources with a summary of the
+// configuration of the Target Allocator via the
+// [AnnotationTargetAllocatorConfig] annotation, which is meant for
+// troubleshooting the discovery of the scrape targets.
+func WithTargetAllocatorConfigAnnotation(enabled bool) Option {
+	opt := func(a *Actuator) error {
+		a.targetAllocatorConfigAnnotation = enabled
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithOwnerReferences is an [Option], which configures the [Actuator] whether
+// to set owner references of the extension resource on the resources deployed
+// into the seed cluster, so that they are listed as its dependents and garbage
+// collected, when it is deleted.
+func WithOwnerReferences(enabled bool) Option {
+	opt := func(a *Actuator) error {
+		a.ownerReferences = enabled
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithExtensionClasses is an [Option], which configures the [Actuator] to be
+// responsible for the given set of [extensionsv1alpha1.ExtensionClass]. By
+// default the [Actuator] is responsible for shoot-class extensions only.
+func WithExtensionClasses(classes ...extensionsv1alpha1.ExtensionClass) Option {
+	opt := func(a *Actuator) error {
+		if len(classes) == 0 {
+			return fmt.Errorf("%w: no extension classes specified", ErrInvalidActuator)
+		}
+
+		supportedClasses := []extensionsv1alpha1.ExtensionClass{
+			extensionsv1alpha1.ExtensionClassShoot,
+			extensionsv1alpha1.ExtensionClassSeed,
+		}
+
+		for _, class := range classes {
+			if !slices.Contains(supportedClasses, class) {
+				return fmt.Errorf("%w: unsupported extension class %q", ErrInvalidActuator, class)
+			}
+		}
+
+		slices.Sort(classes)
+		a.extensionClasses = slices.Compact(classes)
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithManagedResourceFailureThreshold is an [Option], which configures the
+// number of consecutive failures of the managed resource operations for a
+// cluster, after which the [Actuator] reports the extension as degraded.
+func WithManagedResourceFailureThreshold(threshold int) Option {
+	opt := func(a *Actuator) error {
+		if threshold <= 0 {
+			return fmt.Errorf("%w: invalid managed resource failure threshold: %d", ErrInvalidActuator, threshold)
+		}
+
+		a.managedResourceFailureThreshold = threshold
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithManagedResourceClass is an [Option], which configures the [Actuator] to
+// create the ManagedResource for the seed cluster with the given class. This
+// allows the collector resources to be handled by a dedicated
+// gardener-resource-manager instance. By default the ManagedResource is
+// handled by the gardener-resource-manager of the seed.
+func WithManagedResourceClass(class string) Option {
+	opt := func(a *Actuator) error {
+		if strings.TrimSpace(class) == "" {
+			return fmt.Errorf("%w: empty managed resource class specified", ErrInvalidActuator)
+		}
+
+		a.managedResourceClass = class
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithManagedResourceHealthTimeout is an [Option], which configures the
+// [Actuator] to wait up to the given timeout for the ManagedResource of the
+// seed to report its resources as applied and healthy on reconciliation. The
+// reconciliation is requeued, if the ManagedResource is not healthy within the
+// timeout, so that the status of the extension reflects the readiness of the
+// collector. A zero timeout disables the wait.
+func WithManagedResourceHealthTimeout(timeout time.Duration) Option {
+	opt := func(a *Actuator) error {
+		if timeout < 0 {
+			return fmt.Errorf("%w: invalid managed resource health timeout: %s", ErrInvalidActuator, timeout)
+		}
+
+		a.managedResourceHealthTimeout = timeout
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithImagePullSecret is an [Option], which configures the [Actuator] to use
+// the secret with the given key for pulling the images of the collector and
+// Target Allocator. The secret is copied into the namespace of each cluster.
+func WithImagePullSecret(key client.ObjectKey) Option {
+	opt := func(a *Actuator) error {
+		if key.Namespace == "" || key.Name == "" {
+			return fmt.Errorf("%w: invalid image pull secret specified: %q", ErrInvalidActuator, key)
+		}
+
+		a.imagePullSecret = key
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithDefaultExporter is an [Option], which configures the [Actuator] to add an
+// OTLP HTTP exporter for the given endpoint to every collector, in addition to
+// the exporters configured by the shoot owners. Shoot owners can opt out of
+// the default exporter via the [AnnotationDisableDefaultExporter] annotation.
+func WithDefaultExporter(endpoint string) Option {
+	opt := func(a *Actuator) error {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("%w: invalid default exporter endpoint specified: %q", ErrInvalidActuator, endpoint)
+		}
+
+		a.defaultExporterEndpoint = endpoint
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithDefaultExporterSecret is an [Option], which configures the [Actuator] to
+// authenticate the default exporter with the bearer token from the `token'
+// data key of the secret with the given key. The secret is copied into the
+// namespace of each cluster.
+func WithDefaultExporterSecret(key client.ObjectKey) Option {
+	opt := func(a *Actuator) error {
+		if key.Namespace == "" || key.Name == "" {
+			return fmt.Errorf("%w: invalid default exporter secret specified: %q", ErrInvalidActuator, key)
+		}
+
+		a.defaultExporterSecret = key
+
+		return nil
+	}
+
+	return opt
+}
+
 // Name returns the name of the actuator. This name can be used when registering
 // a controller for the actuator.
 func (a *Actuator) Name() string {
@@ -349,11 +975,18 @@ func (a *Actuator) ExtensionType() string {
 	return ExtensionType
 }
 
-// ExtensionClass returns the [extensionsv1alpha1.ExtensionClass] for the
-// actuator. The result of this method may be used when registering a controller
-// with the actuator.
-func (a *Actuator) ExtensionClass() extensionsv1alpha1.ExtensionClass {
-	return extensionsv1alpha1.ExtensionClassShoot
+// ExtensionClasses returns the set of [extensionsv1alpha1.ExtensionClass] the
+// actuator is responsible for. The result of this method may be used when
+// registering a controller with the actuator.
+func (a *Actuator) ExtensionClasses() []extensionsv1alpha1.ExtensionClass {
+	return slices.Clone(a.extensionClasses)
+}
+
+// isSeedClass returns true, if the given [extensionsv1alpha1.Extension] is of
+// the seed extension class. Extensions without a class are shoot-class
+// extensions.
+func isSeedClass(ex *extensionsv1alpha1.Extension) bool {
+	return ptr.Deref(ex.Spec.Class, extensionsv1alpha1.ExtensionClassShoot) == extensionsv1alpha1.ExtensionClassSeed
 }
 
 // Reconcile reconciles the [extensionsv1alpha1.Extension] resource by taking
@@ -370,22 +1003,31 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 	// The cluster name is the same as the name of the namespace for our
 	// [extensionsv1alpha1.Extension] resource.
 	clusterName := ex.Namespace
+	seedClass := isSeedClass(ex)
 
 	secretsManager, err := a.newSecretsManager(ctx, logger, ex.Namespace)
 	if err != nil {
 		return fmt.Errorf("failed creating a new secrets manager: %w", err)
 	}
 
-	logger.Info("reconciling extension", "name", ex.Name, "cluster", clusterName)
+	logger.Info("reconciling extension", "name", ex.Name, "cluster", clusterName, "seedClass", seedClass)
 
-	cluster, err := extensionscontroller.GetCluster(ctx, a.client, clusterName)
-	if err != nil {
-		return fmt.Errorf("failed to get cluster: %w", err)
-	}
+	// Seed-class extensions live in the garden namespace of the seed and
+	// are not associated with any shoot cluster.
+	var cluster *extensionscontroller.Cluster
+	var resources []gardencorev1beta1.NamedResourceReference
+	if !seedClass {
+		cluster, err = extensionscontroller.GetCluster(ctx, a.client, clusterName)
+		if err != nil {
+			return fmt.Errorf("failed to get cluster: %w", err)
+		}
 
-	// Nothing to do here, if the shoot cluster is hibernated at the moment.
-	if v1beta1helper.HibernationIsEnabled(cluster.Shoot) {
-		return nil
+		// Nothing to do here, if the shoot cluster is hibernated at the moment.
+		if v1beta1helper.HibernationIsEnabled(cluster.Shoot) {
+			return nil
+		}
+
+		resources = cluster.Shoot.Spec.Resources
 	}
 
 	// Parse and validate the provider config
@@ -395,6 +1037,12 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 
 	var cfg config.CollectorConfig
 	if err := runtime.DecodeInto(a.decoder, ex.Spec.ProviderConfig.Raw, &cfg); err != nil {
+		// Unknown fields are most likely typos, which would be
+		// silently ignored otherwise.
+		if runtime.IsStrictDecodingError(err) {
+			return fmt.Errorf("provider spec configuration contains unknown fields: %w", err)
+		}
+
 		return fmt.Errorf("invalid provider spec configuration: %w", err)
 	}
 
@@ -402,146 +1050,608 @@ func (a *Actuator) Reconcile(ctx context.Context, logger logr.Logger, ex *extens
 		return err
 	}
 
-	// Generate CA and server certificate for Target Allocator
-	if _, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
-		Name:       secretNameCACertificate,
-		CommonName: Name,
-		CertType:   secretsutils.CACert,
-		Validity:   ptr.To(30 * 24 * time.Hour),
-	}, secretsmanager.Rotate(secretsmanager.KeepOld), secretsmanager.IgnoreOldSecretsAfter(24*time.Hour)); err != nil {
-		return fmt.Errorf("failed generating CA certificate secret: %w", err)
+	if err := a.validateTLSPolicy(cfg); err != nil {
+		return err
 	}
-	caBundleSecret, _ := secretsManager.Get(secretNameCACertificate)
 
-	serverSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
-		Name:                        secretNameServerCertificate,
-		CommonName:                  targetAllocatorHTTPSServiceName,
-		DNSNames:                    kubernetesutils.DNSNamesForService(targetAllocatorHTTPSServiceName, ex.Namespace),
-		CertType:                    secretsutils.ServerCert,
-		SkipPublishingCACertificate: true,
-	}, secretsmanager.SignedByCA(secretNameCACertificate), secretsmanager.Rotate(secretsmanager.InPlace))
-	if err != nil {
-		return fmt.Errorf("failed generating server certificate secret for target allocator: %w", err)
+	if err := a.validateReceiverAuthPolicy(cfg, seedClass); err != nil {
+		return err
 	}
 
-	clientSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
-		Name:                        secretNameClientCertificate,
-		CommonName:                  secretNameClientCertificate,
-		CertType:                    secretsutils.ClientCert,
-		SkipPublishingCACertificate: true,
-	}, secretsmanager.SignedByCA(secretNameCACertificate), secretsmanager.Rotate(secretsmanager.InPlace))
-	if err != nil {
-		return fmt.Errorf("failed generating server certificate secret for target allocator: %w", err)
+	if err := a.validateHostMetricsPolicy(cfg, seedClass); err != nil {
+		return err
 	}
 
-	taImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelTargetAllocator)
-	if err != nil {
-		return fmt.Errorf("failed to find image: %w", err)
+	// The resources referenced by a seed are not known to the extension,
+	// because there is no Cluster resource for seed-class extensions.
+	if seedClass && hasResourceReferences(cfg) {
+		return errors.New("resource references are not supported for seed-class extensions")
 	}
 
-	collectorImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelCollector)
-	if err != nil {
-		return fmt.Errorf("failed to find image: %w", err)
+	if err := validateScrapeConfigReferences(cfg, resources); err != nil {
+		return err
 	}
 
-	// Bundle things up in a managed resource
-	registry := managedresources.NewRegistry(
-		kubernetes.SeedScheme,
-		kubernetes.SeedCodec,
-		kubernetes.SeedSerializer,
-	)
-
-	taConfigMap, err := a.getTargetAllocatorConfigMap(ex.Namespace)
+	cfg, err = expandEndpoints(cfg, getEndpointVariables(cluster))
 	if err != nil {
 		return err
 	}
 
-	shootKubeconfigSecretName := extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster)
-
-	shootAccessSecret := gardenerutils.NewShootAccessSecret(shootAccessSecretName, ex.Namespace)
-	if err := shootAccessSecret.Reconcile(ctx, a.client); err != nil {
-		return fmt.Errorf("failed reconciling shoot access secret: %w", err)
-	}
-
-	data, err := registry.AddAllAndSerialize(
-		taConfigMap,
-		a.getTargetAllocatorServiceAccount(ex.Namespace),
-		a.getTargetAllocatorRole(ex.Namespace),
-		a.getTargetAllocatorRoleBinding(ex.Namespace),
-		a.getTargetAllocatorHTTPSService(ex.Namespace),
-		a.getTargetAllocatorDeployment(ex.Namespace, caBundleSecret, serverSecret, taImage),
-		a.getOtelCollectorServiceAccount(ex.Namespace),
-		a.getOtelCollector(
-			ex.Namespace,
-			caBundleSecret,
-			clientSecret,
-			cfg,
-			cluster.Shoot.Spec.Resources,
-			shootKubeconfigSecretName,
-			shootAccessSecret.Secret.Name,
-			collectorImage,
-		),
-	)
-
-	if err != nil {
+	if err := a.validateSecretReferences(ctx, ex.Namespace, cfg, resources); err != nil {
 		return err
 	}
 
-	shootRegistry := managedresources.NewRegistry(
-		kubernetes.ShootScheme,
-		kubernetes.ShootCodec,
-		kubernetes.ShootSerializer,
-	)
+	if err := a.validateVolumeReferences(ctx, ex.Namespace, cfg, resources); err != nil {
+		return err
+	}
 
-	shootData, err := shootRegistry.AddAllAndSerialize(
-		a.getEventsClusterRole(),
-		a.getEventsClusterRoleBinding(shootAccessSecret.ServiceAccountName),
-	)
+	referencedConfig, err := a.getReferencedConfig(ctx, ex.Namespace, cfg.Spec.ConfigRef, resources)
 	if err != nil {
 		return err
 	}
-
-	if err := managedresources.CreateForShoot(ctx, a.client, ex.Namespace, shootManagedResourceName, Name, false, shootData); err != nil {
-		return fmt.Errorf("failed creating shoot managed resource: %w", err)
+	if err := a.validateReferencedConfigPolicy(referencedConfig); err != nil {
+		return err
 	}
 
-	return managedresources.CreateForSeed(
-		ctx,
-		a.client,
-		ex.Namespace,
-		managedResourceName,
-		false,
-		data,
-	)
-}
-
-// Delete deletes any resources managed by the [Actuator]. This method
-// implements the [extension.Actuator] interface.
-func (a *Actuator) Delete(ctx context.Context, logger logr.Logger, ex *extensionsv1alpha1.Extension) error {
-	secretsManager, err := a.newSecretsManager(ctx, logger, ex.Namespace)
-	if err != nil {
-		return fmt.Errorf("failed creating a new secrets manager: %w", err)
+	// The collector pods are rolled out, when the referenced secrets are
+	// rotated, since not every component reloads the projected files.
+	var secretsChecksum string
+	if cfg.Spec.IsRolloutOnSecretRotationEnabled() {
+		secretsChecksum, err = a.getSecretReferencesChecksum(ctx, ex.Namespace, cfg, resources)
+		if err != nil {
+			return err
+		}
 	}
 
-	logger.Info("deleting resources managed by extension")
-
-	if err := secretsManager.Cleanup(ctx); err != nil {
-		return fmt.Errorf("failed cleaning up secrets managed by secrets manager: %w", err)
+	// The client metadata propagated by the OTLP receiver is only consumed
+	// by context-aware components such as the `headers_setter' extension,
+	// which the extension does not configure, or the client authentication
+	// of the receiver.
+	if cfg.Spec.Receivers.OTLPReceiver.IsIncludeMetadataEnabled() &&
+		!cfg.Spec.Receivers.OTLPReceiver.Auth.IsConfigured() &&
+		!hasServiceExtension(referencedConfig, "headers_setter") {
+		logger.Info("include_metadata is enabled for the OTLP receiver, but no headers_setter or context-based auth is configured")
 	}
 
-	if err := client.IgnoreNotFound(managedresources.DeleteForShoot(ctx, a.client, ex.Namespace, shootManagedResourceName)); err != nil {
-		return fmt.Errorf("failed deleting shoot managed resource: %w", err)
+	// The certificates for the mTLS between the Collector and the Target
+	// Allocator are not needed, when the Target Allocator is managed by the
+	// OTel Operator.
+	var caBundleSecret, serverSecret, clientSecret *corev1.Secret
+	if a.targetAllocatorMTLS {
+		caBundleSecret, serverSecret, clientSecret, err = a.generateTargetAllocatorCertificates(ctx, logger, secretsManager, ex.Namespace)
+		if err != nil {
+			return err
+		}
 	}
 
-	if err := managedresources.WaitUntilDeleted(ctx, a.client, ex.Namespace, shootManagedResourceName); err != nil {
-		return fmt.Errorf("failed waiting for shoot managed resource to be deleted: %w", err)
-	}
+	taImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelTargetAllocator)
+	if err != nil {
+		return fmt.Errorf("failed to find image: %w", err)
+	}
+
+	collectorImage, err := imagevector.Images().FindImage(collectorImageName(cfg.Spec.Distribution))
+	if err != nil {
+		return fmt.Errorf("failed to find image: %w", err)
+	}
+
+	taConfigMap, err := a.getTargetAllocatorConfigMap(ex.Namespace, cfg.Spec.TargetAllocator)
+	if err != nil {
+		return err
+	}
+
+	var shootAccessSecret *gardenerutils.AccessSecret
+	var shootKubeconfigSecretName string
+	if !seedClass {
+		shootKubeconfigSecretName = extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster)
+		shootAccessSecret = gardenerutils.NewShootAccessSecret(shootAccessSecretName, ex.Namespace)
+		if err := shootAccessSecret.Reconcile(ctx, a.client); err != nil {
+			return fmt.Errorf("failed reconciling shoot access secret: %w", err)
+		}
+	}
+
+	// The scrape targets of the ServiceMonitors, which reference secrets
+	// not delivered by the Target Allocator, fail to be scraped. This does
+	// not prevent the collector from being deployed, since the other scrape
+	// targets are not affected.
+	//
+	// On a fresh shoot the ServiceMonitors may not exist yet, in which case
+	// the collector runs with an empty set of scrape targets, until they
+	// are discovered by the Target Allocator.
+	if cfg.Spec.Mode != config.CollectorModeDeployment {
+		monitors, err := a.listServiceMonitors(ctx, ex.Namespace)
+		if err != nil {
+			logger.Error(err, "failed to check the service monitors discovered by the target allocator")
+		} else {
+			metrics.ServiceMonitors.WithLabelValues(ex.Namespace).Set(float64(len(monitors)))
+			if len(monitors) == 0 {
+				logger.Info(
+					"no service monitors match the selector of the target allocator, scrape targets are discovered once they are created",
+					"selector", labels.Set{configKeyPrometheus: labelValuePrometheusShoot}.String(),
+				)
+			}
+			if err := a.validateServiceMonitorSecrets(ctx, ex.Namespace, monitors); err != nil {
+				logger.Error(err, "scrape targets of the service monitors will fail to authenticate")
+			}
+		}
+	} else {
+		metrics.ServiceMonitors.DeleteLabelValues(ex.Namespace)
+	}
+
+	// Batches, which are much shorter than the scrape interval, contain
+	// the samples of a fraction of the scrape targets only.
+	if cfg.Spec.Mode != config.CollectorModeDeployment && cfg.Spec.Processors.Batch.IsEnabled() {
+		timeout, interval := a.getBatchTimeout(cfg), getMinScrapeInterval(cfg)
+		if timeout*batchTimeoutWarningRatio < interval {
+			logger.Info("batch processor timeout is much smaller than the scrape interval, consider the auto-tuning of the batch timeout", "timeout", timeout, "scrapeInterval", interval)
+		}
+	}
+
+	// Without the batch processor, the exporters, which send the data
+	// over the network, issue a request per incoming request.
+	if !cfg.Spec.Processors.Batch.IsEnabled() {
+		if exporters := getBatchingExporterNames(cfg); len(exporters) > 0 {
+			logger.Info("batch processor is disabled, which increases the number of requests of the exporters, consider enabling it", "exporters", exporters)
+		}
+	}
+
+	// The default exporter is merged into the OTLP HTTP exporter of the
+	// shoot owner, when both export to the same endpoint, which would
+	// otherwise receive the signals twice.
+	defaultExporter := a.isDefaultExporterEnabled(cluster)
+	if defaultExporter && a.isDuplicateOfDefaultExporter(cfg) {
+		logger.Info("otlp_http exporter exports to the endpoint of the default exporter, skipping the default exporter", "endpoint", a.defaultExporterEndpoint)
+		defaultExporter = false
+	}
+
+	otelCollector, objects := a.getSeedObjects(seedObjectsParams{
+		namespace:                 ex.Namespace,
+		cfg:                       cfg,
+		resources:                 resources,
+		caSecret:                  caBundleSecret,
+		clientSecret:              clientSecret,
+		serverSecret:              serverSecret,
+		taConfigMap:               taConfigMap,
+		shootKubeconfigSecretName: shootKubeconfigSecretName,
+		seedClass:                 seedClass,
+		collectorImage:            collectorImage,
+		taImage:                   taImage,
+		defaultExporter:           defaultExporter,
+		seedName:                  seedNameFromCluster(cluster),
+		secretsChecksum:           secretsChecksum,
+	})
+
+	if err := mergeReferencedConfig(otelCollector, referencedConfig); err != nil {
+		return fmt.Errorf("failed to merge referenced collector configuration: %w", err)
+	}
+	configureTailSampling(otelCollector, cfg.Spec.Processors.TailSampling)
+
+	if err := connectTracesConnectors(otelCollector); err != nil {
+		return err
+	}
+
+	// Exemplars are lost, when the metrics of the Span Metrics connector
+	// pass processors, which aggregate the data points.
+	if cfg.Spec.Connectors.SpanMetricsConnector.Exemplars.IsEnabled() {
+		for pipeline, processors := range getExemplarStrippingProcessors(otelCollector) {
+			logger.Info("exemplars of the spanmetrics connector are stripped by the processors of the pipeline", "pipeline", pipeline, "processors", processors)
+		}
+	}
+
+	if err := validateCollectors(objects, collectorImage); err != nil {
+		return err
+	}
+	recordConfigComponents(ex.Namespace, otelCollector)
+
+	imagePullSecret, err := a.getImagePullSecret(ctx, ex.Namespace)
+	if err != nil {
+		return err
+	}
+	if imagePullSecret != nil {
+		objects = append(objects, imagePullSecret)
+	}
+
+	if defaultExporter {
+		defaultExporterSecret, err := a.getDefaultExporterSecret(ctx, ex.Namespace)
+		if err != nil {
+			return err
+		}
+		if defaultExporterSecret != nil {
+			objects = append(objects, defaultExporterSecret)
+		}
+	}
+
+	// Seed-class extensions are not associated with any shoot cluster.
+	if a.shootUIDLabel && !seedClass {
+		for _, obj := range objects {
+			obj.SetLabels(utils.MergeStringMaps(obj.GetLabels(), map[string]string{
+				LabelShootUID: string(cluster.Shoot.UID),
+			}))
+		}
+	}
+
+	if a.ownerReferences {
+		setOwnerReferences(ex, objects)
+	}
+
+	// The hash of the desired objects covers the provider config, the
+	// images, the certificates and the settings of the actuator, so the
+	// managed resources are only applied again, when any of them changed.
+	// The class of the seed ManagedResource is not part of the objects, so
+	// it is covered separately.
+	configHash := utils.ComputeChecksum(map[string]any{
+		"class":   a.managedResourceClass,
+		"objects": objects,
+	})
+
+	// The summary of the configuration of the Target Allocator managed by
+	// the extension is exposed for troubleshooting, if enabled.
+	var taConfigSummary string
+	if a.targetAllocatorConfigAnnotation && a.targetAllocatorMTLS && cfg.Spec.Mode != config.CollectorModeDeployment {
+		taConfigSummary, err = getTargetAllocatorConfigSummary(taConfigMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ex.Annotations[AnnotationConfigHash] == configHash && ex.Annotations[AnnotationTargetAllocatorConfig] == taConfigSummary {
+		upToDate, err := a.managedResourcesUpToDate(ctx, ex.Namespace, seedClass)
+		if err != nil {
+			return err
+		}
+		if upToDate {
+			logger.Info("configuration is unchanged, skipping managed resources", "cluster", clusterName)
+
+			return a.waitUntilSeedManagedResourceHealthy(ctx, ex.Namespace)
+		}
+	}
+
+	// Bundle things up in a managed resource
+	data, err := serializeManagedResourceObjects(newSeedRegistry, managedResourceSecretMaxSize, objects...)
+	if err != nil {
+		return err
+	}
+
+	if !seedClass {
+		if err := a.reconcileShootManagedResource(ctx, ex.Namespace, shootAccessSecret.ServiceAccountName); err != nil {
+			return err
+		}
+	}
+
+	if err := a.mrBackoff.do(ex.Namespace, func() error {
+		return a.createSeedManagedResource(ctx, ex.Namespace, data)
+	}); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(ex.DeepCopy())
+	metav1.SetMetaDataAnnotation(&ex.ObjectMeta, AnnotationConfigHash, configHash)
+	if taConfigSummary != "" {
+		metav1.SetMetaDataAnnotation(&ex.ObjectMeta, AnnotationTargetAllocatorConfig, taConfigSummary)
+	} else {
+		delete(ex.Annotations, AnnotationTargetAllocatorConfig)
+	}
+	if err := a.client.Patch(ctx, ex, patch); err != nil {
+		return fmt.Errorf("failed to annotate extension with the configuration hash: %w", err)
+	}
+
+	return a.waitUntilSeedManagedResourceHealthy(ctx, ex.Namespace)
+}
+
+// setOwnerReferences sets an owner reference of the given extension resource on
+// the given objects in its namespace. Cluster-scoped objects and objects in
+// other namespaces cannot be owned by the extension resource.
+//
+// The owner reference is not a controller reference, since the objects are
+// applied by gardener-resource-manager, which tracks them via the
+// ManagedResource instead of owner references. It does not block the deletion
+// of the extension resource either, which would require the permission to
+// update its finalizers.
+func setOwnerReferences(ex *extensionsv1alpha1.Extension, objects []client.Object) {
+	ownerRef := metav1.OwnerReference{
+		APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(),
+		Kind:       extensionsv1alpha1.ExtensionResource,
+		Name:       ex.Name,
+		UID:        ex.UID,
+	}
+
+	for _, obj := range objects {
+		if obj.GetNamespace() != ex.Namespace {
+			continue
+		}
+
+		ownerRefs := slices.DeleteFunc(obj.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+			return ref.UID == ex.UID
+		})
+		obj.SetOwnerReferences(append(ownerRefs, ownerRef))
+	}
+}
+
+// waitUntilSeedManagedResourceHealthy waits up to the configured timeout for
+// the ManagedResource of the seed in the given namespace to become healthy.
+// The reconciliation is requeued, if it does not become healthy in time.
+func (a *Actuator) waitUntilSeedManagedResourceHealthy(ctx context.Context, namespace string) error {
+	if a.managedResourceHealthTimeout == 0 {
+		return nil
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, a.managedResourceHealthTimeout)
+	defer cancel()
+
+	if err := managedresources.WaitUntilHealthy(timeoutCtx, a.client, namespace, managedResourceName); err != nil {
+		// The reconciliation itself is cancelled, e.g. on shutdown.
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("managed resource %s is not healthy after %s: %w", managedResourceName, a.managedResourceHealthTimeout, err),
+			RequeueAfter: managedResourceHealthRequeueInterval,
+		}
+	}
+
+	return nil
+}
+
+// generateTargetAllocatorCertificates generates the CA, the server
+// certificate of the Target Allocator and the client certificate of the
+// Collector, which are used for the mTLS between the Collector and the Target
+// Allocator. It returns the CA bundle, the server and the client secret.
+func (a *Actuator) generateTargetAllocatorCertificates(
+	ctx context.Context,
+	logger logr.Logger,
+	secretsManager secretsmanager.Interface,
+	namespace string,
+) (*corev1.Secret, *corev1.Secret, *corev1.Secret, error) {
+	caSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:       secretNameCACertificate,
+		CommonName: Name,
+		CertType:   secretsutils.CACert,
+		Validity:   ptr.To(30 * 24 * time.Hour),
+	}, secretsmanager.Rotate(secretsmanager.KeepOld), secretsmanager.IgnoreOldSecretsAfter(24*time.Hour))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed generating CA certificate secret: %w", err)
+	}
+	caBundleSecret, _ := secretsManager.Get(secretNameCACertificate)
+
+	serverSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        secretNameServerCertificate,
+		CommonName:                  targetAllocatorHTTPSServiceName,
+		DNSNames:                    kubernetesutils.DNSNamesForService(targetAllocatorHTTPSServiceName, namespace),
+		CertType:                    secretsutils.ServerCert,
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(secretNameCACertificate), secretsmanager.Rotate(secretsmanager.InPlace))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed generating server certificate secret for target allocator: %w", err)
+	}
+
+	clientSecret, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        secretNameClientCertificate,
+		CommonName:                  secretNameClientCertificate,
+		CertType:                    secretsutils.ClientCert,
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(secretNameCACertificate), secretsmanager.Rotate(secretsmanager.InPlace))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed generating server certificate secret for target allocator: %w", err)
+	}
+
+	certificates := map[string][]byte{
+		secretNameCACertificate:     caSecret.Data[secretsutils.DataKeyCertificateCA],
+		secretNameServerCertificate: serverSecret.Data[secretsutils.DataKeyCertificate],
+		secretNameClientCertificate: clientSecret.Data[secretsutils.DataKeyCertificate],
+	}
+	for name, data := range certificates {
+		if err := recordCertificateExpiry(namespace, name, data); err != nil {
+			logger.Error(err, "failed to record certificate expiry", "cert", name)
+		}
+	}
+
+	return caBundleSecret, serverSecret, clientSecret, nil
+}
 
-	if err := client.IgnoreNotFound(a.client.Delete(ctx, gardenerutils.NewShootAccessSecret(shootAccessSecretName, ex.Namespace).Secret)); err != nil {
-		return fmt.Errorf("failed deleting shoot access secret: %w", err)
+// managedResourcesUpToDate returns whether the ManagedResource, which deploys
+// the collector resources into the seed cluster, exists in the given namespace
+// with the configured class. Unless seedClass is set, the ManagedResource of
+// the shoot must exist as well and must not keep its objects, e.g. after the
+// restore of a control-plane migration.
+func (a *Actuator) managedResourcesUpToDate(ctx context.Context, namespace string, seedClass bool) (bool, error) {
+	var mr resourcesv1alpha1.ManagedResource
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResourceName}, &mr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get managed resource: %w", err)
+	}
+	if ptr.Deref(mr.Spec.Class, "") != a.managedResourceClass {
+		return false, nil
+	}
+
+	if seedClass {
+		return true, nil
+	}
+
+	var shootMR resourcesv1alpha1.ManagedResource
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: shootManagedResourceName}, &shootMR); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get shoot managed resource: %w", err)
+	}
+
+	return !ptr.Deref(shootMR.Spec.KeepObjects, false), nil
+}
+
+// seedObjectsParams provides the parameters for the resources, which are
+// deployed into the seed cluster.
+type seedObjectsParams struct {
+	namespace                 string
+	cfg                       config.CollectorConfig
+	resources                 []gardencorev1beta1.NamedResourceReference
+	caSecret                  *corev1.Secret
+	clientSecret              *corev1.Secret
+	serverSecret              *corev1.Secret
+	taConfigMap               *corev1.ConfigMap
+	shootKubeconfigSecretName string
+	seedClass                 bool
+	collectorImage            *imagevectorutils.Image
+	taImage                   *imagevectorutils.Image
+	defaultExporter           bool
+	seedName                  string
+	secretsChecksum           string
+}
+
+// getSeedObjects returns the [otelv1beta1.OpenTelemetryCollector] along with
+// all resources, which are deployed into the seed cluster.
+func (a *Actuator) getSeedObjects(p seedObjectsParams) (*otelv1beta1.OpenTelemetryCollector, []client.Object) {
+	otelCollector := a.getOtelCollector(
+		p.namespace,
+		p.caSecret,
+		p.clientSecret,
+		p.cfg,
+		p.resources,
+		p.shootKubeconfigSecretName,
+		shootAccessSecretName,
+		p.collectorImage,
+	)
+
+	if p.defaultExporter {
+		a.configureDefaultExporter(otelCollector)
+	}
+
+	setSecretsChecksum(otelCollector, p.secretsChecksum)
+
+	if p.cfg.Spec.Metrics.IsResourceAttributesEnabled() {
+		a.configureTelemetryResource(otelCollector, p.namespace, p.seedName)
+	}
+
+	if len(p.cfg.Spec.Metrics.DisabledMetrics) > 0 {
+		a.configureDisabledMetrics(otelCollector, p.cfg.Spec.Metrics.DisabledMetrics)
+	}
+
+	objects := []client.Object{
+		a.getOtelCollectorServiceAccount(p.namespace, p.cfg),
+		otelCollector,
+	}
+
+	// The k8sobjects/events receiver of a seed-class extension watches the
+	// events of the seed cluster, hence the RBAC is deployed in the seed.
+	if p.seedClass {
+		a.configureSeedClass(otelCollector)
+		objects = append(
+			objects,
+			a.getSeedEventsClusterRole(),
+			a.getSeedEventsClusterRoleBinding(p.namespace),
+		)
+	}
+
+	// The OTLP gateway receives the OTLP data in front of the collector.
+	if p.cfg.Spec.Gateway.IsEnabled() {
+		gateway := a.getOtelCollectorGateway(p.namespace, p.cfg, p.resources, p.collectorImage)
+		setSecretsChecksum(gateway, p.secretsChecksum)
+		objects = append(objects, gateway)
+	}
+
+	// The OTLP receiver is restricted to the allowed clients.
+	if len(p.cfg.Spec.Receivers.OTLPReceiver.AllowedClients) > 0 {
+		objects = append(objects, a.getOTLPReceiverNetworkPolicies(p.namespace, p.cfg)...)
+	}
+
+	// The host metrics collector forwards the metrics of the seed nodes to
+	// the metrics pipeline of the collector.
+	if p.cfg.Spec.Receivers.HostMetricsReceiver.IsEnabled() {
+		a.configureHostMetricsReceiver(otelCollector)
+		objects = append(objects, a.getOtelCollectorHostMetrics(p.namespace, p.cfg, p.collectorImage))
+	}
+
+	// The Target Allocator is needed by the Prometheus receiver only, which
+	// is not configured in deployment mode. Without mTLS, the Target
+	// Allocator is managed by the OTel Operator.
+	switch {
+	case p.cfg.Spec.Mode == config.CollectorModeDeployment:
+	case !a.targetAllocatorMTLS:
+		objects = append(
+			objects,
+			a.getTargetAllocatorServiceAccount(p.namespace),
+			a.getTargetAllocatorRole(p.namespace),
+			a.getTargetAllocatorRoleBinding(p.namespace),
+			a.getTargetAllocator(p.namespace, p.taImage, p.cfg),
+		)
+	default:
+		objects = append(
+			objects,
+			p.taConfigMap,
+			a.getTargetAllocatorServiceAccount(p.namespace),
+			a.getTargetAllocatorRole(p.namespace),
+			a.getTargetAllocatorRoleBinding(p.namespace),
+			a.getTargetAllocatorHTTPSService(p.namespace),
+			a.getTargetAllocatorMetricsService(p.namespace),
+			a.getTargetAllocatorDeployment(p.namespace, p.caSecret, p.serverSecret, p.taImage, p.cfg),
+		)
+	}
+
+	return otelCollector, objects
+}
+
+// reconcileShootManagedResource creates or updates the ManagedResource, which
+// deploys the RBAC for the k8sobjects/events receiver into the shoot cluster.
+func (a *Actuator) reconcileShootManagedResource(ctx context.Context, namespace string, serviceAccountName string) error {
+	shootRegistry := managedresources.NewRegistry(
+		kubernetes.ShootScheme,
+		kubernetes.ShootCodec,
+		kubernetes.ShootSerializer,
+	)
+
+	shootData, err := shootRegistry.AddAllAndSerialize(
+		a.getEventsClusterRole(),
+		a.getEventsClusterRoleBinding(serviceAccountName),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := managedresources.CreateForShoot(ctx, a.client, namespace, shootManagedResourceName, Name, false, shootData); err != nil {
+		return fmt.Errorf("failed creating shoot managed resource: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes any resources managed by the [Actuator]. This method
+// implements the [extension.Actuator] interface.
+func (a *Actuator) Delete(ctx context.Context, logger logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	secretsManager, err := a.newSecretsManager(ctx, logger, ex.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed creating a new secrets manager: %w", err)
+	}
+
+	logger.Info("deleting resources managed by extension")
+	metrics.ConfigComponents.DeletePartialMatch(prometheus.Labels{"cluster": ex.Namespace})
+	metrics.CertificateExpirySeconds.DeletePartialMatch(prometheus.Labels{"cluster": ex.Namespace})
+	metrics.ServiceMonitors.DeleteLabelValues(ex.Namespace)
+
+	if err := secretsManager.Cleanup(ctx); err != nil {
+		return fmt.Errorf("failed cleaning up secrets managed by secrets manager: %w", err)
+	}
+
+	// Seed-class extensions do not manage any resources in a shoot cluster.
+	if !isSeedClass(ex) {
+		if err := client.IgnoreNotFound(managedresources.DeleteForShoot(ctx, a.client, ex.Namespace, shootManagedResourceName)); err != nil {
+			return fmt.Errorf("failed deleting shoot managed resource: %w", err)
+		}
+
+		if err := managedresources.WaitUntilDeleted(ctx, a.client, ex.Namespace, shootManagedResourceName); err != nil {
+			return fmt.Errorf("failed waiting for shoot managed resource to be deleted: %w", err)
+		}
+
+		if err := client.IgnoreNotFound(a.client.Delete(ctx, gardenerutils.NewShootAccessSecret(shootAccessSecretName, ex.Namespace).Secret)); err != nil {
+			return fmt.Errorf("failed deleting shoot access secret: %w", err)
+		}
 	}
 
-	return client.IgnoreNotFound(managedresources.DeleteForSeed(ctx, a.client, ex.Namespace, managedResourceName))
+	return a.mrBackoff.do(ex.Namespace, func() error {
+		return client.IgnoreNotFound(managedresources.DeleteForSeed(ctx, a.client, ex.Namespace, managedResourceName))
+	})
 }
 
 // ForceDelete signals the [Actuator] to delete any resources managed by it,
@@ -567,6 +1677,12 @@ func (a *Actuator) Restore(ctx context.Context, logger logr.Logger, ex *extensio
 // target seed can pick them up after migration. SetKeepObjects prevents the
 // ManagedResource controller from deleting them when the ManagedResource is
 // removed from the old seed.
+//
+// The seed-scoped resources (collector, Target Allocator) are not kept, since
+// their mTLS secrets and the shoot access secret are deleted along with them,
+// and a kept collector would export the data in addition to the collector on
+// the destination seed. The keep-objects setting of the shoot ManagedResource
+// is reset on the destination seed by [Actuator.Reconcile].
 func (a *Actuator) Migrate(ctx context.Context, logger logr.Logger, ex *extensionsv1alpha1.Extension) error {
 	if err := managedresources.SetKeepObjects(ctx, a.client, ex.Namespace, shootManagedResourceName, true); err != nil {
 		return fmt.Errorf("failed setting keep-objects on shoot managed resource: %w", err)
@@ -575,61 +1691,616 @@ func (a *Actuator) Migrate(ctx context.Context, logger logr.Logger, ex *extensio
 	return a.Delete(ctx, logger, ex)
 }
 
-func (a *Actuator) newSecretsManager(ctx context.Context, log logr.Logger, namespace string) (secretsmanager.Interface, error) {
-	return secretsmanager.New(
-		ctx,
-		log,
-		clock.RealClock{},
-		a.client,
-		secretsManagerIdentity,
-		secretsmanager.WithCASecretAutoRotation(),
-		secretsmanager.WithNamespaces(namespace),
-	)
-}
+// validateTLSPolicy validates the TLS settings of the enabled exporters
+// against the policy configured by the operator.
+func (a *Actuator) validateTLSPolicy(cfg config.CollectorConfig) error {
+	otlpHTTP := cfg.Spec.Exporters.OTLPHTTPExporter
+	if a.requireExplicitCA && otlpHTTP.IsEnabled() && strings.HasPrefix(otlpHTTP.Endpoint, "https://") {
+		if otlpHTTP.TLS == nil || otlpHTTP.TLS.CA == nil {
+			return fmt.Errorf("%w: spec.exporters.otlp_http.tls.ca must be specified", ErrExplicitCARequired)
+		}
+	}
+	for i, exporter := range cfg.Spec.Exporters.Named {
+		otlpHTTP := exporter.OTLPHTTPExporter
+		if a.requireExplicitCA && exporter.IsEnabled() && otlpHTTP != nil && strings.HasPrefix(otlpHTTP.Endpoint, "https://") {
+			if otlpHTTP.TLS == nil || otlpHTTP.TLS.CA == nil {
+				return fmt.Errorf("%w: spec.exporters.named[%d].otlp_http.tls.ca must be specified", ErrExplicitCARequired, i)
+			}
+		}
+	}
 
-// getCommonLabels returns the common set of labels for the Collector and Target
-// Allocator resources.
-func (a *Actuator) getCommonLabels() map[string]string {
-	items := map[string]string{
-		v1beta1constants.LabelRole:                     v1beta1constants.LabelObservability,
-		v1beta1constants.GardenRole:                    v1beta1constants.GardenRoleObservability,
-		v1beta1constants.LabelObservabilityApplication: otelCollectorName,
+	if a.allowInsecureSkipVerify {
+		return nil
 	}
 
-	return items
+	tlsConfigs := make(map[string]*config.TLSConfig)
+	if cfg.Spec.Exporters.OTLPHTTPExporter.IsEnabled() {
+		tlsConfigs["spec.exporters.otlp_http.tls"] = cfg.Spec.Exporters.OTLPHTTPExporter.TLS
+	}
+	if cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled() {
+		tlsConfigs["spec.exporters.otlp_grpc.tls"] = cfg.Spec.Exporters.OTLPGRPCExporter.TLS
+	}
+	for i, exporter := range cfg.Spec.Exporters.Named {
+		if exporter.IsEnabled() {
+			tls, _ := getNamedExporterReferences(exporter)
+			tlsConfigs[fmt.Sprintf("spec.exporters.named[%d].%s.tls", i, exporter.ExporterType())] = tls
+		}
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(tlsConfigs)) {
+		tls := tlsConfigs[path]
+		if tls != nil && ptr.Deref(tls.InsecureSkipVerify, false) {
+			return fmt.Errorf("%w: %s.insecureSkipVerify must not be enabled", ErrInsecureSkipVerifyNotAllowed, path)
+		}
+	}
+
+	return nil
 }
 
-// getNetworkLabels returns the set of labels related to Gardener Network
-// Policies.
-func (a *Actuator) getNetworkLabels() map[string]string {
-	// The `networking.resources.gardener.cloud/to-all-scrape-targets' label
-	toAllScrapeTargetsLabel := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + v1beta1constants.LabelNetworkPolicyScrapeTargets
+// validateReceiverAuthPolicy validates that the clients of the OTLP receiver
+// are required to authenticate, unless the operator allows anonymous clients.
+// Seed-class extensions cannot reference the credentials of the clients,
+// hence they are rejected, unless anonymous clients are allowed.
+func (a *Actuator) validateReceiverAuthPolicy(cfg config.CollectorConfig, seedClass bool) error {
+	if a.allowAnonymousOTLPReceiver || cfg.Spec.Receivers.OTLPReceiver.Auth.IsConfigured() {
+		return nil
+	}
 
-	items := map[string]string{
-		v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
-		v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
-		v1beta1constants.LabelNetworkPolicyToPrivateNetworks:  v1beta1constants.LabelNetworkPolicyAllowed,
-		v1beta1constants.LabelNetworkPolicyToPublicNetworks:   v1beta1constants.LabelNetworkPolicyAllowed,
-		resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + targetAllocatorHTTPSServiceName + "-tcp-" + strconv.Itoa(targetAllocatorHTTPSPort): v1beta1constants.LabelNetworkPolicyAllowed,
-		toAllScrapeTargetsLabel: v1beta1constants.LabelNetworkPolicyAllowed,
+	if seedClass {
+		return fmt.Errorf("%w: seed-class extensions do not support resource references for spec.receivers.otlp.auth", ErrAnonymousOTLPReceiverNotAllowed)
 	}
 
-	return items
+	return fmt.Errorf("%w: spec.receivers.otlp.auth must be specified", ErrAnonymousOTLPReceiverNotAllowed)
 }
 
-// getAnnotations returns the common set of annotations for the Collector and
-// Target Allocator resources.
-func (a *Actuator) getAnnotations() map[string]string {
-	// The `networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports' annotation
+// validateHostMetricsPolicy validates that the host metrics receiver is
+// enabled only by seed-class extensions and only, if the operator allows it.
+func (a *Actuator) validateHostMetricsPolicy(cfg config.CollectorConfig, seedClass bool) error {
+	if !cfg.Spec.Receivers.HostMetricsReceiver.IsEnabled() {
+		return nil
+	}
+
+	if !seedClass {
+		return fmt.Errorf("%w: shoot-class extensions do not support spec.receivers.hostmetrics", ErrHostMetricsReceiverNotAllowed)
+	}
+
+	if a.allowHostMetricsReceiver {
+		return nil
+	}
+
+	return fmt.Errorf("%w: spec.receivers.hostmetrics.enabled must not be set", ErrHostMetricsReceiverNotAllowed)
+}
+
+// validateReferencedConfigPolicy validates that the referenced collector
+// configuration contains only components of the types of
+// [referencedComponents], and receivers or extensions only, if the operator
+// allows them.
+func (a *Actuator) validateReferencedConfigPolicy(referenced *otelv1beta1.Config) error {
+	if referenced == nil {
+		return nil
+	}
+
+	for _, c := range []struct {
+		kind    string
+		source  *otelv1beta1.AnyConfig
+		allow   bool
+		allowed []string
+	}{
+		{"receiver", &referenced.Receivers, a.allowReferencedReceivers, referencedComponents.receivers},
+		{"processor", referenced.Processors, true, referencedComponents.processors},
+		{"exporter", &referenced.Exporters, true, referencedComponents.exporters},
+		{"connector", referenced.Connectors, true, referencedComponents.connectors},
+		{"extension", referenced.Extensions, a.allowReferencedExtensions, referencedComponents.extensions},
+	} {
+		if c.source == nil {
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(c.source.Object)) {
+			if !c.allow {
+				return fmt.Errorf("%w: %s %q, %ss are not allowed", ErrReferencedComponentNotAllowed, c.kind, name, c.kind)
+			}
+			componentType, _, _ := strings.Cut(name, "/")
+			if !slices.Contains(c.allowed, componentType) {
+				return fmt.Errorf("%w: %s %q, the %s type is not supported", ErrReferencedComponentNotAllowed, c.kind, name, componentType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateScrapeConfigReferences validates that the credentials of the
+// additional scrape jobs reference Secrets, which are specified in the
+// referenced resources of the shoot.
+func validateScrapeConfigReferences(cfg config.CollectorConfig, resources []gardencorev1beta1.NamedResourceReference) error {
+	for _, sc := range cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs {
+		ref := scrapeConfigCredentials(sc)
+		if ref == nil {
+			continue
+		}
+
+		if secretNameForResource(ref.ResourceRef.Name, resources) == "" {
+			return fmt.Errorf("credentials of scrape job %s reference unknown secret resource %s", sc.JobName, ref.ResourceRef.Name)
+		}
+	}
+
+	return nil
+}
+
+// getSecretReferences returns the references to the Secrets of the given
+// [config.CollectorConfig] by their field path.
+func getSecretReferences(cfg config.CollectorConfig) map[string]*config.ResourceReference {
+	refs := make(map[string]*config.ResourceReference)
+	addTLSReferences := func(path string, tls *config.TLSConfig) {
+		if tls == nil {
+			return
+		}
+		refs[path+".ca"] = tls.CA
+		refs[path+".cert"] = tls.Cert
+		refs[path+".key"] = tls.Key
+	}
+
+	if cfg.Spec.Exporters.OTLPHTTPExporter.IsEnabled() {
+		addTLSReferences("spec.exporters.otlp_http.tls", cfg.Spec.Exporters.OTLPHTTPExporter.TLS)
+		refs["spec.exporters.otlp_http.token"] = cfg.Spec.Exporters.OTLPHTTPExporter.Token
+	}
+	if cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled() {
+		addTLSReferences("spec.exporters.otlp_grpc.tls", cfg.Spec.Exporters.OTLPGRPCExporter.TLS)
+		refs["spec.exporters.otlp_grpc.token"] = cfg.Spec.Exporters.OTLPGRPCExporter.Token
+	}
+	for i, exporter := range cfg.Spec.Exporters.Named {
+		if !exporter.IsEnabled() {
+			continue
+		}
+
+		path := fmt.Sprintf("spec.exporters.named[%d].%s", i, exporter.ExporterType())
+		tls, token := getNamedExporterReferences(exporter)
+		addTLSReferences(path+".tls", tls)
+		refs[path+".token"] = token
+	}
+
+	refs["spec.receivers.otlp.auth.token"] = cfg.Spec.Receivers.OTLPReceiver.Auth.Token
+	refs["spec.receivers.otlp.auth.htpasswd"] = cfg.Spec.Receivers.OTLPReceiver.Auth.Htpasswd
+
+	for i, sc := range cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs {
+		refs[fmt.Sprintf("spec.receivers.prometheus.scrape_configs[%d].credentials", i)] = scrapeConfigCredentials(sc)
+	}
+
+	maps.DeleteFunc(refs, func(_ string, ref *config.ResourceReference) bool {
+		return ref == nil
+	})
+
+	return refs
+}
+
+// validateSecretReferences validates that the data keys referenced by the
+// given [config.CollectorConfig] exist in the referenced Secrets, which are
+// otherwise mounted as empty files into the collector. The reconciliation is
+// requeued, if a referenced Secret has not been copied into the namespace of
+// the cluster yet.
+func (a *Actuator) validateSecretReferences(
+	ctx context.Context,
+	namespace string,
+	cfg config.CollectorConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+) error {
+	refs := getSecretReferences(cfg)
+	for _, path := range slices.Sorted(maps.Keys(refs)) {
+		ref := refs[path]
+		name := secretNameForResource(ref.ResourceRef.Name, resources)
+		if name == "" {
+			return fmt.Errorf("%s references unknown secret resource %s", path, ref.ResourceRef.Name)
+		}
+
+		var secret corev1.Secret
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return &reconcilerutils.RequeueAfterError{
+					Cause:        fmt.Errorf("referenced secret %s of %s is not synced yet: %w", name, path, err),
+					RequeueAfter: referencedSecretRequeueInterval,
+				}
+			}
+
+			return fmt.Errorf("failed to get referenced secret %s: %w", name, err)
+		}
+
+		data, ok := secret.Data[ref.ResourceRef.DataKey]
+		if !ok {
+			return fmt.Errorf("%w: %s references data key %q of secret resource %s", ErrMissingDataKey, path, ref.ResourceRef.DataKey, ref.ResourceRef.Name)
+		}
+
+		// The basicauth extension refuses all clients with an empty or
+		// malformed htpasswd file, but the collector starts anyway.
+		if ref == cfg.Spec.Receivers.OTLPReceiver.Auth.Htpasswd {
+			if err := validateHtpasswd(data); err != nil {
+				return fmt.Errorf("%w: %s references data key %q of secret resource %s: %w", ErrInvalidHtpasswd, path, ref.ResourceRef.DataKey, ref.ResourceRef.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateHtpasswd returns an error, if the given htpasswd file does not
+// contain at least one entry, or contains an entry without a user name or
+// password hash, or the same user name twice. Blank lines and comments are
+// ignored. The errors refer to the line numbers only, in order to not leak
+// the credentials.
+func validateHtpasswd(data []byte) error {
+	users := sets.New[string]()
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		user, hash, ok := strings.Cut(line, ":")
+		switch {
+		case !ok || user == "" || hash == "":
+			return fmt.Errorf("line %d is not of the form user:hash", i+1)
+		case users.Has(user):
+			return fmt.Errorf("line %d specifies a duplicate user", i+1)
+		}
+		users.Insert(user)
+	}
+
+	if users.Len() == 0 {
+		return errors.New("no credentials specified")
+	}
+
+	return nil
+}
+
+// getSecretReferencesChecksum returns the checksum of the data keys referenced
+// by the given [config.CollectorConfig], which changes when the referenced
+// Secrets are rotated. An empty checksum is returned, if no Secrets are
+// referenced.
+func (a *Actuator) getSecretReferencesChecksum(
+	ctx context.Context,
+	namespace string,
+	cfg config.CollectorConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+) (string, error) {
+	refs := getSecretReferences(cfg)
+	if len(refs) == 0 {
+		return "", nil
+	}
+
+	data := make(map[string][]byte, len(refs))
+	for path, ref := range refs {
+		name := secretNameForResource(ref.ResourceRef.Name, resources)
+
+		var secret corev1.Secret
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+			return "", fmt.Errorf("failed to get referenced secret %s: %w", name, err)
+		}
+
+		data[path] = secret.Data[ref.ResourceRef.DataKey]
+	}
+
+	return utils.ComputeChecksum(data), nil
+}
+
+// validateVolumeReferences validates that the resources referenced by the
+// additional volumes of the given [config.CollectorConfig] contain the
+// referenced data keys, since the collector pod fails to start otherwise. The
+// reconciliation is requeued, if a referenced resource has not been copied
+// into the namespace of the cluster yet.
+func (a *Actuator) validateVolumeReferences(
+	ctx context.Context,
+	namespace string,
+	cfg config.CollectorConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+) error {
+	for i, volume := range cfg.Spec.Volumes {
+		if volume.ResourceRef == nil {
+			continue
+		}
+
+		path := fmt.Sprintf("spec.volumes[%d].resourceRef", i)
+		var (
+			obj  client.Object
+			keys func() []string
+		)
+		if name := secretNameForResource(volume.ResourceRef.Name, resources); name != "" {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			obj, keys = secret, func() []string { return slices.Collect(maps.Keys(secret.Data)) }
+		} else if name := configMapNameForResource(volume.ResourceRef.Name, resources); name != "" {
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			obj, keys = configMap, func() []string {
+				return append(slices.Collect(maps.Keys(configMap.Data)), slices.Collect(maps.Keys(configMap.BinaryData))...)
+			}
+		} else {
+			return fmt.Errorf("%s references unknown secret or configmap resource %s", path, volume.ResourceRef.Name)
+		}
+
+		if err := a.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return &reconcilerutils.RequeueAfterError{
+					Cause:        fmt.Errorf("referenced resource %s of %s is not synced yet: %w", obj.GetName(), path, err),
+					RequeueAfter: referencedSecretRequeueInterval,
+				}
+			}
+
+			return fmt.Errorf("failed to get referenced resource %s: %w", obj.GetName(), err)
+		}
+
+		if !slices.Contains(keys(), volume.ResourceRef.DataKey) {
+			return fmt.Errorf("%w: %s references data key %q of resource %s", ErrMissingDataKey, path, volume.ResourceRef.DataKey, volume.ResourceRef.Name)
+		}
+	}
+
+	return nil
+}
+
+// getReferencedConfig returns the collector configuration from the ConfigMap
+// key referenced by the given reference, or nil if no configuration is
+// referenced.
+func (a *Actuator) getReferencedConfig(
+	ctx context.Context,
+	namespace string,
+	ref *config.ResourceReference,
+	resources []gardencorev1beta1.NamedResourceReference,
+) (*otelv1beta1.Config, error) {
+	if ref == nil {
+		return nil, nil
+	}
+
+	name := configMapNameForResource(ref.ResourceRef.Name, resources)
+	if name == "" {
+		return nil, fmt.Errorf("collector configuration references unknown configmap resource %s", ref.ResourceRef.Name)
+	}
+
+	var configMap corev1.ConfigMap
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &configMap); err != nil {
+		return nil, fmt.Errorf("failed to get referenced collector configuration: %w", err)
+	}
+
+	data, ok := configMap.Data[ref.ResourceRef.DataKey]
+	if !ok {
+		return nil, fmt.Errorf("referenced collector configuration has no %q data key", ref.ResourceRef.DataKey)
+	}
+
+	var otelConfig otelv1beta1.Config
+	if err := yaml.Unmarshal([]byte(data), &otelConfig); err != nil {
+		return nil, fmt.Errorf("invalid referenced collector configuration: %w", err)
+	}
+
+	return &otelConfig, nil
+}
+
+func (a *Actuator) newSecretsManager(ctx context.Context, log logr.Logger, namespace string) (secretsmanager.Interface, error) {
+	return secretsmanager.New(
+		ctx,
+		log,
+		clock.RealClock{},
+		a.client,
+		secretsManagerIdentity,
+		secretsmanager.WithCASecretAutoRotation(),
+		secretsmanager.WithNamespaces(namespace),
+	)
+}
+
+// getImagePullSecret returns a copy of the configured image pull secret for
+// the given namespace, or nil if no image pull secret is configured.
+func (a *Actuator) getImagePullSecret(ctx context.Context, namespace string) (*corev1.Secret, error) {
+	if a.imagePullSecret.Name == "" {
+		return nil, nil
+	}
+
+	var secret corev1.Secret
+	if err := a.client.Get(ctx, a.imagePullSecret, &secret); err != nil {
+		return nil, fmt.Errorf("failed to get image pull secret: %w", err)
+	}
+
+	obj := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      imagePullSecretName,
+			Namespace: namespace,
+			Labels:    a.getCommonLabels(),
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}
+
+	return obj, nil
+}
+
+// isDefaultExporterEnabled returns whether the default exporter is added to the
+// collector of the given cluster, which is nil for seed-class extensions.
+func (a *Actuator) isDefaultExporterEnabled(cluster *extensionscontroller.Cluster) bool {
+	if a.defaultExporterEndpoint == "" {
+		return false
+	}
+
+	if cluster == nil || cluster.Shoot == nil {
+		return true
+	}
+
+	return cluster.Shoot.Annotations[AnnotationDisableDefaultExporter] != "true"
+}
+
+// isDuplicateOfDefaultExporter returns whether the OTLP HTTP exporter of the
+// given [config.CollectorConfig] exports to the endpoint of the default
+// exporter.
+func (a *Actuator) isDuplicateOfDefaultExporter(cfg config.CollectorConfig) bool {
+	exporter := cfg.Spec.Exporters.OTLPHTTPExporter
+	if a.defaultExporterEndpoint == "" || !exporter.IsEnabled() {
+		return false
+	}
+
+	return strings.TrimSuffix(exporter.Endpoint, "/") == strings.TrimSuffix(a.defaultExporterEndpoint, "/")
+}
+
+// getDefaultExporterSecret returns a copy of the secret of the default
+// exporter for the given namespace, or nil if no secret is configured.
+func (a *Actuator) getDefaultExporterSecret(ctx context.Context, namespace string) (*corev1.Secret, error) {
+	if a.defaultExporterSecret.Name == "" {
+		return nil, nil
+	}
+
+	var secret corev1.Secret
+	if err := a.client.Get(ctx, a.defaultExporterSecret, &secret); err != nil {
+		return nil, fmt.Errorf("failed to get default exporter secret: %w", err)
+	}
+
+	if _, ok := secret.Data[defaultExporterSecretDataKey]; !ok {
+		return nil, fmt.Errorf("default exporter secret %s has no %q data key", a.defaultExporterSecret, defaultExporterSecretDataKey)
+	}
+
+	obj := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultExporterSecretName,
+			Namespace: namespace,
+			Labels:    a.getCommonLabels(),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			defaultExporterSecretDataKey: secret.Data[defaultExporterSecretDataKey],
+		},
+	}
+
+	return obj, nil
+}
+
+// getImagePullSecrets returns the image pull secrets for the pods of the
+// collector and Target Allocator.
+func (a *Actuator) getImagePullSecrets() []corev1.LocalObjectReference {
+	if a.imagePullSecret.Name == "" {
+		return nil
+	}
+
+	return []corev1.LocalObjectReference{{Name: imagePullSecretName}}
+}
+
+// getCommonLabels returns the common set of labels for the Collector and Target
+// Allocator resources.
+func (a *Actuator) getCommonLabels() map[string]string {
+	items := map[string]string{
+		v1beta1constants.LabelRole:                     v1beta1constants.LabelObservability,
+		v1beta1constants.GardenRole:                    v1beta1constants.GardenRoleObservability,
+		v1beta1constants.LabelObservabilityApplication: otelCollectorName,
+	}
+
+	return items
+}
+
+// getNetworkLabels returns the set of labels related to Gardener Network
+// Policies.
+func (a *Actuator) getNetworkLabels() map[string]string {
+	// The `networking.resources.gardener.cloud/to-all-scrape-targets' label
+	toAllScrapeTargetsLabel := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + v1beta1constants.LabelNetworkPolicyScrapeTargets
+
+	// The label, which allows the traffic to the Target Allocator.
+	toTargetAllocatorLabel := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + targetAllocatorHTTPSServiceName + "-tcp-" + strconv.Itoa(targetAllocatorHTTPSPort)
+	if !a.targetAllocatorMTLS {
+		toTargetAllocatorLabel = resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + targetAllocatorServiceName + "-tcp-" + strconv.Itoa(targetAllocatorContainerPort)
+	}
+
+	items := map[string]string{
+		v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+		v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+		v1beta1constants.LabelNetworkPolicyToPrivateNetworks:  v1beta1constants.LabelNetworkPolicyAllowed,
+		v1beta1constants.LabelNetworkPolicyToPublicNetworks:   v1beta1constants.LabelNetworkPolicyAllowed,
+		toTargetAllocatorLabel:                                v1beta1constants.LabelNetworkPolicyAllowed,
+		toAllScrapeTargetsLabel:                               v1beta1constants.LabelNetworkPolicyAllowed,
+	}
+
+	return items
+}
+
+// getAnnotations returns the common set of annotations for the Collector and
+// Target Allocator resources. The given additional ports are allowed along
+// with the port of the internal metrics, and the port of the OTLP gRPC
+// receiver, if it is exposed to all scrape targets.
+func (a *Actuator) getAnnotations(additionalPorts []config.PortConfig, exposeOTLPReceiver bool) map[string]string {
+	// The `networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports' annotation
 	fromAllScrapeTargetsAnnotation := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "from-all-scrape-targets-allowed-ports"
 
+	allowedPorts := []string{
+		fmt.Sprintf(`{"protocol":"TCP","port":%d}`, otelCollectorMetricsPort),
+	}
+	if exposeOTLPReceiver {
+		allowedPorts = append(allowedPorts, fmt.Sprintf(`{"protocol":"TCP","port":%d}`, otelCollectorGRPCReceiverPort))
+	}
+	for _, port := range additionalPorts {
+		allowedPorts = append(allowedPorts, fmt.Sprintf(`{"protocol":%q,"port":%d}`, port.Protocol, port.Port))
+	}
+
 	items := map[string]string{
-		fromAllScrapeTargetsAnnotation: fmt.Sprintf(`[{"protocol":"TCP","port":%d},{"protocol":"TCP","port":%d}]`, otelCollectorMetricsPort, otelCollectorGRPCReceiverPort),
+		fromAllScrapeTargetsAnnotation: "[" + strings.Join(allowedPorts, ",") + "]",
 	}
 
 	return items
 }
 
+// getAdditionalPorts returns the additional ports of the collector from the
+// given [config.CollectorConfig], including the ports of the optional
+// receivers.
+func getAdditionalPorts(cfg config.CollectorConfig) []config.PortConfig {
+	ports := slices.Clone(cfg.Spec.Ports)
+
+	if statsd := cfg.Spec.Receivers.StatsDReceiver; statsd.IsEnabled() {
+		if _, port, err := net.SplitHostPort(statsd.Endpoint); err == nil {
+			if n, err := strconv.ParseInt(port, 10, 32); err == nil {
+				ports = append(ports, config.PortConfig{
+					Name:     config.ReceiverNameStatsD,
+					Port:     int32(n),
+					Protocol: corev1.ProtocolUDP,
+				})
+			}
+		}
+	}
+
+	return ports
+}
+
+// getPortsSpecs returns the given additional ports of the collector as
+// [otelv1beta1.PortsSpec] items.
+func getPortsSpecs(additionalPorts []config.PortConfig) []otelv1beta1.PortsSpec {
+	if len(additionalPorts) == 0 {
+		return nil
+	}
+
+	ports := make([]otelv1beta1.PortsSpec, 0, len(additionalPorts))
+	for _, port := range additionalPorts {
+		ports = append(ports, otelv1beta1.PortsSpec{
+			ServicePort: corev1.ServicePort{
+				Name:       port.Name,
+				Port:       port.Port,
+				Protocol:   port.Protocol,
+				TargetPort: intstr.FromInt32(port.Port),
+			},
+		})
+	}
+
+	return ports
+}
+
+// setSecretsChecksum annotates the pods of the given
+// [otelv1beta1.OpenTelemetryCollector] with the given checksum of the
+// referenced secrets, so that the pods are rolled out, when the secrets are
+// rotated.
+func setSecretsChecksum(obj *otelv1beta1.OpenTelemetryCollector, checksum string) {
+	if checksum == "" {
+		return
+	}
+
+	obj.Spec.PodAnnotations = getPodAnnotations(obj.Spec.PodAnnotations, map[string]string{
+		AnnotationChecksumSecretReferences: checksum,
+	})
+}
+
+// getPodAnnotations returns the given additional pod annotations merged with
+// the given annotations managed by the extension, which take precedence.
+func getPodAnnotations(additional, managed map[string]string) map[string]string {
+	if len(additional) == 0 && len(managed) == 0 {
+		return nil
+	}
+
+	return utils.MergeStringMaps(additional, managed)
+}
+
 // getTargetAllocatorServiceAccount returns the [corev1.ServiceAccount] for the
 // Target Allocator.
 func (a *Actuator) getTargetAllocatorServiceAccount(namespace string) *corev1.ServiceAccount {
@@ -640,6 +2311,7 @@ func (a *Actuator) getTargetAllocatorServiceAccount(namespace string) *corev1.Se
 			Labels:    a.getCommonLabels(),
 		},
 		AutomountServiceAccountToken: new(false),
+		ImagePullSecrets:             a.getImagePullSecrets(),
 	}
 
 	return obj
@@ -668,9 +2340,61 @@ func (a *Actuator) getTargetAllocatorHTTPSService(namespace string) *corev1.Serv
 	}
 }
 
+// getTargetAllocatorMetricsService returns the [corev1.Service], which exposes
+// the metrics of the Target Allocator to the self-monitoring scrape job of the
+// collector.
+func (a *Actuator) getTargetAllocatorMetricsService(namespace string) *corev1.Service {
+	// The `networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports' annotation
+	fromAllScrapeTargetsAnnotation := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "from-all-scrape-targets-allowed-ports"
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      targetAllocatorMetricsServiceName,
+			Namespace: namespace,
+			Labels:    a.getCommonLabels(),
+			Annotations: map[string]string{
+				fromAllScrapeTargetsAnnotation: fmt.Sprintf(`[{"protocol":"TCP","port":%d}]`, targetAllocatorMetricsPort),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{{
+				Name:       "metrics",
+				Port:       targetAllocatorMetricsPort,
+				Protocol:   corev1.ProtocolTCP,
+				TargetPort: intstr.FromInt32(targetAllocatorMetricsPort),
+			}},
+			Selector: map[string]string{
+				labelKeyComponent: labelValueTargetAllocator,
+			},
+		},
+	}
+}
+
 // getTargetAllocatorConfigMap returns the [corev1.ConfigMap] for the Target
 // Allocator.
-func (a *Actuator) getTargetAllocatorConfigMap(namespace string) (*corev1.ConfigMap, error) {
+func (a *Actuator) getTargetAllocatorConfigMap(namespace string, cfg config.TargetAllocatorConfig) (*corev1.ConfigMap, error) {
+	prometheusCR := map[string]any{
+		configKeyEnabled:         true,
+		"allow_namespaces":       []string{namespace},
+		"scrape_interval":        getTargetAllocatorScrapeInterval(cfg),
+		"scrape_config_selector": nil,
+		"probe_selector":         nil,
+		"pod_monitor_selector":   nil,
+		"deny_namespaces":        nil,
+		"service_monitor_selector": map[string]any{
+			"matchLabels": map[string]any{
+				configKeyPrometheus: labelValuePrometheusShoot,
+			},
+		},
+	}
+
+	// The Role of the Target Allocator grants access to both, Endpoints and
+	// EndpointSlices.
+	if cfg.ServiceDiscoveryRole != "" {
+		prometheusCR["service_discovery_role"] = string(cfg.ServiceDiscoveryRole)
+	}
+
 	taConfig := map[string]any{
 		"allocation_strategy":              otelv1alpha1.OpenTelemetryTargetAllocatorAllocationStrategyConsistentHashing,
 		"collector_not_ready_grace_period": 30 * time.Second,
@@ -685,20 +2409,7 @@ func (a *Actuator) getTargetAllocatorConfigMap(namespace string) (*corev1.Config
 			},
 		},
 		"filter_strategy": "relabel-config",
-		"prometheus_cr": map[string]any{
-			configKeyEnabled:         true,
-			"allow_namespaces":       []string{namespace},
-			"scrape_interval":        30 * time.Second,
-			"scrape_config_selector": nil,
-			"probe_selector":         nil,
-			"pod_monitor_selector":   nil,
-			"deny_namespaces":        nil,
-			"service_monitor_selector": map[string]any{
-				"matchLabels": map[string]any{
-					configKeyPrometheus: labelValuePrometheusShoot,
-				},
-			},
-		},
+		"prometheus_cr":   prometheusCR,
 	}
 
 	data, err := yaml.Marshal(taConfig)
@@ -720,7 +2431,40 @@ func (a *Actuator) getTargetAllocatorConfigMap(namespace string) (*corev1.Config
 	return configMap, nil
 }
 
-// getTargetAllocatorRole returns the [rbacv1.Role] for the Target Allocator.
+// targetAllocatorConfigSummary provides a summary of the configuration of the
+// Target Allocator, which is exposed via the [AnnotationTargetAllocatorConfig]
+// annotation.
+type targetAllocatorConfigSummary struct {
+	Checksum           string         `json:"checksum" yaml:"-"`
+	AllocationStrategy string         `json:"allocation_strategy" yaml:"allocation_strategy"`
+	FilterStrategy     string         `json:"filter_strategy" yaml:"filter_strategy"`
+	CollectorSelector  map[string]any `json:"collector_selector" yaml:"collector_selector"`
+	PrometheusCR       struct {
+		AllowNamespaces        []string       `json:"allow_namespaces" yaml:"allow_namespaces"`
+		ServiceMonitorSelector map[string]any `json:"service_monitor_selector" yaml:"service_monitor_selector"`
+		ServiceDiscoveryRole   string         `json:"service_discovery_role,omitempty" yaml:"service_discovery_role"`
+	} `json:"prometheus_cr" yaml:"prometheus_cr"`
+}
+
+// getTargetAllocatorConfigSummary returns the summary of the configuration of
+// the Target Allocator from the given [corev1.ConfigMap] as JSON, along with
+// the checksum of the whole configuration.
+func getTargetAllocatorConfigSummary(configMap *corev1.ConfigMap) (string, error) {
+	var summary targetAllocatorConfigSummary
+	if err := yaml.Unmarshal([]byte(configMap.Data["targetallocator.yaml"]), &summary); err != nil {
+		return "", fmt.Errorf("failed to parse target allocator configuration: %w", err)
+	}
+	summary.Checksum = utils.ComputeChecksum(configMap.Data)
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal target allocator configuration summary: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// getTargetAllocatorRole returns the [rbacv1.Role] for the Target Allocator.
 func (a *Actuator) getTargetAllocatorRole(namespace string) *rbacv1.Role {
 	return &rbacv1.Role{
 		ObjectMeta: metav1.ObjectMeta{
@@ -802,7 +2546,13 @@ func (a *Actuator) getTargetAllocatorRoleBinding(namespace string) *rbacv1.RoleB
 // - Deployment for the TargetAllocator (getTargetAllocatorDeployment)
 // - ConfigMap for the TargetAllocator (getTargetAllocatorConfigMap)
 // - HTTPS Service for the Target Allocator (getTargetAllocatorHTTPSService)
-func (a *Actuator) getTargetAllocatorDeployment(namespace string, caSecret, serverSecret *corev1.Secret, image *imagevectorutils.Image) *appsv1.Deployment {
+// - Metrics Service for the Target Allocator (getTargetAllocatorMetricsService)
+func (a *Actuator) getTargetAllocatorDeployment(
+	namespace string,
+	caSecret, serverSecret *corev1.Secret,
+	image *imagevectorutils.Image,
+	cfg config.CollectorConfig,
+) *appsv1.Deployment {
 	const (
 		volumeNameCACertificate      = "ca-cert"
 		volumeMountPathCACertificate = "/etc/ssl/certs/ca"
@@ -830,34 +2580,39 @@ func (a *Actuator) getTargetAllocatorDeployment(namespace string, caSecret, serv
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas:             new(targetAllocatorReplicas),
-			RevisionHistoryLimit: ptr.To[int32](2),
+			RevisionHistoryLimit: cmp.Or(cfg.Spec.TargetAllocator.RevisionHistoryLimit, ptr.To[int32](targetAllocatorRevisionHistoryLimit)),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: allLabels,
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: allLabels,
+					Labels:      allLabels,
+					Annotations: getPodAnnotations(cfg.Spec.PodAnnotations, nil),
 				},
 				Spec: corev1.PodSpec{
 					PriorityClassName:  v1beta1constants.PriorityClassNameShootControlPlane100,
 					ServiceAccountName: targetAllocatorServiceAccountName,
+					DNSPolicy:          cfg.Spec.DNS.Policy,
+					DNSConfig:          cfg.Spec.DNS.Config,
+					ImagePullSecrets:   a.getImagePullSecrets(),
 					SecurityContext: &corev1.PodSecurityContext{
-						RunAsNonRoot: new(true),
-						RunAsUser:    ptr.To[int64](65532),
-						RunAsGroup:   ptr.To[int64](65532),
-						FSGroup:      ptr.To[int64](65532),
+						RunAsNonRoot:   new(true),
+						RunAsUser:      ptr.To[int64](65532),
+						RunAsGroup:     ptr.To[int64](65532),
+						FSGroup:        ptr.To[int64](65532),
+						SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
 					},
 					Containers: []corev1.Container{
 						{
 							Name:  "ta-container",
 							Image: image.String(),
-							Args: []string{
+							Args: append([]string{
 								"--enable-https-server=true",
 								fmt.Sprintf("--config-file=%s/targetallocator.yaml", volumeMountTargetAllocatorConfig),
 								fmt.Sprintf("--https-ca-file=%s/%s", volumeMountPathCACertificate, secretsutils.DataKeyCertificateBundle),
 								fmt.Sprintf("--https-tls-cert-file=%s/%s", volumeMountPathServerCertificate, secretsutils.DataKeyCertificate),
 								fmt.Sprintf("--https-tls-key-file=%s/%s", volumeMountPathServerCertificate, secretsutils.DataKeyPrivateKey),
-							},
+							}, getTargetAllocatorLogArgs(cfg.Spec.TargetAllocator.LogLevel)...),
 							Resources: corev1.ResourceRequirements{
 								Requests: corev1.ResourceList{
 									corev1.ResourceCPU:    resource.MustParse("10m"),
@@ -885,21 +2640,138 @@ func (a *Actuator) getTargetAllocatorDeployment(namespace string, caSecret, serv
 	}
 }
 
+// getTargetAllocator returns the upstream [otelv1alpha1.TargetAllocator]
+// resource, which is deployed instead of the Target Allocator managed by the
+// extension, when mTLS between the Collector and the Target Allocator is
+// disabled via [WithTargetAllocatorMTLS]. The OTel Operator configures the
+// communication via plain HTTP in this case, hence the Target Allocator does
+// not deliver the secrets of the scrape targets.
+func (a *Actuator) getTargetAllocator(
+	namespace string,
+	image *imagevectorutils.Image,
+	cfg config.CollectorConfig,
+) *otelv1alpha1.TargetAllocator {
+	obj := &otelv1alpha1.TargetAllocator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      otelCollectorName,
+			Namespace: namespace,
+			// The labels are propagated to the pods of the Target
+			// Allocator by the OTel Operator.
+			Labels: utils.MergeStringMaps(
+				a.getCommonLabels(),
+				map[string]string{
+					v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+					v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+				},
+			),
+		},
+		Spec: otelv1alpha1.TargetAllocatorSpec{
+			OpenTelemetryCommonFields: otelv1beta1.OpenTelemetryCommonFields{
+				Image:             image.String(),
+				Replicas:          new(targetAllocatorReplicas),
+				PodAnnotations:    getPodAnnotations(cfg.Spec.PodAnnotations, nil),
+				PriorityClassName: v1beta1constants.PriorityClassNameShootControlPlane100,
+				ServiceAccount:    targetAllocatorServiceAccountName,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("10m"),
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: new(false),
+				},
+				PodSecurityContext: &corev1.PodSecurityContext{
+					RunAsNonRoot:   new(true),
+					RunAsUser:      ptr.To[int64](65532),
+					RunAsGroup:     ptr.To[int64](65532),
+					FSGroup:        ptr.To[int64](65532),
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			},
+			AllocationStrategy:           otelv1beta1.TargetAllocatorAllocationStrategyConsistentHashing,
+			FilterStrategy:               otelv1beta1.TargetAllocatorFilterStrategyRelabelConfig,
+			CollectorNotReadyGracePeriod: &metav1.Duration{Duration: 30 * time.Second},
+			PrometheusCR: otelv1beta1.TargetAllocatorPrometheusCR{
+				Enabled:         true,
+				AllowNamespaces: []string{namespace},
+				ScrapeInterval:  &metav1.Duration{Duration: getTargetAllocatorScrapeInterval(cfg.Spec.TargetAllocator)},
+				ServiceMonitorSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						configKeyPrometheus: labelValuePrometheusShoot,
+					},
+				},
+			},
+		},
+	}
+
+	if cfg.Spec.DNS.Policy != "" {
+		obj.Spec.DNSPolicy = new(cfg.Spec.DNS.Policy)
+	}
+	if cfg.Spec.DNS.Config != nil {
+		obj.Spec.PodDNSConfig = *cfg.Spec.DNS.Config
+	}
+
+	// The OTel Operator renders the args as `--key=value' flags.
+	if level := cfg.Spec.TargetAllocator.LogLevel; level != "" {
+		obj.Spec.Args = map[string]string{
+			targetAllocatorLogLevelFlag: strings.ToLower(string(level)),
+		}
+	}
+
+	return obj
+}
+
+// targetAllocatorLogLevelFlag is the flag of the Target Allocator, which
+// specifies its log level.
+const targetAllocatorLogLevelFlag = "zap-log-level"
+
+// getTargetAllocatorLogArgs returns the args of the Target Allocator for the
+// given log level, or nil, if the default log level of the Target Allocator is
+// used.
+func getTargetAllocatorLogArgs(level config.LogLevel) []string {
+	if level == "" {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("--%s=%s", targetAllocatorLogLevelFlag, strings.ToLower(string(level)))}
+}
+
 // getOtelCollectorServiceAccount returns the [corev1.ServiceAccount] for the
 // the OTel Collector.
-func (a *Actuator) getOtelCollectorServiceAccount(namespace string) *corev1.ServiceAccount {
+func (a *Actuator) getOtelCollectorServiceAccount(namespace string, cfg config.CollectorConfig) *corev1.ServiceAccount {
 	obj := &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      otelCollectorServiceAccountName,
-			Namespace: namespace,
-			Labels:    a.getCommonLabels(),
+			Name:        otelCollectorServiceAccountName,
+			Namespace:   namespace,
+			Labels:      a.getCommonLabels(),
+			Annotations: getServiceAccountAnnotations(cfg),
 		},
 		AutomountServiceAccountToken: new(false),
+		// The OTel Operator does not expose the image pull secrets of
+		// the collector pods, which inherit them from the service
+		// account instead.
+		ImagePullSecrets: a.getImagePullSecrets(),
 	}
 
 	return obj
 }
 
+// getServiceAccountAnnotations returns the annotations of the service account
+// of the collector, which bind it to the identities of the cloud providers used
+// by the exporters. The additional annotations of the given
+// [config.CollectorConfig] must not conflict with the managed ones.
+func getServiceAccountAnnotations(cfg config.CollectorConfig) map[string]string {
+	annotations := maps.Clone(cfg.Spec.ServiceAccountAnnotations)
+	if exporter := cfg.Spec.Exporters.GoogleCloudExporter; exporter.IsEnabled() {
+		annotations = utils.MergeStringMaps(annotations, map[string]string{
+			config.AnnotationGCPServiceAccount: exporter.ServiceAccount,
+		})
+	}
+
+	return annotations
+}
+
 // getDebugExporterConfig returns the OTel settings for the debug exporter.
 func (a *Actuator) getDebugExporterConfig(cfg config.DebugExporterConfig) map[string]any {
 	// See the link below for more details about each config setting for the
@@ -913,9 +2785,27 @@ func (a *Actuator) getDebugExporterConfig(cfg config.DebugExporterConfig) map[st
 	return exporter
 }
 
+// debugFileExporterMaxMegabytes specifies the size (in megabytes), at which
+// the file with the debug output is rotated. Only a single rotated file is
+// retained, so that the debug output does not exhaust the disk of the node.
+const debugFileExporterMaxMegabytes = 100
+
+// getDebugFileExporterConfig returns the OTel settings for the file exporter,
+// which writes the debug output to a file instead of the standard output.
+func (a *Actuator) getDebugFileExporterConfig(cfg config.DebugExporterConfig) map[string]any {
+	return a.getFileExporterConfig(config.FileExporterConfig{
+		Path:   cfg.Path,
+		Format: config.FileExporterFormatJSON,
+		Rotation: config.FileExporterRotationConfig{
+			MaxMegabytes: debugFileExporterMaxMegabytes,
+			MaxBackups:   1,
+		},
+	})
+}
+
 // getOTLPHTTPExporterConfig returns the OTel settings for the OTLP HTTP
 // exporter.
-func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig) map[string]any {
+func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig, tlsMountPath, authenticator string) map[string]any {
 	exporter := map[string]any{}
 
 	// See the link below for more details about each config setting of the
@@ -950,13 +2840,22 @@ func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig)
 
 	// Retry on Failure settings
 	if cfg.RetryOnFailure.Enabled != nil {
-		exporter["retry_on_failure"] = map[string]any{
+		retryOnFailure := map[string]any{
 			configKeyEnabled:   *cfg.RetryOnFailure.Enabled,
 			"initial_interval": cfg.RetryOnFailure.InitialInterval.String(),
 			"max_interval":     cfg.RetryOnFailure.MaxInterval.String(),
-			"max_elapsed_time": cfg.RetryOnFailure.MaxElapsedTime.String(),
 			"multiplier":       cfg.RetryOnFailure.Multiplier,
 		}
+		// An explicit zero value disables the limit of the retries.
+		if cfg.RetryOnFailure.MaxElapsedTime != nil {
+			retryOnFailure["max_elapsed_time"] = cfg.RetryOnFailure.MaxElapsedTime.String()
+		}
+		exporter["retry_on_failure"] = retryOnFailure
+	}
+
+	// Sending Queue settings
+	if cfg.SendingQueue.Enabled != nil {
+		exporter["sending_queue"] = getSendingQueueConfig(cfg.SendingQueue)
 	}
 
 	// TLS settings
@@ -966,16 +2865,20 @@ func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig)
 			tlsConfig["insecure_skip_verify"] = *tls.InsecureSkipVerify
 		}
 		if tls.CA != nil {
-			tlsConfig["ca_file"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.CA.ResourceRef.DataKey)
+			tlsConfig["ca_file"] = filepath.Join(tlsMountPath, tls.CA.ResourceRef.DataKey)
+			if ptr.Deref(tls.IncludeSystemCAs, false) {
+				tlsConfig["include_system_ca_certs_pool"] = true
+			}
 		}
 		if tls.Cert != nil {
-			tlsConfig["cert_file"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.Cert.ResourceRef.DataKey)
+			tlsConfig["cert_file"] = filepath.Join(tlsMountPath, tls.Cert.ResourceRef.DataKey)
 		}
 		if tls.Key != nil {
-			tlsConfig["key_file"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.Key.ResourceRef.DataKey)
+			tlsConfig["key_file"] = filepath.Join(tlsMountPath, tls.Key.ResourceRef.DataKey)
 		}
 
 		tlsConfig["reload_interval"] = tls.ReloadInterval.String()
+		setTLSVersionConfig(tlsConfig, tls)
 
 		exporter["tls"] = tlsConfig
 	}
@@ -983,16 +2886,61 @@ func (a *Actuator) getOTLPHTTPExporterConfig(cfg config.OTLPHTTPExporterConfig)
 	// Bearer Token Authentication settings
 	if cfg.Token != nil {
 		exporter["auth"] = map[string]any{
-			"authenticator": httpExporterBearerTokenAuthName,
+			"authenticator": authenticator,
+		}
+	}
+
+	// Cookie handling settings, e.g. for session affinity
+	if cfg.Cookies.IsEnabled() {
+		exporter["cookies"] = map[string]any{
+			configKeyEnabled: true,
 		}
 	}
 
 	return exporter
 }
 
+// setTLSVersionConfig sets the TLS versions and cipher suites from the given
+// [config.TLSConfig] in the TLS settings of an exporter.
+func setTLSVersionConfig(tlsConfig map[string]any, tls *config.TLSConfig) {
+	if tls.MinVersion != "" {
+		tlsConfig["min_version"] = string(tls.MinVersion)
+	}
+	if tls.MaxVersion != "" {
+		tlsConfig["max_version"] = string(tls.MaxVersion)
+	}
+	if len(tls.CipherSuites) > 0 {
+		tlsConfig["cipher_suites"] = tls.CipherSuites
+	}
+}
+
+// getSendingQueueConfig returns the OTel settings for the sending queue of an
+// exporter.
+func getSendingQueueConfig(cfg config.SendingQueueConfig) map[string]any {
+	queue := map[string]any{
+		configKeyEnabled:    *cfg.Enabled,
+		"num_consumers":     cfg.NumConsumers,
+		"queue_size":        cfg.QueueSize,
+		"block_on_overflow": cfg.IsBlockOnOverflowEnabled(),
+	}
+
+	// The requests are split only, when they exceed the maximum size, but
+	// never held back to be merged with other requests.
+	if cfg.Batch.IsEnabled() {
+		queue["batch"] = map[string]any{
+			"sizer":         string(cmp.Or(cfg.Batch.Sizer, config.RequestSizerItems)),
+			"min_size":      0,
+			"max_size":      cfg.Batch.MaxSize,
+			"flush_timeout": sendingQueueBatchFlushTimeout.String(),
+		}
+	}
+
+	return queue
+}
+
 // getOTLPGRPCExporterConfig returns the OTel settings for the OTLP gRPC
 // exporter.
-func (a *Actuator) getOTLPGRPCExporterConfig(cfg config.OTLPGRPCExporterConfig) map[string]any {
+func (a *Actuator) getOTLPGRPCExporterConfig(cfg config.OTLPGRPCExporterConfig, tlsMountPath, authenticator string) map[string]any {
 	// See the link below for more details about each config setting of the
 	// OTLP gRPC exporter.
 	//
@@ -1007,13 +2955,22 @@ func (a *Actuator) getOTLPGRPCExporterConfig(cfg config.OTLPGRPCExporterConfig)
 
 	// Retry on Failure settings
 	if cfg.RetryOnFailure.Enabled != nil {
-		exporter["retry_on_failure"] = map[string]any{
+		retryOnFailure := map[string]any{
 			configKeyEnabled:   *cfg.RetryOnFailure.Enabled,
 			"initial_interval": cfg.RetryOnFailure.InitialInterval.String(),
 			"max_interval":     cfg.RetryOnFailure.MaxInterval.String(),
-			"max_elapsed_time": cfg.RetryOnFailure.MaxElapsedTime.String(),
 			"multiplier":       cfg.RetryOnFailure.Multiplier,
 		}
+		// An explicit zero value disables the limit of the retries.
+		if cfg.RetryOnFailure.MaxElapsedTime != nil {
+			retryOnFailure["max_elapsed_time"] = cfg.RetryOnFailure.MaxElapsedTime.String()
+		}
+		exporter["retry_on_failure"] = retryOnFailure
+	}
+
+	// Sending Queue settings
+	if cfg.SendingQueue.Enabled != nil {
+		exporter["sending_queue"] = getSendingQueueConfig(cfg.SendingQueue)
 	}
 
 	// TLS settings
@@ -1023,16 +2980,20 @@ func (a *Actuator) getOTLPGRPCExporterConfig(cfg config.OTLPGRPCExporterConfig)
 			tlsConfig["insecure_skip_verify"] = *tls.InsecureSkipVerify
 		}
 		if tls.CA != nil {
-			tlsConfig["ca_file"] = filepath.Join(grpcExporterVolumeMountPathTLS, tls.CA.ResourceRef.DataKey)
+			tlsConfig["ca_file"] = filepath.Join(tlsMountPath, tls.CA.ResourceRef.DataKey)
+			if ptr.Deref(tls.IncludeSystemCAs, false) {
+				tlsConfig["include_system_ca_certs_pool"] = true
+			}
 		}
 		if tls.Cert != nil {
-			tlsConfig["cert_file"] = filepath.Join(grpcExporterVolumeMountPathTLS, tls.Cert.ResourceRef.DataKey)
+			tlsConfig["cert_file"] = filepath.Join(tlsMountPath, tls.Cert.ResourceRef.DataKey)
 		}
 		if tls.Key != nil {
-			tlsConfig["key_file"] = filepath.Join(grpcExporterVolumeMountPathTLS, tls.Key.ResourceRef.DataKey)
+			tlsConfig["key_file"] = filepath.Join(tlsMountPath, tls.Key.ResourceRef.DataKey)
 		}
 
 		tlsConfig["reload_interval"] = tls.ReloadInterval.String()
+		setTLSVersionConfig(tlsConfig, tls)
 
 		exporter["tls"] = tlsConfig
 	}
@@ -1040,7 +3001,7 @@ func (a *Actuator) getOTLPGRPCExporterConfig(cfg config.OTLPGRPCExporterConfig)
 	// Bearer Token Authentication settings
 	if cfg.Token != nil {
 		exporter["auth"] = map[string]any{
-			"authenticator": grpcExporterBearerTokenAuthName,
+			"authenticator": authenticator,
 		}
 	}
 
@@ -1053,20 +3014,214 @@ func (a *Actuator) getOtelExporters(cfg config.CollectorConfig) map[string]any {
 	exporters := make(map[string]any)
 
 	if cfg.Spec.Exporters.DebugExporter.IsEnabled() {
-		exporters["debug"] = a.getDebugExporterConfig(cfg.Spec.Exporters.DebugExporter)
+		if cfg.Spec.Exporters.DebugExporter.Path != "" {
+			exporters[config.ExporterNameDebugFile] = a.getDebugFileExporterConfig(cfg.Spec.Exporters.DebugExporter)
+		} else {
+			exporters[config.ExporterNameDebug] = a.getDebugExporterConfig(cfg.Spec.Exporters.DebugExporter)
+		}
 	}
 
 	if cfg.Spec.Exporters.OTLPHTTPExporter.IsEnabled() {
-		exporters["otlp_http"] = a.getOTLPHTTPExporterConfig(cfg.Spec.Exporters.OTLPHTTPExporter)
+		exporters[config.ExporterNameOTLPHTTP] = a.getOTLPHTTPExporterConfig(
+			cfg.Spec.Exporters.OTLPHTTPExporter,
+			httpExporterVolumeMountPathTLS,
+			httpExporterBearerTokenAuthName,
+		)
 	}
 
 	if cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled() {
-		exporters["otlp_grpc"] = a.getOTLPGRPCExporterConfig(cfg.Spec.Exporters.OTLPGRPCExporter)
+		exporters[config.ExporterNameOTLPGRPC] = a.getOTLPGRPCExporterConfig(
+			cfg.Spec.Exporters.OTLPGRPCExporter,
+			grpcExporterVolumeMountPathTLS,
+			grpcExporterBearerTokenAuthName,
+		)
+	}
+
+	if cfg.Spec.Exporters.FileExporter.IsEnabled() {
+		exporters[config.ExporterNameFile] = a.getFileExporterConfig(cfg.Spec.Exporters.FileExporter)
+	}
+
+	if cfg.Spec.Exporters.GoogleCloudExporter.IsEnabled() {
+		exporters[config.ExporterNameGoogleCloud] = a.getGoogleCloudExporterConfig(cfg.Spec.Exporters.GoogleCloudExporter)
+	}
+
+	for _, exporter := range cfg.Spec.Exporters.Named {
+		if !exporter.IsEnabled() {
+			continue
+		}
+
+		suffix := namedExporterResourceSuffix(exporter)
+		switch {
+		case exporter.OTLPGRPCExporter != nil:
+			exporters[exporter.ExporterName()] = a.getOTLPGRPCExporterConfig(
+				*exporter.OTLPGRPCExporter,
+				baseVolumeMountPathTLS+"-"+suffix,
+				baseBearerTokenAuthName+"/"+suffix,
+			)
+		case exporter.OTLPHTTPExporter != nil:
+			exporters[exporter.ExporterName()] = a.getOTLPHTTPExporterConfig(
+				*exporter.OTLPHTTPExporter,
+				baseVolumeMountPathTLS+"-"+suffix,
+				baseBearerTokenAuthName+"/"+suffix,
+			)
+		case exporter.DebugExporter != nil:
+			exporters[exporter.ExporterName()] = a.getDebugExporterConfig(*exporter.DebugExporter)
+		}
 	}
 
 	return exporters
 }
 
+// getNamedExporterReferences returns the TLS settings and the bearer token of
+// the given named exporter, which are supported by the OTLP exporters only.
+func getNamedExporterReferences(exporter config.NamedExporterConfig) (*config.TLSConfig, *config.ResourceReference) {
+	switch {
+	case exporter.OTLPGRPCExporter != nil:
+		return exporter.OTLPGRPCExporter.TLS, exporter.OTLPGRPCExporter.Token
+	case exporter.OTLPHTTPExporter != nil:
+		return exporter.OTLPHTTPExporter.TLS, exporter.OTLPHTTPExporter.Token
+	}
+
+	return nil, nil
+}
+
+// namedExporterResourceSuffix returns the suffix of the names of the volumes
+// and of the authentication extension of the given named exporter, e.g.
+// `exporter-otlp-http-backup' for the `otlp_http/backup' exporter.
+func namedExporterResourceSuffix(exporter config.NamedExporterConfig) string {
+	return "exporter-" + strings.NewReplacer("_", "-", "/", "-").Replace(exporter.ExporterName())
+}
+
+// getGoogleCloudExporterConfig returns the OTel settings for the Google Cloud
+// exporter.
+func (a *Actuator) getGoogleCloudExporterConfig(cfg config.GoogleCloudExporterConfig) map[string]any {
+	// See the link below for more details about each config setting for the
+	// Google Cloud exporter. The credentials are provided via Workload
+	// Identity.
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/googlecloudexporter
+	exporter := map[string]any{
+		"project": cfg.Project,
+		// Log entries without the `gcp.log_name' attribute are written
+		// to the log of the collector.
+		"log": map[string]any{
+			"default_log_name": otelCollectorName,
+		},
+	}
+
+	return exporter
+}
+
+// getFileExporterConfig returns the OTel settings for the file exporter.
+func (a *Actuator) getFileExporterConfig(cfg config.FileExporterConfig) map[string]any {
+	// See the link below for more details about each config setting for the
+	// file exporter.
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/fileexporter
+	exporter := map[string]any{
+		"path":   cfg.Path,
+		"format": string(cfg.Format),
+		"rotation": map[string]any{
+			"max_megabytes": cfg.Rotation.MaxMegabytes,
+			"max_days":      cfg.Rotation.MaxDays,
+			"max_backups":   cfg.Rotation.MaxBackups,
+			"localtime":     ptr.Deref(cfg.Rotation.LocalTime, false),
+		},
+	}
+
+	return exporter
+}
+
+// getOTLPReceiverConfig returns the OTel settings for the OTLP receiver.
+func (a *Actuator) getOTLPReceiverConfig(cfg config.OTLPReceiverConfig) map[string]any {
+	// See the link below for more details about each config setting of the
+	// OTLP receiver.
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector/tree/main/receiver/otlpreceiver
+	grpcConfig := map[string]any{
+		configKeyEndpoint: fmt.Sprintf("0.0.0.0:%d", otelCollectorGRPCReceiverPort),
+	}
+
+	if cfg.IsIncludeMetadataEnabled() {
+		grpcConfig["include_metadata"] = true
+	}
+
+	return map[string]any{
+		"protocols": map[string]any{
+			"grpc": grpcConfig,
+		},
+	}
+}
+
+// getBatchProcessorConfig returns the settings for the Batch processor with
+// the given timeout.
+func (a *Actuator) getBatchProcessorConfig(timeout time.Duration) map[string]any {
+	processor := map[string]any{
+		"timeout":             timeout.String(),
+		"send_batch_size":     a.batchProcessorConfig.SendBatchSize,
+		"send_batch_max_size": a.batchProcessorConfig.SendBatchMaxSize,
+	}
+
+	return processor
+}
+
+// getCollectorBatchProcessorConfig returns the settings for the Batch
+// processor of the collector for the given [config.CollectorConfig]. The
+// maximum size of the batches of the extension is overridden by the one of
+// the collector, if specified, which also caps the size of the batches.
+func (a *Actuator) getCollectorBatchProcessorConfig(cfg config.CollectorConfig) map[string]any {
+	processor := a.getBatchProcessorConfig(a.getBatchTimeout(cfg))
+	if maxSize := uint32(cfg.Spec.Processors.Batch.SendBatchMaxSize); maxSize > 0 {
+		processor["send_batch_size"] = min(a.batchProcessorConfig.SendBatchSize, maxSize)
+		processor["send_batch_max_size"] = maxSize
+	}
+
+	return processor
+}
+
+// getBatchTimeout returns the timeout of the Batch processor of the collector
+// for the given [config.CollectorConfig]. When auto-tuning is enabled, the
+// timeout is raised to the shortest scrape interval of the Prometheus receiver,
+// which is not configured in deployment mode.
+func (a *Actuator) getBatchTimeout(cfg config.CollectorConfig) time.Duration {
+	if !a.batchTimeoutAutoTuning || cfg.Spec.Mode == config.CollectorModeDeployment {
+		return a.batchProcessorConfig.Timeout
+	}
+
+	return max(a.batchProcessorConfig.Timeout, getMinScrapeInterval(cfg))
+}
+
+// getMinScrapeInterval returns the shortest scrape interval of the scrape jobs
+// of the Prometheus receiver for the given [config.CollectorConfig].
+func getMinScrapeInterval(cfg config.CollectorConfig) time.Duration {
+	interval := min(selfScrapeInterval, getTargetAllocatorScrapeInterval(cfg.Spec.TargetAllocator))
+	for _, sc := range cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs {
+		interval = min(interval, cmp.Or(sc.ScrapeInterval, defaultScrapeInterval))
+	}
+
+	return interval
+}
+
+// getTargetAllocatorScrapeInterval returns the scrape interval of the scrape
+// jobs discovered by the Target Allocator, which do not specify an interval.
+func getTargetAllocatorScrapeInterval(cfg config.TargetAllocatorConfig) time.Duration {
+	return cmp.Or(cfg.ScrapeInterval, targetAllocatorScrapeInterval)
+}
+
+// getMemoryLimiterProcessorConfig returns the settings for the Memory Limiter
+// processor.
+func (a *Actuator) getMemoryLimiterProcessorConfig() map[string]any {
+	processor := map[string]any{
+		"check_interval":         a.memoryLimiterConfig.CheckInterval.String(),
+		"limit_mib":              a.memoryLimiterConfig.MemoryLimitMiB,
+		"spike_limit_mib":        a.memoryLimiterConfig.MemorySpikeLimitMiB,
+		"limit_percentage":       a.memoryLimiterConfig.MemoryLimitPercentage,
+		"spike_limit_percentage": a.memoryLimiterConfig.MemorySpikePercentage,
+	}
+
+	return processor
+}
+
 // parseShootNamespaceAttributes extracts OTel resource attributes from a shoot
 // namespace name of the form "shoot--<project>--<shoot>".
 // The full namespace name maps to k8s.cluster.name; the two segments map to
@@ -1084,6 +3239,284 @@ func parseShootNamespaceAttributes(namespace string) (clusterName, projectName,
 	return clusterName, projectName, shootName
 }
 
+// getEndpointVariables returns the values of the cluster variables, which may be
+// referenced by the templates of the exporter endpoints, for the given cluster.
+// The values are empty for seed-class extensions, which have no cluster.
+func getEndpointVariables(cluster *extensionscontroller.Cluster) config.EndpointVariables {
+	if cluster == nil || cluster.Shoot == nil {
+		return config.EndpointVariables{}
+	}
+
+	return config.EndpointVariables{
+		Region:    cluster.Shoot.Spec.Region,
+		Provider:  cluster.Shoot.Spec.Provider.Type,
+		ShootName: cluster.Shoot.Name,
+		SeedName:  seedNameFromCluster(cluster),
+	}
+}
+
+// expandEndpoints returns a copy of the given [config.CollectorConfig], in
+// which the templates of the exporter endpoints are expanded with the given
+// cluster variables. An error is returned, if a template references a variable
+// without a value, or if it does not resolve to a valid URL.
+func expandEndpoints(cfg config.CollectorConfig, vars config.EndpointVariables) (config.CollectorConfig, error) {
+	cfg = *cfg.DeepCopy()
+
+	type endpoint struct {
+		path  string
+		value *string
+		isURL bool
+	}
+
+	httpEndpoints := func(path string, exporter *config.OTLPHTTPExporterConfig) []endpoint {
+		return []endpoint{
+			{path: path + ".endpoint", value: &exporter.Endpoint, isURL: true},
+			{path: path + ".traces_endpoint", value: &exporter.TracesEndpoint, isURL: true},
+			{path: path + ".metrics_endpoint", value: &exporter.MetricsEndpoint, isURL: true},
+			{path: path + ".logs_endpoint", value: &exporter.LogsEndpoint, isURL: true},
+			{path: path + ".profiles_endpoint", value: &exporter.ProfilesEndpoint, isURL: true},
+		}
+	}
+
+	endpoints := httpEndpoints("spec.exporters.otlp_http", &cfg.Spec.Exporters.OTLPHTTPExporter)
+	endpoints = append(endpoints, endpoint{path: "spec.exporters.otlp_grpc.endpoint", value: &cfg.Spec.Exporters.OTLPGRPCExporter.Endpoint})
+	for i, exporter := range cfg.Spec.Exporters.Named {
+		path := fmt.Sprintf("spec.exporters.named[%d]", i)
+		if exporter.OTLPHTTPExporter != nil {
+			endpoints = append(endpoints, httpEndpoints(path+".otlp_http", exporter.OTLPHTTPExporter)...)
+		}
+		if exporter.OTLPGRPCExporter != nil {
+			endpoints = append(endpoints, endpoint{path: path + ".otlp_grpc.endpoint", value: &exporter.OTLPGRPCExporter.Endpoint})
+		}
+	}
+
+	for _, e := range endpoints {
+		if !config.IsEndpointTemplate(*e.value) {
+			continue
+		}
+
+		expanded, err := config.ExpandEndpoint(*e.value, vars)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to expand the template of %s: %w", e.path, err)
+		}
+		if e.isURL {
+			if _, err := url.Parse(expanded); err != nil {
+				return cfg, fmt.Errorf("invalid URL %q resolved from the template of %s: %w", expanded, e.path, err)
+			}
+		}
+
+		*e.value = expanded
+	}
+
+	return cfg, nil
+}
+
+// seedNameFromCluster returns the name of the seed of the given cluster, which
+// is nil for seed-class extensions.
+func seedNameFromCluster(cluster *extensionscontroller.Cluster) string {
+	if cluster == nil || cluster.Seed == nil {
+		return ""
+	}
+
+	return cluster.Seed.Name
+}
+
+// getTelemetryMetricsReaders returns the readers of the internal metrics of
+// the collector. The internal metrics are always exposed for the self-scrape
+// of the collector, and optionally pushed via OTLP.
+func getTelemetryMetricsReaders(cfg config.CollectorConfig) []any {
+	pull := map[string]any{
+		"host": "0.0.0.0",
+		"port": otelCollectorMetricsPort,
+	}
+	if cfg.Spec.Metrics.IsWithoutUnits() {
+		pull["without_units"] = true
+	}
+	if cfg.Spec.Metrics.IsWithoutTypeSuffix() {
+		pull["without_type_suffix"] = true
+	}
+
+	readers := []any{
+		map[string]any{
+			"pull": map[string]any{
+				"exporter": map[string]any{
+					configKeyPrometheus: pull,
+				},
+			},
+		},
+	}
+
+	push := cfg.Spec.Metrics.Push
+	if !push.IsEnabled() {
+		return readers
+	}
+
+	otlp := map[string]any{
+		"protocol":        string(cmp.Or(push.Protocol, config.MetricsPushProtocolHTTPProtobuf)),
+		configKeyEndpoint: push.Endpoint,
+	}
+
+	// The metrics endpoint of the OTLP HTTP exporter is used along with its
+	// CA, client certificate and token, unless an endpoint is specified.
+	// The token is expanded from the mounted file on startup of the
+	// collector.
+	if push.Endpoint == "" {
+		exporter := cfg.Spec.Exporters.OTLPHTTPExporter
+		otlp[configKeyEndpoint] = cmp.Or(exporter.MetricsEndpoint, strings.TrimSuffix(exporter.Endpoint, "/")+"/v1/metrics")
+
+		if tls := exporter.TLS; tls != nil {
+			if tls.CA != nil {
+				otlp["certificate"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.CA.ResourceRef.DataKey)
+			}
+			if tls.Cert != nil {
+				otlp["client_certificate"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.Cert.ResourceRef.DataKey)
+			}
+			if tls.Key != nil {
+				otlp["client_key"] = filepath.Join(httpExporterVolumeMountPathTLS, tls.Key.ResourceRef.DataKey)
+			}
+		}
+
+		if exporter.Token != nil {
+			tokenFile := filepath.Join(httpExporterVolumeMountPathBearerTokenFile, exporter.Token.ResourceRef.DataKey)
+			otlp["headers"] = []any{
+				map[string]any{
+					"name":  "Authorization",
+					"value": "Bearer ${file:" + tokenFile + "}",
+				},
+			}
+		}
+	}
+
+	periodic := map[string]any{
+		"exporter": map[string]any{
+			"otlp": otlp,
+		},
+	}
+	// The interval is specified in milliseconds.
+	if push.Interval > 0 {
+		periodic["interval"] = push.Interval.Milliseconds()
+	}
+
+	return append(readers, map[string]any{"periodic": periodic})
+}
+
+// getDeploymentUpdateStrategy returns the [appsv1.DeploymentStrategy] of the
+// collector Deployments. Unset values are left to the defaults of Kubernetes.
+func getDeploymentUpdateStrategy(cfg config.UpdateStrategyConfig) appsv1.DeploymentStrategy {
+	if !cfg.IsConfigured() {
+		return appsv1.DeploymentStrategy{}
+	}
+
+	return appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxUnavailable: cfg.MaxUnavailable,
+			MaxSurge:       cfg.MaxSurge,
+		},
+	}
+}
+
+// getPodSecurityContext returns the [corev1.PodSecurityContext] of the collector
+// pods, which complies with the `restricted' Pod Security Standard. Unset IDs
+// are left to the user of the collector image.
+func getPodSecurityContext(cfg config.SecurityContextConfig) *corev1.PodSecurityContext {
+	securityContext := &corev1.PodSecurityContext{
+		RunAsNonRoot:   new(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	if cfg.RunAsUser > 0 {
+		securityContext.RunAsUser = new(cfg.RunAsUser)
+	}
+	if cfg.RunAsGroup > 0 {
+		securityContext.RunAsGroup = new(cfg.RunAsGroup)
+		securityContext.FSGroup = new(cfg.RunAsGroup)
+	}
+
+	return securityContext
+}
+
+// getStartupProbe returns the [otelv1beta1.Probe] settings for the startup
+// probe of the collector. Unset values are left to the defaults of the OTel
+// Operator.
+func getStartupProbe(cfg config.StartupProbeConfig) *otelv1beta1.Probe {
+	probe := &otelv1beta1.Probe{}
+	if cfg.FailureThreshold > 0 {
+		probe.FailureThreshold = new(cfg.FailureThreshold)
+	}
+	if cfg.PeriodSeconds > 0 {
+		probe.PeriodSeconds = new(cfg.PeriodSeconds)
+	}
+
+	return probe
+}
+
+// getPreStopLifecycle returns the [corev1.Lifecycle] of the collector
+// containers, which delays the shutdown of the collector until the pod no
+// longer receives new data. On shutdown the collector drains the sending
+// queues of the exporters within the termination grace period, hence the
+// queued data is not lost during rollouts.
+func getPreStopLifecycle() *corev1.Lifecycle {
+	lifecycle := &corev1.Lifecycle{
+		PreStop: &corev1.LifecycleHandler{
+			Sleep: &corev1.SleepAction{
+				Seconds: otelCollectorPreStopSleepSeconds,
+			},
+		},
+	}
+
+	return lifecycle
+}
+
+// getTerminationGracePeriodSeconds returns the termination grace period of the
+// collector pods for the given [config.CollectorConfig]. The shutdown timeout
+// of the collector starts after the pre-stop hook, hence the grace period
+// covers both of them. The collector does not provide a setting for the
+// duration of its shutdown, so the shutdown timeout only raises the grace
+// period, which never falls below the default.
+func getTerminationGracePeriodSeconds(cfg config.CollectorConfig) int64 {
+	return max(
+		otelCollectorTerminationGracePeriodSeconds,
+		otelCollectorPreStopSleepSeconds+int64(cfg.Spec.ShutdownTimeout/time.Second),
+	)
+}
+
+// recordConfigComponents records the number of components of each kind, which
+// are configured in the given [otelv1beta1.OpenTelemetryCollector].
+func recordConfigComponents(namespace string, obj *otelv1beta1.OpenTelemetryCollector) {
+	countOf := func(cfg *otelv1beta1.AnyConfig) int {
+		if cfg == nil {
+			return 0
+		}
+
+		return len(cfg.Object)
+	}
+
+	components := map[string]int{
+		"receivers":  countOf(&obj.Spec.Config.Receivers),
+		"processors": countOf(obj.Spec.Config.Processors),
+		"exporters":  countOf(&obj.Spec.Config.Exporters),
+		"connectors": countOf(obj.Spec.Config.Connectors),
+		"extensions": countOf(obj.Spec.Config.Extensions),
+	}
+
+	for kind, count := range components {
+		metrics.ConfigComponents.WithLabelValues(namespace, kind).Set(float64(count))
+	}
+}
+
+// recordCertificateExpiry records the expiration time of the given
+// PEM-encoded certificate.
+func recordCertificateExpiry(namespace string, name string, data []byte) error {
+	cert, err := utils.DecodeCertificate(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode certificate %s: %w", name, err)
+	}
+
+	metrics.CertificateExpirySeconds.WithLabelValues(namespace, name).Set(float64(cert.NotAfter.Unix()))
+
+	return nil
+}
+
 // getOTelCollector returns the [otelv1beta1.OpenTelemetryCollector]
 // resource, which the extension manages.
 func (a *Actuator) getOtelCollector(
@@ -1109,23 +3542,75 @@ func (a *Actuator) getOtelCollector(
 		baseVolumeMountPathBearerTokenFile         = "/etc/auth/bearer"                                         // #nosec: G101
 		httpExporterVolumeMountPathBearerTokenFile = baseVolumeMountPathBearerTokenFile + "-exporter-otlp-http" // #nosec: G101
 		grpcExporterVolumeMountPathBearerTokenFile = baseVolumeMountPathBearerTokenFile + "-exporter-otlp-grpc" // #nosec: G101
+
+		volumeNameFileExporter  = "file-exporter"
+		volumeNameDebugExporter = "debug-exporter"
 	)
 
 	exporters := a.getOtelExporters(cfg)
 	exporterNames := slices.Sorted(maps.Keys(exporters))
+	// The file exporter receives the data refused by the other exporters
+	// only, when used as a fallback.
+	if cfg.Spec.Exporters.FileExporter.IsFallbackEnabled() {
+		exporterNames = slices.DeleteFunc(exporterNames, func(name string) bool {
+			return name == config.ExporterNameFile
+		})
+	}
+	additionalPorts := getAdditionalPorts(cfg)
 	clusterName, projectName, shootName := parseShootNamespaceAttributes(namespace)
 	allLabels := utils.MergeStringMaps(
 		a.getCommonLabels(),
 		a.getNetworkLabels(),
 	)
 
-	obj := &otelv1beta1.OpenTelemetryCollector{
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	targetAllocatorConfig := map[string]any{
+		"collector_id": "${POD_NAME}",
+		"interval":     "30s",
+	}
+	targetAllocatorMetricsTarget := fmt.Sprintf("%s:%d", targetAllocatorMetricsServiceName, targetAllocatorMetricsPort)
+	if a.targetAllocatorMTLS {
+		targetAllocatorConfig[configKeyEndpoint] = "https://" + targetAllocatorHTTPSServiceName
+		targetAllocatorConfig["tls"] = map[string]any{
+			"ca_file":   filepath.Join(volumeMountPathCACertificate, secretsutils.DataKeyCertificateBundle),
+			"cert_file": filepath.Join(volumeMountPathClientCertificate, secretsutils.DataKeyCertificate),
+			"key_file":  filepath.Join(volumeMountPathClientCertificate, secretsutils.DataKeyPrivateKey),
+		}
+		volumeMounts = []corev1.VolumeMount{
+			{Name: volumeNameCACertificate, MountPath: volumeMountPathCACertificate, ReadOnly: true},
+			{Name: volumeNameClientCertificate, MountPath: volumeMountPathClientCertificate, ReadOnly: true},
+		}
+		volumes = []corev1.Volume{
+			{Name: volumeNameCACertificate, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: caSecret.Name}}},
+			{Name: volumeNameClientCertificate, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: clientSecret.Name}}},
+		}
+	} else {
+		// The Target Allocator managed by the OTel Operator serves
+		// both, the scrape targets and its metrics, via the HTTP port
+		// of its service.
+		targetAllocatorConfig[configKeyEndpoint] = "http://" + targetAllocatorServiceName
+		targetAllocatorMetricsTarget = fmt.Sprintf("%s:%d", targetAllocatorServiceName, targetAllocatorHTTPPort)
+		allLabels = utils.MergeStringMaps(allLabels, map[string]string{
+			labelKeyTargetAllocator: otelCollectorName,
+		})
+	}
+
+	obj := &otelv1beta1.OpenTelemetryCollector{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      otelCollectorName,
 			Namespace: namespace,
 			Labels:    allLabels,
 			Annotations: utils.MergeStringMaps(
-				a.getAnnotations(),
+				// The OTLP receiver is reachable from the allowed
+				// clients only, if specified. Behind the OTLP gateway,
+				// it is reachable from the gateway pods only via their
+				// `to-<service>-tcp-<port>' label, so that the
+				// authentication of the gateway cannot be bypassed.
+				a.getAnnotations(
+					additionalPorts,
+					len(cfg.Spec.Receivers.OTLPReceiver.AllowedClients) == 0 && !cfg.Spec.Gateway.IsEnabled(),
+				),
 				map[string]string{
 					resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "pod-label-selector-namespace-alias": "all-shoots",
 					resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "namespace-selectors":                `[{"matchExpressions":[{"key":"kubernetes.io/metadata.name","operator":"In","values":["garden"]}]},{"matchExpressions":[{"key":"gardener.cloud/role","operator":"In","values":["extension"]}]}]`,
@@ -1144,18 +3629,16 @@ func (a *Actuator) getOtelCollector(
 			Mode:            otelv1beta1.ModeStatefulSet,
 			UpgradeStrategy: otelv1beta1.UpgradeStrategyNone,
 			OpenTelemetryCommonFields: otelv1beta1.OpenTelemetryCommonFields{
-				Image:    image.String(),
-				Replicas: new(otelCollectorReplicas),
-				VolumeMounts: []corev1.VolumeMount{
-					{Name: volumeNameCACertificate, MountPath: volumeMountPathCACertificate, ReadOnly: true},
-					{Name: volumeNameClientCertificate, MountPath: volumeMountPathClientCertificate, ReadOnly: true},
-					{Name: volumeNameShootKubeconfig, MountPath: gardenerutils.VolumeMountPathGenericKubeconfig, ReadOnly: true},
-				},
-				Volumes: []corev1.Volume{
-					{Name: volumeNameCACertificate, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: caSecret.Name}}},
-					{Name: volumeNameClientCertificate, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: clientSecret.Name}}},
+				Image:          image.String(),
+				Replicas:       new(otelCollectorReplicas),
+				Ports:          getPortsSpecs(additionalPorts),
+				PodAnnotations: getPodAnnotations(cfg.Spec.PodAnnotations, nil),
+				VolumeMounts: append(volumeMounts,
+					corev1.VolumeMount{Name: volumeNameShootKubeconfig, MountPath: gardenerutils.VolumeMountPathGenericKubeconfig, ReadOnly: true},
+				),
+				Volumes: append(volumes,
 					gardenerutils.GenerateGenericKubeconfigVolume(shootKubeconfigSecretName, accessSecretName, volumeNameShootKubeconfig),
-				},
+				),
 				Env: []corev1.EnvVar{{
 					Name:  "KUBECONFIG",
 					Value: gardenerutils.PathGenericKubeconfig,
@@ -1170,36 +3653,37 @@ func (a *Actuator) getOtelCollector(
 				SecurityContext: &corev1.SecurityContext{
 					AllowPrivilegeEscalation: new(false),
 				},
-				ServiceAccount: otelCollectorServiceAccountName,
+				PodSecurityContext:            getPodSecurityContext(cfg.Spec.SecurityContext),
+				ServiceAccount:                otelCollectorServiceAccountName,
+				Lifecycle:                     getPreStopLifecycle(),
+				TerminationGracePeriodSeconds: new(getTerminationGracePeriodSeconds(cfg)),
 			},
+			StartupProbe: getStartupProbe(cfg.Spec.StartupProbe),
 			// Explicitly configure the Prometheus receiver to point
 			// at an existing Target Allocator.
 			Config: otelv1beta1.Config{
 				Receivers: otelv1beta1.AnyConfig{
 					Object: map[string]any{
-						"otlp": map[string]any{
-							"protocols": map[string]any{
-								"grpc": map[string]any{
-									configKeyEndpoint: fmt.Sprintf("0.0.0.0:%d", otelCollectorGRPCReceiverPort),
-								},
-							},
-						},
+						"otlp": a.getOTLPReceiverConfig(cfg.Spec.Receivers.OTLPReceiver),
 						configKeyPrometheus: map[string]any{
-							"target_allocator": map[string]any{
-								"collector_id":    "${POD_NAME}",
-								configKeyEndpoint: "https://" + targetAllocatorHTTPSServiceName,
-								"interval":        "30s",
-								"tls": map[string]any{
-									"ca_file":   filepath.Join(volumeMountPathCACertificate, secretsutils.DataKeyCertificateBundle),
-									"cert_file": filepath.Join(volumeMountPathClientCertificate, secretsutils.DataKeyCertificate),
-									"key_file":  filepath.Join(volumeMountPathClientCertificate, secretsutils.DataKeyPrivateKey),
-								},
-							},
+							"target_allocator": targetAllocatorConfig,
 							"config": map[string]any{
 								"scrape_configs": []any{
 									map[string]any{
-										"job_name":        otelCollectorName,
-										"scrape_interval": "15s",
+										"job_name":        config.SelfScrapeJobName,
+										"scrape_interval": selfScrapeInterval.String(),
+									},
+									// Self-monitoring of the Target Allocator, which
+									// exposes the number of discovered and assigned
+									// targets, e.g. opentelemetry_allocator_targets.
+									map[string]any{
+										"job_name":        config.TargetAllocatorScrapeJobName,
+										"scrape_interval": selfScrapeInterval.String(),
+										"static_configs": []any{
+											map[string]any{
+												"targets": []string{targetAllocatorMetricsTarget},
+											},
+										},
 									},
 								},
 							},
@@ -1218,18 +3702,8 @@ func (a *Actuator) getOtelCollector(
 				},
 				Processors: &otelv1beta1.AnyConfig{
 					Object: map[string]any{
-						batchProcessorName: map[string]any{
-							"timeout":             a.batchProcessorConfig.Timeout.String(),
-							"send_batch_size":     a.batchProcessorConfig.SendBatchSize,
-							"send_batch_max_size": a.batchProcessorConfig.SendBatchMaxSize,
-						},
-						memoryLimiterProcessorName: map[string]any{
-							"check_interval":         a.memoryLimiterConfig.CheckInterval.String(),
-							"limit_mib":              a.memoryLimiterConfig.MemoryLimitMiB,
-							"spike_limit_mib":        a.memoryLimiterConfig.MemorySpikeLimitMiB,
-							"limit_percentage":       a.memoryLimiterConfig.MemoryLimitPercentage,
-							"spike_limit_percentage": a.memoryLimiterConfig.MemorySpikePercentage,
-						},
+						batchProcessorName:         a.getCollectorBatchProcessorConfig(cfg),
+						memoryLimiterProcessorName: a.getMemoryLimiterProcessorConfig(),
 						resourceProcessorName: map[string]any{
 							"attributes": []any{
 								upsertAttribute("k8s.cluster.name", clusterName),
@@ -1252,23 +3726,42 @@ func (a *Actuator) getOtelCollector(
 				Exporters: otelv1beta1.AnyConfig{
 					Object: exporters,
 				},
+				// The OTel Operator configures the probes of the
+				// collector container based on the health_check
+				// extension.
+				//
+				// Note that a gRPC readiness probe against the OTLP
+				// receiver is not configured, because the probes of
+				// the OpenTelemetryCollector resource expose the
+				// timing settings only, and the OTLP receiver does not
+				// serve the gRPC health service, i.e. such a probe
+				// would never succeed.
+				//
+				// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/extension/healthcheckextension
+				Extensions: &otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						healthCheckExtensionName: map[string]any{
+							configKeyEndpoint: fmt.Sprintf("0.0.0.0:%d", otelCollectorHealthCheckPort),
+						},
+					},
+				},
 				Service: otelv1beta1.Service{
+					Extensions: []string{healthCheckExtensionName},
 					Telemetry: &otelv1beta1.AnyConfig{
 						Object: map[string]any{
 							"metrics": map[string]any{
-								"level": string(cfg.Spec.Metrics.Level),
-								"readers": []any{
-									map[string]any{
-										"pull": map[string]any{
-											"exporter": map[string]any{
-												configKeyPrometheus: map[string]any{
-													"host": "0.0.0.0",
-													"port": otelCollectorMetricsPort,
-												},
-											},
-										},
-									},
-								},
+								// The collector rejects an empty
+								// level, e.g. of a provider config,
+								// which is not defaulted.
+								"level": string(cmp.Or(cfg.Spec.Metrics.Level, config.MetricsVerbosityLevelNormal)),
+								// Note that the pull-based Prometheus
+								// reader of the internal telemetry does
+								// not support TLS server settings, so the
+								// internal metrics (and the self-scrape
+								// of them) are served over plain HTTP.
+								//
+								// https://github.com/open-telemetry/opentelemetry-configuration
+								"readers": getTelemetryMetricsReaders(cfg),
 							},
 							"logs": map[string]any{
 								"level":    string(cfg.Spec.Logs.Level),
@@ -1277,17 +3770,17 @@ func (a *Actuator) getOtelCollector(
 						},
 					},
 					Pipelines: map[string]*otelv1beta1.Pipeline{
-						"logs": {
+						config.PipelineNameLogs: {
 							Receivers:  []string{"otlp"},
 							Processors: []string{resourceProcessorName, memoryLimiterProcessorName, batchProcessorName},
 							Exporters:  exporterNames,
 						},
-						"logs/events": {
+						config.PipelineNameEvents: {
 							Receivers:  []string{"k8sobjects/events"},
 							Processors: []string{resourceProcessorName, memoryLimiterProcessorName, transformEventsProcessorName, batchProcessorName},
 							Exporters:  exporterNames,
 						},
-						"metrics": {
+						config.PipelineNameMetrics: {
 							Receivers:  []string{"prometheus"},
 							Processors: []string{resourceProcessorName, memoryLimiterProcessorName, batchProcessorName},
 							Exporters:  exporterNames,
@@ -1340,157 +3833,1981 @@ func (a *Actuator) getOtelCollector(
 		resources,
 	)
 
-	return obj
-}
+	// TLS and Bearer Token Authentication settings of the named OTLP
+	// exporters
+	for _, exporter := range cfg.Spec.Exporters.Named {
+		if !exporter.IsEnabled() {
+			continue
+		}
 
-// getEventsClusterRole returns the [rbacv1.ClusterRole] granting the OTel
-// Collector's service account in the shoot cluster permission to list and watch
-// events from the events.k8s.io API group.
-func (a *Actuator) getEventsClusterRole() *rbacv1.ClusterRole {
-	return &rbacv1.ClusterRole{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: otelCollectorName,
-		},
-		Rules: []rbacv1.PolicyRule{{
-			APIGroups: []string{"events.k8s.io"},
-			Resources: []string{"events"},
-			Verbs:     readVerbs,
-		}},
+		tls, token := getNamedExporterReferences(exporter)
+		suffix := namedExporterResourceSuffix(exporter)
+		a.configureVolumeForTLS(
+			obj,
+			tls,
+			baseVolumeNameTLS+"-"+suffix,
+			baseVolumeMountPathTLS+"-"+suffix,
+			resources,
+		)
+		a.configureVolumeForBearerTokenAuthExtension(
+			obj,
+			token,
+			baseBearerTokenAuthName+"/"+suffix,
+			baseVolumeMountPathBearerTokenFile+"-"+suffix,
+			baseVolumeNameBearerToken+"-"+suffix,
+			baseVolumeMountPathBearerTokenFile+"-"+suffix,
+			resources,
+		)
 	}
-}
 
-// getEventsClusterRoleBinding returns the [rbacv1.ClusterRoleBinding] that
-// binds the events ClusterRole to the OTel Collector's service account in the
-// shoot cluster's kube-system namespace.
-func (a *Actuator) getEventsClusterRoleBinding(serviceAccountName string) *rbacv1.ClusterRoleBinding {
-	return &rbacv1.ClusterRoleBinding{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: otelCollectorName,
-		},
-		RoleRef: rbacv1.RoleRef{
-			APIGroup: rbacv1.GroupName,
-			Kind:     "ClusterRole",
-			Name:     otelCollectorName,
-		},
-		Subjects: []rbacv1.Subject{{
-			Kind:      rbacv1.ServiceAccountKind,
-			Name:      serviceAccountName,
-			Namespace: metav1.NamespaceSystem,
-		}},
+	// File exporter writing to a dedicated writable volume
+	if cfg.Spec.Exporters.FileExporter.IsEnabled() {
+		obj.Spec.VolumeMounts = append(obj.Spec.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeNameFileExporter,
+			MountPath: filepath.Dir(cfg.Spec.Exporters.FileExporter.Path),
+		})
+		obj.Spec.Volumes = append(obj.Spec.Volumes, corev1.Volume{
+			Name:         volumeNameFileExporter,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
 	}
-}
 
-func secretNameForResource(resourceName string, resources []gardencorev1beta1.NamedResourceReference) string {
-	for _, r := range resources {
-		if r.Name == resourceName &&
-			r.ResourceRef.APIVersion == corev1.SchemeGroupVersion.String() && r.ResourceRef.Kind == "Secret" {
-			return v1beta1constants.ReferencedResourcesPrefix + r.ResourceRef.Name
-		}
+	// Debug exporter writing to a dedicated writable volume instead of the
+	// standard output
+	if cfg.Spec.Exporters.DebugExporter.IsEnabled() && cfg.Spec.Exporters.DebugExporter.Path != "" {
+		obj.Spec.VolumeMounts = append(obj.Spec.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeNameDebugExporter,
+			MountPath: filepath.Dir(cfg.Spec.Exporters.DebugExporter.Path),
+		})
+		obj.Spec.Volumes = append(obj.Spec.Volumes, corev1.Volume{
+			Name:         volumeNameDebugExporter,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
 	}
 
-	return ""
-}
+	// Additional volumes, e.g. for the components of the referenced
+	// collector configuration
+	configureVolumes(obj, cfg.Spec.Volumes, resources)
+
+	// In deployment mode the collector is stateless, so there is no
+	// Prometheus receiver, which requires the Target Allocator.
+	if cfg.Spec.Mode == config.CollectorModeDeployment {
+		obj.Spec.Mode = otelv1beta1.ModeDeployment
+		obj.Spec.DeploymentUpdateStrategy = getDeploymentUpdateStrategy(cfg.Spec.UpdateStrategy)
+		delete(obj.Spec.Config.Receivers.Object, configKeyPrometheus)
+		delete(obj.Spec.Config.Service.Pipelines, config.PipelineNameMetrics)
+	}
 
-// configureVolumeForTLS configures a volume for the OpenTelemetry collector for
-// TLS secrets.
-func (a *Actuator) configureVolumeForTLS(
-	obj *otelv1beta1.OpenTelemetryCollector,
-	tls *config.TLSConfig,
-	volumeName string,
-	volumeMount string,
-	resources []gardencorev1beta1.NamedResourceReference,
-) {
-	if obj == nil || tls == nil {
-		return
+	// DNS settings of the collector pods
+	if cfg.Spec.DNS.Policy != "" {
+		obj.Spec.DNSPolicy = new(cfg.Spec.DNS.Policy)
+	}
+	if cfg.Spec.DNS.Config != nil {
+		obj.Spec.PodDNSConfig = *cfg.Spec.DNS.Config
 	}
 
-	volume := corev1.Volume{
-		Name: volumeName,
-		VolumeSource: corev1.VolumeSource{
-			Projected: &corev1.ProjectedVolumeSource{},
-		},
+	// Additional scrape jobs of the Prometheus receiver
+	a.configureScrapeConfigs(obj, cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs, resources)
+
+	// Native histograms support of the Prometheus receiver
+	if cfg.Spec.Receivers.PrometheusReceiver.IsNativeHistogramsEnabled() {
+		a.configureNativeHistograms(obj)
 	}
 
-	addSecretToProjectedVolume := func(resourceRef config.ResourceReferenceDetails) {
-		volume.Projected.Sources = append(
-			volume.Projected.Sources,
-			corev1.VolumeProjection{
-				Secret: &corev1.SecretProjection{
-					LocalObjectReference: corev1.LocalObjectReference{
-						Name: secretNameForResource(resourceRef.Name, resources),
-					},
-					Items: []corev1.KeyToPath{{Key: resourceRef.DataKey, Path: resourceRef.DataKey}},
-				},
-			},
-		)
+	// Default limits of the scraped samples and labels
+	a.configureScrapeLimits(obj, cfg.Spec.Receivers.PrometheusReceiver.Limits)
+
+	// Start times of the cumulative metrics from the scraped targets
+	if cfg.Spec.Receivers.PrometheusReceiver.IsUseStartTimeMetricEnabled() {
+		a.configureStartTimeMetric(obj, cfg.Spec.Receivers.PrometheusReceiver.StartTimeMetricRegex)
 	}
 
-	if tls.CA != nil {
-		addSecretToProjectedVolume(tls.CA.ResourceRef)
+	// StatsD receiver feeding the metrics pipeline
+	if cfg.Spec.Receivers.StatsDReceiver.IsEnabled() {
+		a.configureStatsDReceiver(obj, cfg.Spec.Receivers.StatsDReceiver)
 	}
-	if tls.Cert != nil {
-		addSecretToProjectedVolume(tls.Cert.ResourceRef)
+
+	// Service graph derived from the spans of the traces pipelines
+	if cfg.Spec.Connectors.ServiceGraphConnector.IsEnabled() {
+		a.configureServiceGraphConnector(obj, cfg.Spec.Connectors.ServiceGraphConnector, exporterNames)
 	}
-	if tls.Key != nil {
-		addSecretToProjectedVolume(tls.Key.ResourceRef)
+
+	// RED metrics derived from the spans of the traces pipelines
+	if cfg.Spec.Connectors.SpanMetricsConnector.IsEnabled() {
+		a.configureSpanMetricsConnector(obj, cfg.Spec.Connectors.SpanMetricsConnector, exporterNames)
 	}
 
-	obj.Spec.Volumes = append(obj.Spec.Volumes, volume)
-	obj.Spec.VolumeMounts = append(
-		obj.Spec.VolumeMounts,
-		corev1.VolumeMount{
-			Name:      volumeName,
-			MountPath: volumeMount,
-		},
-	)
+	// Named processors referenced by the forward pipelines
+	a.configureNamedProcessors(obj, cfg.Spec.Processors.Named)
+
+	// Pipelines chained via the forward connector
+	a.configureForwardPipelines(obj, cfg.Spec.Pipelines.Forward, exporterNames)
+
+	// Debug exporters configured for a single pipeline only
+	a.configurePipelineDebugExporters(obj, cfg.Spec.Exporters.DebugExporter.Pipelines)
+
+	// File exporter used as a fallback of the other exporters
+	if cfg.Spec.Exporters.FileExporter.IsFallbackEnabled() {
+		a.configureFileExporterFallback(obj, exporterNames)
+	}
+
+	// Renaming and aggregation of the metrics. This happens before
+	// prefixing the metric names, so that the transforms match the
+	// original names.
+	a.configureMetricsTransform(obj, cfg.Spec.Processors.MetricsTransform)
+
+	// Prefix of the metric names
+	a.configureMetricNamePrefix(obj, cfg.Spec.MetricNamePrefix)
+
+	// Error handling of the processors, which evaluate OTTL statements
+	a.configureProcessorErrorMode(obj, cfg.Spec.Processors.ErrorMode)
+
+	// The OTLP gateway authenticates the clients instead, if enabled.
+	if !cfg.Spec.Gateway.IsEnabled() {
+		a.configureOTLPReceiverAuth(obj, cfg.Spec.Receivers.OTLPReceiver.Auth, resources)
+	}
+
+	// The batch processor is removed last, so that the processors added
+	// by the connectors and the forward pipelines are covered as well.
+	if !cfg.Spec.Processors.Batch.IsEnabled() {
+		removeBatchProcessor(obj)
+	}
+
+	obj.Spec.Config.Service.Extensions = sortServiceExtensions(obj.Spec.Config.Service.Extensions)
+
+	return obj
 }
 
-// configureVolumeForBearerTokenAuthExtension configures a volume for the
-// OpenTelemetry collector for the bearertokenauth extension.
-func (a *Actuator) configureVolumeForBearerTokenAuthExtension(
-	obj *otelv1beta1.OpenTelemetryCollector,
-	ref *config.ResourceReference,
-	authExtensionName string,
-	tokenBasePath string,
-	volumeName string,
-	volumeMount string,
-	resources []gardencorev1beta1.NamedResourceReference,
-) {
-	if obj == nil || ref == nil {
+// removeBatchProcessor removes the batch processor from the processors and
+// from the pipelines of the given collector.
+func removeBatchProcessor(obj *otelv1beta1.OpenTelemetryCollector) {
+	if obj == nil {
 		return
 	}
 
-	if obj.Spec.Config.Extensions == nil {
-		obj.Spec.Config.Extensions = &otelv1beta1.AnyConfig{}
+	if obj.Spec.Config.Processors != nil {
+		delete(obj.Spec.Config.Processors.Object, batchProcessorName)
+	}
+	for _, pipeline := range obj.Spec.Config.Service.Pipelines {
+		// The processors of the pipelines may share the same backing
+		// array, so make sure to not modify it.
+		pipeline.Processors = slices.DeleteFunc(slices.Clone(pipeline.Processors), func(name string) bool {
+			return name == batchProcessorName
+		})
 	}
+}
 
-	if obj.Spec.Config.Extensions.Object == nil {
-		obj.Spec.Config.Extensions.Object = make(map[string]any)
+// getBatchingExporterNames returns the names of the enabled exporters of the
+// given [config.CollectorConfig], which send the data over the network and
+// rely on the batch processor to not issue a request per incoming request.
+func getBatchingExporterNames(cfg config.CollectorConfig) []string {
+	return slices.DeleteFunc(cfg.Spec.Exporters.EnabledExporterNames(), func(name string) bool {
+		exporterType, _, _ := strings.Cut(name, "/")
+		return exporterType == config.ExporterNameDebug || exporterType == config.ExporterNameFile
+	})
+}
+
+// serviceExtensionOrder specifies the order of the extension types in the
+// `service.extensions' setting of the collector. Extensions, which other
+// components depend on, such as storage extensions, come first.
+var serviceExtensionOrder = []string{
+	healthCheckExtensionName,
+	"pprof",
+	"zpages",
+	"file_storage",
+	baseBearerTokenAuthName,
+	"basicauth",
+}
+
+// ottlProcessorTypes specifies the types of the processors, which evaluate
+// OTTL statements or conditions and support the `error_mode' setting.
+var ottlProcessorTypes = []string{"transform", "filter"}
+
+// sortServiceExtensions returns the given extension names without duplicates,
+// ordered by their type according to [serviceExtensionOrder] and by name.
+// Extensions of unknown types come last.
+func sortServiceExtensions(names []string) []string {
+	rank := func(name string) int {
+		extensionType, _, _ := strings.Cut(name, "/")
+		if idx := slices.Index(serviceExtensionOrder, extensionType); idx >= 0 {
+			return idx
+		}
+
+		return len(serviceExtensionOrder)
 	}
 
-	obj.Spec.Config.Extensions.Object[authExtensionName] = map[string]any{
-		"filename": filepath.Join(tokenBasePath, ref.ResourceRef.DataKey),
+	result := slices.Clone(names)
+	slices.SortStableFunc(result, func(x, y string) int {
+		return cmp.Or(cmp.Compare(rank(x), rank(y)), strings.Compare(x, y))
+	})
+
+	return slices.Compact(result)
+}
+
+// getOTLPReceiverNetworkPolicies returns the NetworkPolicies, which allow the
+// traffic from the allowed clients of the given [config.CollectorConfig] to
+// the OTLP receiver. The receiver of the OTLP gateway is targeted instead, if
+// the gateway is enabled.
+func (a *Actuator) getOTLPReceiverNetworkPolicies(namespace string, cfg config.CollectorConfig) []client.Object {
+	receiverName := otelCollectorName
+	if cfg.Spec.Gateway.IsEnabled() {
+		receiverName = otelCollectorGatewayName
 	}
 
-	obj.Spec.Config.Service.Extensions = append(obj.Spec.Config.Service.Extensions, authExtensionName)
+	// The labels of the collector pods managed by the OTel Operator
+	receiverSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			labelKeyComponent:              "opentelemetry-collector",
+			"app.kubernetes.io/instance":   fmt.Sprintf("%s.%s", namespace, receiverName),
+			"app.kubernetes.io/managed-by": "opentelemetry-operator",
+		},
+	}
+	ports := []networkingv1.NetworkPolicyPort{
+		{
+			Protocol: new(corev1.ProtocolTCP),
+			Port:     new(intstr.FromInt32(otelCollectorGRPCReceiverPort)),
+		},
+	}
 
-	obj.Spec.Volumes = append(
-		obj.Spec.Volumes,
-		corev1.Volume{
-			Name: volumeName,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: secretNameForResource(ref.ResourceRef.Name, resources),
+	peers := make([]networkingv1.NetworkPolicyPeer, 0, len(cfg.Spec.Receivers.OTLPReceiver.AllowedClients))
+	for _, selector := range cfg.Spec.Receivers.OTLPReceiver.AllowedClients {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{PodSelector: selector.DeepCopy()})
+	}
+
+	objects := []client.Object{
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      otlpReceiverNetworkPolicyName,
+				Namespace: namespace,
+				Labels:    a.getCommonLabels(),
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: receiverSelector,
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{
+					{From: peers, Ports: ports},
 				},
 			},
 		},
-	)
+	}
 
-	obj.Spec.VolumeMounts = append(
-		obj.Spec.VolumeMounts,
-		corev1.VolumeMount{
-			Name:      volumeName,
-			MountPath: volumeMount,
+	// The egress traffic of the clients is denied by default in the
+	// namespace of the cluster, hence each client needs a NetworkPolicy
+	// of its own.
+	for i, selector := range cfg.Spec.Receivers.OTLPReceiver.AllowedClients {
+		objects = append(objects, &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-client-%d", otlpReceiverNetworkPolicyName, i),
+				Namespace: namespace,
+				Labels:    a.getCommonLabels(),
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: *selector.DeepCopy(),
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress: []networkingv1.NetworkPolicyEgressRule{
+					{
+						To:    []networkingv1.NetworkPolicyPeer{{PodSelector: receiverSelector.DeepCopy()}},
+						Ports: ports,
+					},
+				},
+			},
+		})
+	}
+
+	return objects
+}
+
+// getOtelCollectorGateway returns the [otelv1beta1.OpenTelemetryCollector] of
+// the OTLP gateway. The gateway is a lightweight and stateless collector (the
+// receiver tier), which receives the OTLP data and forwards it via OTLP to
+// the OTel Collector (the processing tier).
+//
+// The traffic between the gateway and the OTel Collector stays within the
+// namespace of the cluster and is not encrypted.
+func (a *Actuator) getOtelCollectorGateway(
+	namespace string,
+	cfg config.CollectorConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+	image *imagevectorutils.Image,
+) *otelv1beta1.OpenTelemetryCollector {
+	// The `networking.resources.gardener.cloud/to-<service>-tcp-<port>' label
+	toCollectorLabel := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + otelCollectorServiceName + "-tcp-" + strconv.Itoa(otelCollectorGRPCReceiverPort)
+
+	allLabels := utils.MergeStringMaps(
+		a.getCommonLabels(),
+		map[string]string{
+			v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed,
+			toCollectorLabel:                         v1beta1constants.LabelNetworkPolicyAllowed,
 		},
 	)
+
+	obj := &otelv1beta1.OpenTelemetryCollector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      otelCollectorGatewayName,
+			Namespace: namespace,
+			Labels:    allLabels,
+			Annotations: utils.MergeStringMaps(
+				a.getAnnotations(nil, len(cfg.Spec.Receivers.OTLPReceiver.AllowedClients) == 0),
+				map[string]string{
+					resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "pod-label-selector-namespace-alias": "all-shoots",
+					resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "namespace-selectors":                `[{"matchExpressions":[{"key":"kubernetes.io/metadata.name","operator":"In","values":["garden"]}]},{"matchExpressions":[{"key":"gardener.cloud/role","operator":"In","values":["extension"]}]}]`,
+				}),
+		},
+		Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+			Mode:                     otelv1beta1.ModeDeployment,
+			UpgradeStrategy:          otelv1beta1.UpgradeStrategyNone,
+			DeploymentUpdateStrategy: getDeploymentUpdateStrategy(cfg.Spec.UpdateStrategy),
+			OpenTelemetryCommonFields: otelv1beta1.OpenTelemetryCommonFields{
+				Image:             image.String(),
+				Replicas:          new(cfg.Spec.Gateway.Replicas),
+				PodAnnotations:    getPodAnnotations(cfg.Spec.PodAnnotations, nil),
+				PriorityClassName: v1beta1constants.PriorityClassNameShootControlPlane100,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("10m"),
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: new(false),
+				},
+				PodSecurityContext: getPodSecurityContext(cfg.Spec.SecurityContext),
+				// The gateway shares the service account with the
+				// collector, which provides the image pull secrets.
+				ServiceAccount:                otelCollectorServiceAccountName,
+				PodDNSConfig:                  ptr.Deref(cfg.Spec.DNS.Config, corev1.PodDNSConfig{}),
+				Lifecycle:                     getPreStopLifecycle(),
+				TerminationGracePeriodSeconds: new(getTerminationGracePeriodSeconds(cfg)),
+			},
+			StartupProbe: getStartupProbe(cfg.Spec.StartupProbe),
+			Config: otelv1beta1.Config{
+				Receivers: otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						"otlp": a.getOTLPReceiverConfig(cfg.Spec.Receivers.OTLPReceiver),
+					},
+				},
+				Processors: &otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						batchProcessorName:         a.getBatchProcessorConfig(a.batchProcessorConfig.Timeout),
+						memoryLimiterProcessorName: a.getMemoryLimiterProcessorConfig(),
+					},
+				},
+				Exporters: otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						gatewayExporterName: map[string]any{
+							configKeyEndpoint: fmt.Sprintf("%s:%d", otelCollectorServiceName, otelCollectorGRPCReceiverPort),
+							"tls": map[string]any{
+								"insecure": true,
+							},
+						},
+					},
+				},
+				Extensions: &otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						healthCheckExtensionName: map[string]any{
+							configKeyEndpoint: fmt.Sprintf("0.0.0.0:%d", otelCollectorHealthCheckPort),
+						},
+					},
+				},
+				Service: otelv1beta1.Service{
+					Extensions: []string{healthCheckExtensionName},
+					Telemetry: &otelv1beta1.AnyConfig{
+						Object: map[string]any{
+							"logs": map[string]any{
+								"level":    string(cfg.Spec.Logs.Level),
+								"encoding": string(cfg.Spec.Logs.Encoding),
+							},
+						},
+					},
+					Pipelines: map[string]*otelv1beta1.Pipeline{
+						config.PipelineNameLogs: {
+							Receivers:  []string{"otlp"},
+							Processors: []string{memoryLimiterProcessorName, batchProcessorName},
+							Exporters:  []string{gatewayExporterName},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if cfg.Spec.DNS.Policy != "" {
+		obj.Spec.DNSPolicy = new(cfg.Spec.DNS.Policy)
+	}
+
+	a.configureOTLPReceiverAuth(obj, cfg.Spec.Receivers.OTLPReceiver.Auth, resources)
+
+	return obj
+}
+
+// getOtelCollectorHostMetrics returns the [otelv1beta1.OpenTelemetryCollector],
+// which runs the host metrics receiver in daemonset mode on every node of the
+// seed cluster. It mounts the root filesystem of the node read-only and
+// forwards the metrics via OTLP to the OTel Collector, which processes them in
+// its metrics pipeline.
+//
+// Note that the hostPath volume requires the namespace of the extension to
+// allow privileged pods by the Pod Security Admission. The daemonset runs on
+// every seed node, hence it is only supported for seed-class extensions, see
+// [WithAllowHostMetricsReceiver].
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/hostmetricsreceiver
+func (a *Actuator) getOtelCollectorHostMetrics(
+	namespace string,
+	cfg config.CollectorConfig,
+	image *imagevectorutils.Image,
+) *otelv1beta1.OpenTelemetryCollector {
+	// The `networking.resources.gardener.cloud/to-<service>-tcp-<port>' label
+	toCollectorLabel := resourcesv1alpha1.NetworkPolicyLabelKeyPrefix + "to-" + otelCollectorServiceName + "-tcp-" + strconv.Itoa(otelCollectorHostMetricsReceiverPort)
+
+	allLabels := utils.MergeStringMaps(
+		a.getCommonLabels(),
+		map[string]string{
+			v1beta1constants.LabelNetworkPolicyToDNS: v1beta1constants.LabelNetworkPolicyAllowed,
+			toCollectorLabel:                         v1beta1constants.LabelNetworkPolicyAllowed,
+		},
+	)
+
+	receiver := cfg.Spec.Receivers.HostMetricsReceiver
+	scrapers := make(map[string]any, len(receiver.Scrapers))
+	for _, scraper := range receiver.Scrapers {
+		scrapers[scraper] = map[string]any{}
+	}
+
+	obj := &otelv1beta1.OpenTelemetryCollector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      otelCollectorHostMetricsName,
+			Namespace: namespace,
+			Labels:    allLabels,
+		},
+		Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+			Mode:            otelv1beta1.ModeDaemonSet,
+			UpgradeStrategy: otelv1beta1.UpgradeStrategyNone,
+			OpenTelemetryCommonFields: otelv1beta1.OpenTelemetryCommonFields{
+				Image:             image.String(),
+				PodAnnotations:    getPodAnnotations(cfg.Spec.PodAnnotations, nil),
+				PriorityClassName: v1beta1constants.PriorityClassNameShootControlPlane100,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("10m"),
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: new(false),
+					ReadOnlyRootFilesystem:   new(true),
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:             hostMetricsVolumeName,
+					MountPath:        hostMetricsVolumeMountPath,
+					ReadOnly:         true,
+					MountPropagation: new(corev1.MountPropagationHostToContainer),
+				}},
+				Volumes: []corev1.Volume{{
+					Name: hostMetricsVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+					},
+				}},
+				Env: []corev1.EnvVar{{
+					Name: "K8S_NODE_NAME",
+					ValueFrom: &corev1.EnvVarSource{
+						FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+					},
+				}},
+				// The host metrics collector shares the service
+				// account with the collector, which provides the image
+				// pull secrets.
+				ServiceAccount:                otelCollectorServiceAccountName,
+				PodDNSConfig:                  ptr.Deref(cfg.Spec.DNS.Config, corev1.PodDNSConfig{}),
+				Lifecycle:                     getPreStopLifecycle(),
+				TerminationGracePeriodSeconds: new(getTerminationGracePeriodSeconds(cfg)),
+			},
+			StartupProbe: getStartupProbe(cfg.Spec.StartupProbe),
+			Config: otelv1beta1.Config{
+				Receivers: otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						"hostmetrics": map[string]any{
+							"root_path":           hostMetricsVolumeMountPath,
+							"collection_interval": receiver.CollectionInterval.String(),
+							"scrapers":            scrapers,
+						},
+					},
+				},
+				Processors: &otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						batchProcessorName:         a.getBatchProcessorConfig(a.batchProcessorConfig.Timeout),
+						memoryLimiterProcessorName: a.getMemoryLimiterProcessorConfig(),
+						resourceProcessorName: map[string]any{
+							"attributes": []any{
+								upsertAttribute("k8s.node.name", "${env:K8S_NODE_NAME}"),
+							},
+						},
+					},
+				},
+				Exporters: otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						hostMetricsExporterName: map[string]any{
+							configKeyEndpoint: fmt.Sprintf("%s:%d", otelCollectorServiceName, otelCollectorHostMetricsReceiverPort),
+							"tls": map[string]any{
+								"insecure": true,
+							},
+						},
+					},
+				},
+				Extensions: &otelv1beta1.AnyConfig{
+					Object: map[string]any{
+						healthCheckExtensionName: map[string]any{
+							configKeyEndpoint: fmt.Sprintf("0.0.0.0:%d", otelCollectorHealthCheckPort),
+						},
+					},
+				},
+				Service: otelv1beta1.Service{
+					Extensions: []string{healthCheckExtensionName},
+					Telemetry: &otelv1beta1.AnyConfig{
+						Object: map[string]any{
+							"logs": map[string]any{
+								"level":    string(cfg.Spec.Logs.Level),
+								"encoding": string(cfg.Spec.Logs.Encoding),
+							},
+						},
+					},
+					Pipelines: map[string]*otelv1beta1.Pipeline{
+						config.PipelineNameMetrics: {
+							Receivers:  []string{"hostmetrics"},
+							Processors: []string{memoryLimiterProcessorName, resourceProcessorName, batchProcessorName},
+							Exporters:  []string{hostMetricsExporterName},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if cfg.Spec.DNS.Policy != "" {
+		obj.Spec.DNSPolicy = new(cfg.Spec.DNS.Policy)
+	}
+
+	return obj
+}
+
+// configureHostMetricsReceiver configures the internal OTLP receiver of the
+// collector, which receives the metrics of the host metrics collector, and
+// adds it to the metrics pipeline. The receiver does not authenticate its
+// clients, hence it binds a separate port, which is reachable only from the
+// pods of the host metrics collector.
+func (a *Actuator) configureHostMetricsReceiver(obj *otelv1beta1.OpenTelemetryCollector) {
+	if obj == nil {
+		return
+	}
+
+	pipeline, ok := obj.Spec.Config.Service.Pipelines[config.PipelineNameMetrics]
+	if !ok {
+		return
+	}
+
+	obj.Spec.Config.Receivers.Object[hostMetricsReceiverName] = map[string]any{
+		"protocols": map[string]any{
+			"grpc": map[string]any{
+				configKeyEndpoint: fmt.Sprintf("0.0.0.0:%d", otelCollectorHostMetricsReceiverPort),
+			},
+		},
+	}
+	pipeline.Receivers = append(pipeline.Receivers, hostMetricsReceiverName)
+}
+
+// configureOTLPReceiverAuth configures the given authentication settings for
+// the clients of the OTLP receiver.
+//
+// See the links below for more details about the server authenticators.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/extension/bearertokenauthextension
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/extension/basicauthextension
+func (a *Actuator) configureOTLPReceiverAuth(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	auth config.OTLPReceiverAuthConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+) {
+	if obj == nil || !auth.IsConfigured() {
+		return
+	}
+
+	var authenticator string
+	switch {
+	case auth.Token != nil:
+		authenticator = receiverBearerTokenAuthName
+		a.configureVolumeForBearerTokenAuthExtension(
+			obj,
+			auth.Token,
+			receiverBearerTokenAuthName,
+			receiverVolumeMountPathBearerToken,
+			receiverVolumeNameBearerToken,
+			receiverVolumeMountPathBearerToken,
+			resources,
+		)
+	case auth.Htpasswd != nil:
+		authenticator = receiverBasicAuthName
+		if obj.Spec.Config.Extensions == nil {
+			obj.Spec.Config.Extensions = &otelv1beta1.AnyConfig{}
+		}
+		if obj.Spec.Config.Extensions.Object == nil {
+			obj.Spec.Config.Extensions.Object = make(map[string]any)
+		}
+
+		obj.Spec.Config.Extensions.Object[receiverBasicAuthName] = map[string]any{
+			"htpasswd": map[string]any{
+				"file": filepath.Join(receiverVolumeMountPathBasicAuthFiles, auth.Htpasswd.ResourceRef.DataKey),
+			},
+		}
+		obj.Spec.Config.Service.Extensions = append(obj.Spec.Config.Service.Extensions, receiverBasicAuthName)
+
+		obj.Spec.Volumes = append(
+			obj.Spec.Volumes,
+			corev1.Volume{
+				Name: receiverVolumeNameBasicAuthHtpasswd,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: secretNameForResource(auth.Htpasswd.ResourceRef.Name, resources),
+					},
+				},
+			},
+		)
+		obj.Spec.VolumeMounts = append(
+			obj.Spec.VolumeMounts,
+			corev1.VolumeMount{
+				Name:      receiverVolumeNameBasicAuthHtpasswd,
+				MountPath: receiverVolumeMountPathBasicAuthFiles,
+				ReadOnly:  true,
+			},
+		)
+	}
+
+	receiver, ok := obj.Spec.Config.Receivers.Object["otlp"].(map[string]any)
+	if !ok {
+		return
+	}
+	protocols, ok := receiver["protocols"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, protocol := range protocols {
+		if protocolConfig, ok := protocol.(map[string]any); ok {
+			protocolConfig["auth"] = map[string]any{
+				"authenticator": authenticator,
+			}
+		}
+	}
+
+	obj.Spec.Config.Service.Extensions = sortServiceExtensions(obj.Spec.Config.Service.Extensions)
+}
+
+// getEventsClusterRole returns the [rbacv1.ClusterRole] granting the OTel
+// Collector's service account in the shoot cluster permission to list and watch
+// events from the events.k8s.io API group.
+func (a *Actuator) getEventsClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: otelCollectorName,
+		},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{"events.k8s.io"},
+			Resources: []string{"events"},
+			Verbs:     readVerbs,
+		}},
+	}
+}
+
+// getEventsClusterRoleBinding returns the [rbacv1.ClusterRoleBinding] that
+// binds the events ClusterRole to the OTel Collector's service account in the
+// shoot cluster's kube-system namespace.
+func (a *Actuator) getEventsClusterRoleBinding(serviceAccountName string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: otelCollectorName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     otelCollectorName,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccountName,
+			Namespace: metav1.NamespaceSystem,
+		}},
+	}
+}
+
+// getSeedEventsClusterRole returns the [rbacv1.ClusterRole] granting the OTel
+// Collector of a seed-class extension permission to list and watch events
+// from the events.k8s.io API group of the seed cluster.
+func (a *Actuator) getSeedEventsClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   seedEventsClusterRoleName,
+			Labels: a.getCommonLabels(),
+		},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{"events.k8s.io"},
+			Resources: []string{"events"},
+			Verbs:     readVerbs,
+		}},
+	}
+}
+
+// getSeedEventsClusterRoleBinding returns the [rbacv1.ClusterRoleBinding]
+// that binds the seed events ClusterRole to the OTel Collector's service
+// account in the given namespace of the seed cluster.
+func (a *Actuator) getSeedEventsClusterRoleBinding(namespace string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   seedEventsClusterRoleName,
+			Labels: a.getCommonLabels(),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     seedEventsClusterRoleName,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      otelCollectorServiceAccountName,
+			Namespace: namespace,
+		}},
+	}
+}
+
+// mergeReferencedConfig merges the components and pipelines of the given
+// collector configuration into the configuration of the given collector. The
+// components and service extensions managed by the extension cannot be
+// overridden or enabled again.
+func mergeReferencedConfig(obj *otelv1beta1.OpenTelemetryCollector, referenced *otelv1beta1.Config) error {
+	if obj == nil || referenced == nil {
+		return nil
+	}
+
+	if referenced.Service.Telemetry != nil {
+		return errors.New("service telemetry is managed by the extension")
+	}
+
+	target := &obj.Spec.Config
+	for _, c := range []struct {
+		kind   string
+		source *otelv1beta1.AnyConfig
+		target **otelv1beta1.AnyConfig
+	}{
+		{"processor", referenced.Processors, &target.Processors},
+		{"connector", referenced.Connectors, &target.Connectors},
+		{"extension", referenced.Extensions, &target.Extensions},
+	} {
+		if c.source == nil {
+			continue
+		}
+		if *c.target == nil {
+			*c.target = &otelv1beta1.AnyConfig{}
+		}
+		if err := mergeComponents(c.kind, *c.target, c.source); err != nil {
+			return err
+		}
+	}
+
+	if err := mergeComponents("receiver", &target.Receivers, &referenced.Receivers); err != nil {
+		return err
+	}
+	if err := mergeComponents("exporter", &target.Exporters, &referenced.Exporters); err != nil {
+		return err
+	}
+
+	for name, pipeline := range referenced.Service.Pipelines {
+		if _, ok := target.Service.Pipelines[name]; ok {
+			return fmt.Errorf("pipeline %q is managed by the extension", name)
+		}
+		target.Service.Pipelines[name] = pipeline
+	}
+
+	// The collector refuses to start with an extension listed twice.
+	extensions := slices.Clone(target.Service.Extensions)
+	for _, name := range referenced.Service.Extensions {
+		if slices.Contains(extensions, name) {
+			return fmt.Errorf("service extension %q is already enabled", name)
+		}
+		extensions = append(extensions, name)
+	}
+	target.Service.Extensions = sortServiceExtensions(extensions)
+
+	return nil
+}
+
+// mergeComponents adds the components from the given source to the given
+// target, failing on components, which are already configured.
+func mergeComponents(kind string, target, source *otelv1beta1.AnyConfig) error {
+	if target.Object == nil {
+		target.Object = make(map[string]any)
+	}
+
+	for name, item := range source.Object {
+		if _, ok := target.Object[name]; ok {
+			return fmt.Errorf("%s %q is managed by the extension", kind, name)
+		}
+		target.Object[name] = item
+	}
+
+	return nil
+}
+
+// configureTelemetryResource configures the resource attributes of the
+// internal telemetry of the given collector, which identify the cluster in
+// the given namespace along with the given seed. Attributes, which are not
+// known, are omitted.
+func (a *Actuator) configureTelemetryResource(obj *otelv1beta1.OpenTelemetryCollector, namespace, seedName string) {
+	if obj == nil || obj.Spec.Config.Service.Telemetry == nil {
+		return
+	}
+
+	clusterName, projectName, shootName := parseShootNamespaceAttributes(namespace)
+	attributes := map[string]any{
+		"k8s.cluster.name":      clusterName,
+		"gardener.project.name": projectName,
+		"gardener.shoot.name":   shootName,
+		"gardener.seed.name":    seedName,
+	}
+	maps.DeleteFunc(attributes, func(_ string, v any) bool {
+		return v == ""
+	})
+
+	obj.Spec.Config.Service.Telemetry.Object["resource"] = attributes
+}
+
+// configureDisabledMetrics configures the views of the internal telemetry,
+// which drop the instruments of the internal metrics with the given names.
+//
+// See [Internal telemetry] for more details.
+//
+// [Internal telemetry]: https://opentelemetry.io/docs/collector/internal-telemetry/
+func (a *Actuator) configureDisabledMetrics(obj *otelv1beta1.OpenTelemetryCollector, names []string) {
+	if obj == nil || obj.Spec.Config.Service.Telemetry == nil {
+		return
+	}
+
+	metrics, ok := obj.Spec.Config.Service.Telemetry.Object["metrics"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	views := make([]any, 0, len(names))
+	for _, name := range names {
+		views = append(views, map[string]any{
+			"selector": map[string]any{
+				"instrument_name": name,
+			},
+			"stream": map[string]any{
+				"aggregation": map[string]any{
+					"drop": map[string]any{},
+				},
+			},
+		})
+	}
+
+	metrics["views"] = views
+}
+
+// configureDefaultExporter configures the OTLP HTTP exporter for the default
+// backend configured by the operator, which is added to the pipelines managed
+// by the extension.
+func (a *Actuator) configureDefaultExporter(obj *otelv1beta1.OpenTelemetryCollector) {
+	if obj == nil || a.defaultExporterEndpoint == "" {
+		return
+	}
+
+	exporter := map[string]any{
+		configKeyEndpoint: a.defaultExporterEndpoint,
+	}
+
+	if a.defaultExporterSecret.Name != "" {
+		exporter["auth"] = map[string]any{
+			"authenticator": defaultExporterBearerTokenAuthName,
+		}
+
+		// The secret is copied by the extension, hence it is not
+		// a referenced resource of the shoot.
+		if obj.Spec.Config.Extensions == nil {
+			obj.Spec.Config.Extensions = &otelv1beta1.AnyConfig{}
+		}
+		if obj.Spec.Config.Extensions.Object == nil {
+			obj.Spec.Config.Extensions.Object = make(map[string]any)
+		}
+		obj.Spec.Config.Extensions.Object[defaultExporterBearerTokenAuthName] = map[string]any{
+			"filename": filepath.Join(defaultExporterVolumeMountPathBearerToken, defaultExporterSecretDataKey),
+		}
+		obj.Spec.Config.Service.Extensions = sortServiceExtensions(
+			append(obj.Spec.Config.Service.Extensions, defaultExporterBearerTokenAuthName),
+		)
+
+		obj.Spec.Volumes = append(obj.Spec.Volumes, corev1.Volume{
+			Name: defaultExporterVolumeNameBearerToken,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: defaultExporterSecretName},
+			},
+		})
+		obj.Spec.VolumeMounts = append(obj.Spec.VolumeMounts, corev1.VolumeMount{
+			Name:      defaultExporterVolumeNameBearerToken,
+			MountPath: defaultExporterVolumeMountPathBearerToken,
+			ReadOnly:  true,
+		})
+	}
+
+	obj.Spec.Config.Exporters.Object[defaultExporterName] = exporter
+
+	for _, name := range []string{config.PipelineNameLogs, config.PipelineNameEvents, config.PipelineNameMetrics} {
+		pipeline, ok := obj.Spec.Config.Service.Pipelines[name]
+		if !ok {
+			continue
+		}
+		// The exporters of the managed pipelines share the same
+		// backing array, so make sure to not modify it.
+		pipeline.Exporters = append(slices.Clone(pipeline.Exporters), defaultExporterName)
+	}
+}
+
+// configureSeedClass adjusts the given OTel collector for a seed-class
+// extension. There is no shoot cluster for such extensions, so the
+// k8sobjects/events receiver uses the service account of the collector in
+// order to watch the events of the seed cluster instead.
+func (a *Actuator) configureSeedClass(obj *otelv1beta1.OpenTelemetryCollector) {
+	obj.Spec.Env = slices.DeleteFunc(obj.Spec.Env, func(env corev1.EnvVar) bool {
+		return env.Name == "KUBECONFIG"
+	})
+	obj.Spec.Volumes = slices.DeleteFunc(obj.Spec.Volumes, func(v corev1.Volume) bool {
+		return v.Name == volumeNameShootKubeconfig
+	})
+	obj.Spec.VolumeMounts = slices.DeleteFunc(obj.Spec.VolumeMounts, func(vm corev1.VolumeMount) bool {
+		return vm.Name == volumeNameShootKubeconfig
+	})
+
+	if receiver, ok := obj.Spec.Config.Receivers.Object["k8sobjects/events"].(map[string]any); ok {
+		receiver["auth_type"] = "serviceAccount"
+	}
+}
+
+// hasResourceReferences returns true, if the given [config.CollectorConfig]
+// refers to any named resource.
+func hasResourceReferences(cfg config.CollectorConfig) bool {
+	tlsConfigs := []*config.TLSConfig{
+		cfg.Spec.Exporters.OTLPHTTPExporter.TLS,
+		cfg.Spec.Exporters.OTLPGRPCExporter.TLS,
+	}
+	for _, tls := range tlsConfigs {
+		if tls != nil && (tls.CA != nil || tls.Cert != nil || tls.Key != nil) {
+			return true
+		}
+	}
+
+	if cfg.Spec.Exporters.OTLPHTTPExporter.Token != nil || cfg.Spec.Exporters.OTLPGRPCExporter.Token != nil {
+		return true
+	}
+
+	for _, exporter := range cfg.Spec.Exporters.Named {
+		tls, token := getNamedExporterReferences(exporter)
+		if token != nil || (tls != nil && (tls.CA != nil || tls.Cert != nil || tls.Key != nil)) {
+			return true
+		}
+	}
+
+	if cfg.Spec.Receivers.OTLPReceiver.Auth.IsConfigured() {
+		return true
+	}
+
+	if cfg.Spec.ConfigRef != nil {
+		return true
+	}
+
+	for _, sc := range cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs {
+		if scrapeConfigCredentials(sc) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configMapNameForResource returns the name of the ConfigMap in the namespace
+// of the cluster for the given resource of the shoot, or an empty string if
+// the resource does not reference a ConfigMap.
+func configMapNameForResource(resourceName string, resources []gardencorev1beta1.NamedResourceReference) string {
+	for _, r := range resources {
+		if r.Name == resourceName &&
+			r.ResourceRef.APIVersion == corev1.SchemeGroupVersion.String() && r.ResourceRef.Kind == "ConfigMap" {
+			return v1beta1constants.ReferencedResourcesPrefix + r.ResourceRef.Name
+		}
+	}
+
+	return ""
+}
+
+func secretNameForResource(resourceName string, resources []gardencorev1beta1.NamedResourceReference) string {
+	for _, r := range resources {
+		if r.Name == resourceName &&
+			r.ResourceRef.APIVersion == corev1.SchemeGroupVersion.String() && r.ResourceRef.Kind == "Secret" {
+			return v1beta1constants.ReferencedResourcesPrefix + r.ResourceRef.Name
+		}
+	}
+
+	return ""
+}
+
+// configureVolumes configures the given additional volumes for the
+// OpenTelemetry collector. The names of the volumes are prefixed, so that they
+// do not collide with the volumes managed by the extension.
+func configureVolumes(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	volumes []config.VolumeConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+) {
+	const baseVolumeName = "extra"
+
+	if obj == nil {
+		return
+	}
+
+	for _, v := range volumes {
+		volume := corev1.Volume{Name: baseVolumeName + "-" + v.Name}
+		mount := corev1.VolumeMount{Name: volume.Name, MountPath: v.MountPath}
+
+		switch {
+		case v.EmptyDir != nil:
+			volume.EmptyDir = v.EmptyDir.DeepCopy()
+		case v.ResourceRef != nil:
+			items := []corev1.KeyToPath{{Key: v.ResourceRef.DataKey, Path: v.ResourceRef.DataKey}}
+			mount.ReadOnly = true
+			if name := secretNameForResource(v.ResourceRef.Name, resources); name != "" {
+				volume.Secret = &corev1.SecretVolumeSource{SecretName: name, Items: items}
+			} else {
+				volume.ConfigMap = &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: configMapNameForResource(v.ResourceRef.Name, resources)},
+					Items:                items,
+				}
+			}
+		default:
+			continue
+		}
+
+		obj.Spec.Volumes = append(obj.Spec.Volumes, volume)
+		obj.Spec.VolumeMounts = append(obj.Spec.VolumeMounts, mount)
+	}
+}
+
+// configureVolumeForTLS configures a volume for the OpenTelemetry collector for
+// TLS secrets.
+func (a *Actuator) configureVolumeForTLS(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	tls *config.TLSConfig,
+	volumeName string,
+	volumeMount string,
+	resources []gardencorev1beta1.NamedResourceReference,
+) {
+	if obj == nil || tls == nil {
+		return
+	}
+
+	volume := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{},
+		},
+	}
+
+	addSecretToProjectedVolume := func(resourceRef config.ResourceReferenceDetails) {
+		volume.Projected.Sources = append(
+			volume.Projected.Sources,
+			corev1.VolumeProjection{
+				Secret: &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretNameForResource(resourceRef.Name, resources),
+					},
+					Items: []corev1.KeyToPath{{Key: resourceRef.DataKey, Path: resourceRef.DataKey}},
+				},
+			},
+		)
+	}
+
+	if tls.CA != nil {
+		addSecretToProjectedVolume(tls.CA.ResourceRef)
+	}
+	if tls.Cert != nil {
+		addSecretToProjectedVolume(tls.Cert.ResourceRef)
+	}
+	if tls.Key != nil {
+		addSecretToProjectedVolume(tls.Key.ResourceRef)
+	}
+
+	obj.Spec.Volumes = append(obj.Spec.Volumes, volume)
+	obj.Spec.VolumeMounts = append(
+		obj.Spec.VolumeMounts,
+		corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: volumeMount,
+		},
+	)
+}
+
+// configureVolumeForBearerTokenAuthExtension configures a volume for the
+// OpenTelemetry collector for the bearertokenauth extension.
+func (a *Actuator) configureVolumeForBearerTokenAuthExtension(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	ref *config.ResourceReference,
+	authExtensionName string,
+	tokenBasePath string,
+	volumeName string,
+	volumeMount string,
+	resources []gardencorev1beta1.NamedResourceReference,
+) {
+	if obj == nil || ref == nil {
+		return
+	}
+
+	if obj.Spec.Config.Extensions == nil {
+		obj.Spec.Config.Extensions = &otelv1beta1.AnyConfig{}
+	}
+
+	if obj.Spec.Config.Extensions.Object == nil {
+		obj.Spec.Config.Extensions.Object = make(map[string]any)
+	}
+
+	obj.Spec.Config.Extensions.Object[authExtensionName] = map[string]any{
+		"filename": filepath.Join(tokenBasePath, ref.ResourceRef.DataKey),
+	}
+
+	obj.Spec.Config.Service.Extensions = append(obj.Spec.Config.Service.Extensions, authExtensionName)
+
+	obj.Spec.Volumes = append(
+		obj.Spec.Volumes,
+		corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretNameForResource(ref.ResourceRef.Name, resources),
+				},
+			},
+		},
+	)
+
+	obj.Spec.VolumeMounts = append(
+		obj.Spec.VolumeMounts,
+		corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: volumeMount,
+		},
+	)
+}
+
+// configureNamedProcessors configures the given named instances of the
+// processors, which are referenced by their names in the processors of the
+// forward pipelines.
+func (a *Actuator) configureNamedProcessors(obj *otelv1beta1.OpenTelemetryCollector, processors []config.NamedProcessorConfig) {
+	if obj == nil || len(processors) == 0 {
+		return
+	}
+
+	if obj.Spec.Config.Processors == nil {
+		obj.Spec.Config.Processors = &otelv1beta1.AnyConfig{}
+	}
+
+	if obj.Spec.Config.Processors.Object == nil {
+		obj.Spec.Config.Processors.Object = make(map[string]any)
+	}
+
+	for _, processor := range processors {
+		switch {
+		case processor.Batch != nil:
+			obj.Spec.Config.Processors.Object[processor.ProcessorName()] = a.getNamedBatchProcessorConfig(*processor.Batch)
+		case processor.MemoryLimiter != nil:
+			obj.Spec.Config.Processors.Object[processor.ProcessorName()] = a.getNamedMemoryLimiterProcessorConfig(*processor.MemoryLimiter)
+		case processor.Transform != nil:
+			obj.Spec.Config.Processors.Object[processor.ProcessorName()] = getNamedTransformProcessorConfig(*processor.Transform)
+		}
+	}
+}
+
+// getNamedBatchProcessorConfig returns the settings for a named instance of the
+// Batch processor, which default to the ones of the extension. The maximum size
+// of the batches is raised to the size of the batches, if necessary, and caps
+// it otherwise.
+func (a *Actuator) getNamedBatchProcessorConfig(cfg config.NamedBatchProcessorConfig) map[string]any {
+	processor := a.getBatchProcessorConfig(cmp.Or(cfg.Timeout, a.batchProcessorConfig.Timeout))
+	if size := uint32(cfg.SendBatchSize); size > 0 {
+		processor["send_batch_size"] = size
+		if maxSize := a.batchProcessorConfig.SendBatchMaxSize; maxSize > 0 {
+			processor["send_batch_max_size"] = max(maxSize, size)
+		}
+	}
+	if maxSize := uint32(cfg.SendBatchMaxSize); maxSize > 0 {
+		processor["send_batch_size"] = min(cmp.Or(uint32(cfg.SendBatchSize), a.batchProcessorConfig.SendBatchSize), maxSize)
+		processor["send_batch_max_size"] = maxSize
+	}
+
+	return processor
+}
+
+// getNamedMemoryLimiterProcessorConfig returns the settings for a named
+// instance of the Memory Limiter processor, which default to the ones of the
+// extension. The limits in percent replace the absolute limits of the
+// extension, which would take precedence otherwise. Like for absolute limits,
+// the spike limit defaults to a fifth of the limit.
+func (a *Actuator) getNamedMemoryLimiterProcessorConfig(cfg config.NamedMemoryLimiterProcessorConfig) map[string]any {
+	processor := a.getMemoryLimiterProcessorConfig()
+	if cfg.CheckInterval > 0 {
+		processor["check_interval"] = cfg.CheckInterval.String()
+	}
+	if cfg.LimitPercentage > 0 {
+		processor["limit_mib"] = uint32(0)
+		processor["spike_limit_mib"] = uint32(0)
+		processor["limit_percentage"] = uint32(cfg.LimitPercentage)
+		processor["spike_limit_percentage"] = uint32(cmp.Or(cfg.SpikeLimitPercentage, cfg.LimitPercentage/5))
+	}
+
+	return processor
+}
+
+// getNamedTransformProcessorConfig returns the settings for a named instance of
+// the Transform processor. The statements are given as flat lists, so that
+// the processor infers the OTTL context of each statement.
+func getNamedTransformProcessorConfig(cfg config.NamedTransformProcessorConfig) map[string]any {
+	processor := make(map[string]any)
+	for key, statements := range map[string][]string{
+		"metric_statements": cfg.MetricStatements,
+		"log_statements":    cfg.LogStatements,
+	} {
+		if len(statements) == 0 {
+			continue
+		}
+
+		items := make([]any, 0, len(statements))
+		for _, statement := range statements {
+			items = append(items, statement)
+		}
+		processor[key] = items
+	}
+
+	return processor
+}
+
+// configureForwardPipelines configures the given pipelines, which receive
+// their data from other pipelines via the forward connector.
+//
+// See the link below for more details about the forward connector.
+//
+// https://github.com/open-telemetry/opentelemetry-collector/tree/main/connector/forwardconnector
+func (a *Actuator) configureForwardPipelines(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	pipelines []config.ForwardPipelineConfig,
+	exporterNames []string,
+) {
+	if obj == nil || len(pipelines) == 0 {
+		return
+	}
+
+	if obj.Spec.Config.Connectors == nil {
+		obj.Spec.Config.Connectors = &otelv1beta1.AnyConfig{}
+	}
+
+	if obj.Spec.Config.Connectors.Object == nil {
+		obj.Spec.Config.Connectors.Object = make(map[string]any)
+	}
+
+	for _, pipeline := range pipelines {
+		connectorName := "forward/" + pipeline.Name
+		obj.Spec.Config.Connectors.Object[connectorName] = map[string]any{}
+
+		for _, from := range pipeline.From {
+			source, ok := obj.Spec.Config.Service.Pipelines[from]
+			if !ok {
+				continue
+			}
+			// The exporters of the managed pipelines share the
+			// same backing array, so make sure to not modify it.
+			source.Exporters = append(slices.Clone(source.Exporters), connectorName)
+		}
+
+		exporters := exporterNames
+		if len(pipeline.Exporters) > 0 {
+			exporters = pipeline.Exporters
+		}
+
+		obj.Spec.Config.Service.Pipelines[pipeline.Name] = &otelv1beta1.Pipeline{
+			Receivers:  []string{connectorName},
+			Processors: append([]string{}, pipeline.Processors...),
+			Exporters:  exporters,
+		}
+	}
+}
+
+// configureFileExporterFallback configures the file exporter as a fallback of
+// the given exporters in the managed pipelines. Each managed pipeline exports
+// to a failover connector, which routes the data to a pipeline with the given
+// exporters, and to a pipeline with the file exporter, when the former refuses
+// the data, e.g. because of a full sending queue.
+//
+// See the link below for more details about the failover connector.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/connector/failoverconnector
+func (a *Actuator) configureFileExporterFallback(obj *otelv1beta1.OpenTelemetryCollector, exporterNames []string) {
+	if obj == nil {
+		return
+	}
+
+	if obj.Spec.Config.Connectors == nil {
+		obj.Spec.Config.Connectors = &otelv1beta1.AnyConfig{}
+	}
+
+	if obj.Spec.Config.Connectors.Object == nil {
+		obj.Spec.Config.Connectors.Object = make(map[string]any)
+	}
+
+	managedPipelines := []string{
+		config.PipelineNameLogs,
+		config.PipelineNameEvents,
+		config.PipelineNameMetrics,
+		config.PipelineNameServiceGraph,
+		config.PipelineNameSpanMetrics,
+	}
+
+	for _, name := range managedPipelines {
+		pipeline, ok := obj.Spec.Config.Service.Pipelines[name]
+		if !ok {
+			continue
+		}
+
+		connectorName := "failover/" + strings.ReplaceAll(name, "/", "_")
+		primary, fallback := config.FailoverPipelineNames(name)
+		obj.Spec.Config.Connectors.Object[connectorName] = map[string]any{
+			"priority_levels": []any{
+				[]string{primary},
+				[]string{fallback},
+			},
+		}
+
+		// The forward connectors and the per-pipeline debug exporters
+		// remain in the managed pipeline.
+		exporters := slices.DeleteFunc(slices.Clone(pipeline.Exporters), func(exporter string) bool {
+			return slices.Contains(exporterNames, exporter)
+		})
+		pipeline.Exporters = append([]string{connectorName}, exporters...)
+
+		obj.Spec.Config.Service.Pipelines[primary] = &otelv1beta1.Pipeline{
+			Receivers: []string{connectorName},
+			Exporters: exporterNames,
+		}
+		obj.Spec.Config.Service.Pipelines[fallback] = &otelv1beta1.Pipeline{
+			Receivers: []string{connectorName},
+			Exporters: []string{config.ExporterNameFile},
+		}
+	}
+}
+
+// configureProcessorErrorMode configures the given error mode for all
+// processors of the collector, which evaluate OTTL statements or conditions.
+func (a *Actuator) configureProcessorErrorMode(obj *otelv1beta1.OpenTelemetryCollector, errorMode config.ErrorMode) {
+	if obj == nil || obj.Spec.Config.Processors == nil || errorMode == "" {
+		return
+	}
+
+	for name, item := range obj.Spec.Config.Processors.Object {
+		processorType, _, _ := strings.Cut(name, "/")
+		if !slices.Contains(ottlProcessorTypes, processorType) {
+			continue
+		}
+
+		processor, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		processor["error_mode"] = string(errorMode)
+	}
+}
+
+// configureTailSampling configures the given settings for all Tail Sampling
+// processors of the collector, which are provided by the referenced collector
+// configuration. Settings, which are not specified, are retained, so that the
+// values of the referenced configuration or the upstream defaults apply.
+func configureTailSampling(obj *otelv1beta1.OpenTelemetryCollector, cfg config.TailSamplingProcessorConfig) {
+	if obj == nil || obj.Spec.Config.Processors == nil || cfg == (config.TailSamplingProcessorConfig{}) {
+		return
+	}
+
+	for name, item := range obj.Spec.Config.Processors.Object {
+		processorType, _, _ := strings.Cut(name, "/")
+		if processorType != config.ProcessorNameTailSampling {
+			continue
+		}
+
+		processor, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if cfg.DecisionWait > 0 {
+			processor["decision_wait"] = cfg.DecisionWait.String()
+		}
+		if cfg.NumTraces > 0 {
+			processor["num_traces"] = cfg.NumTraces
+		}
+		if cfg.ExpectedNewTracesPerSec > 0 {
+			processor["expected_new_traces_per_sec"] = cfg.ExpectedNewTracesPerSec
+		}
+	}
+}
+
+// configureMetricNamePrefix configures a transform processor, which prepends
+// the given prefix to the names of the metrics, in the metrics pipeline. The
+// processor is inserted in front of the batch processor, so that the metrics
+// forwarded to other pipelines are prefixed as well.
+func (a *Actuator) configureMetricNamePrefix(obj *otelv1beta1.OpenTelemetryCollector, prefix string) {
+	if obj == nil || prefix == "" {
+		return
+	}
+
+	pipeline, ok := obj.Spec.Config.Service.Pipelines[config.PipelineNameMetrics]
+	if !ok {
+		return
+	}
+
+	obj.Spec.Config.Processors.Object[transformMetricNamePrefixProcessorName] = map[string]any{
+		"metric_statements": []any{
+			map[string]any{
+				"context": "metric",
+				"statements": []any{
+					fmt.Sprintf(`set(metric.name, Concat([%q, metric.name], ""))`, prefix),
+				},
+			},
+		},
+	}
+
+	idx := slices.Index(pipeline.Processors, batchProcessorName)
+	if idx < 0 {
+		idx = len(pipeline.Processors)
+	}
+	pipeline.Processors = slices.Insert(pipeline.Processors, idx, transformMetricNamePrefixProcessorName)
+}
+
+// configureMetricsTransform configures the metricstransform processor with
+// the given transforms in the metrics pipeline. The processor is inserted in
+// front of the batch processor.
+func (a *Actuator) configureMetricsTransform(obj *otelv1beta1.OpenTelemetryCollector, cfg config.MetricsTransformProcessorConfig) {
+	if obj == nil || len(cfg.Transforms) == 0 {
+		return
+	}
+
+	pipeline, ok := obj.Spec.Config.Service.Pipelines[config.PipelineNameMetrics]
+	if !ok {
+		return
+	}
+
+	transforms := make([]any, 0, len(cfg.Transforms))
+	for _, item := range cfg.Transforms {
+		transform := map[string]any{
+			"include":    item.Include,
+			"match_type": cmp.Or(item.MatchType, "strict"),
+			"action":     item.Action,
+		}
+		if item.NewName != "" {
+			transform["new_name"] = item.NewName
+		}
+
+		operations := make([]any, 0, len(item.Operations))
+		for _, op := range item.Operations {
+			operation := map[string]any{
+				"action": op.Action,
+			}
+			for key, value := range map[string]string{
+				"label":            op.Label,
+				"new_label":        op.NewLabel,
+				"label_value":      op.LabelValue,
+				"new_value":        op.NewValue,
+				"aggregation_type": op.AggregationType,
+			} {
+				if value != "" {
+					operation[key] = value
+				}
+			}
+			if len(op.LabelSet) > 0 {
+				operation["label_set"] = slices.Clone(op.LabelSet)
+			}
+			if len(op.AggregatedValues) > 0 {
+				operation["aggregated_values"] = slices.Clone(op.AggregatedValues)
+			}
+			operations = append(operations, operation)
+		}
+		if len(operations) > 0 {
+			transform["operations"] = operations
+		}
+
+		transforms = append(transforms, transform)
+	}
+
+	obj.Spec.Config.Processors.Object[metricsTransformProcessorName] = map[string]any{
+		"transforms": transforms,
+	}
+
+	idx := slices.Index(pipeline.Processors, batchProcessorName)
+	if idx < 0 {
+		idx = len(pipeline.Processors)
+	}
+	pipeline.Processors = slices.Insert(pipeline.Processors, idx, metricsTransformProcessorName)
+}
+
+// configurePipelineDebugExporters configures the given debug exporters, each
+// of which is added to a single pipeline only.
+func (a *Actuator) configurePipelineDebugExporters(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	debugExporters []config.PipelineDebugExporterConfig,
+) {
+	for _, item := range debugExporters {
+		pipeline, ok := obj.Spec.Config.Service.Pipelines[item.Pipeline]
+		if !ok {
+			continue
+		}
+
+		name := item.ExporterName()
+		obj.Spec.Config.Exporters.Object[name] = a.getDebugExporterConfig(config.DebugExporterConfig{
+			Verbosity: item.Verbosity,
+		})
+
+		// The exporters slice may be shared between pipelines, so make
+		// sure to copy it before appending to it.
+		pipeline.Exporters = append(slices.Clone(pipeline.Exporters), name)
+	}
+}
+
+// scrapeConfigCredentials returns the [config.ResourceReference] to the
+// credentials of the given scrape job, if any.
+func scrapeConfigCredentials(sc config.ScrapeConfig) *config.ResourceReference {
+	switch {
+	case sc.Authorization != nil:
+		return sc.Authorization.Credentials
+	case sc.BasicAuth != nil:
+		return sc.BasicAuth.Password
+	default:
+		return nil
+	}
+}
+
+// configureNativeHistograms configures the Prometheus receiver to scrape and
+// preserve native histograms. Native histograms are exposed via the protobuf
+// exposition format only, which is preferred over the text-based formats by
+// the receiver, once the respective feature gate is enabled.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/prometheusreceiver#prometheus-native-histograms
+func (a *Actuator) configureNativeHistograms(obj *otelv1beta1.OpenTelemetryCollector) {
+	receiver, ok := obj.Spec.Config.Receivers.Object[configKeyPrometheus].(map[string]any)
+	if !ok {
+		return
+	}
+	promConfig, ok := receiver["config"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	global, ok := promConfig["global"].(map[string]any)
+	if !ok {
+		global = make(map[string]any)
+		promConfig["global"] = global
+	}
+
+	global["scrape_protocols"] = []any{
+		"PrometheusProto",
+		"OpenMetricsText1.0.0",
+		"OpenMetricsText0.0.1",
+		"PrometheusText0.0.4",
+	}
+
+	if obj.Spec.Args == nil {
+		obj.Spec.Args = make(map[string]string)
+	}
+	obj.Spec.Args["feature-gates"] = nativeHistogramsFeatureGate
+}
+
+// configureScrapeLimits configures the given limits as defaults in the global
+// settings of the Prometheus receiver, which apply to all scrape jobs,
+// including the ones provided by the Target Allocator.
+func (a *Actuator) configureScrapeLimits(obj *otelv1beta1.OpenTelemetryCollector, limits config.ScrapeLimitsConfig) {
+	items := getScrapeLimits(limits)
+	if obj == nil || len(items) == 0 {
+		return
+	}
+
+	receiver, ok := obj.Spec.Config.Receivers.Object[configKeyPrometheus].(map[string]any)
+	if !ok {
+		return
+	}
+	promConfig, ok := receiver["config"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	global, ok := promConfig["global"].(map[string]any)
+	if !ok {
+		global = make(map[string]any)
+		promConfig["global"] = global
+	}
+
+	maps.Copy(global, items)
+}
+
+// configureStartTimeMetric configures the Prometheus receiver to derive the
+// start time of the cumulative metrics from the start time metric of the
+// scraped targets, which is matched by the given regular expression, if any.
+func (a *Actuator) configureStartTimeMetric(obj *otelv1beta1.OpenTelemetryCollector, regex string) {
+	if obj == nil {
+		return
+	}
+
+	receiver, ok := obj.Spec.Config.Receivers.Object[configKeyPrometheus].(map[string]any)
+	if !ok {
+		return
+	}
+
+	receiver["use_start_time_metric"] = true
+	if regex != "" {
+		receiver["start_time_metric_regex"] = regex
+	}
+}
+
+// configureStatsDReceiver configures the StatsD receiver with the given
+// settings and adds it to the metrics pipeline.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/statsdreceiver
+func (a *Actuator) configureStatsDReceiver(obj *otelv1beta1.OpenTelemetryCollector, receiver config.StatsDReceiverConfig) {
+	if obj == nil {
+		return
+	}
+
+	pipeline, ok := obj.Spec.Config.Service.Pipelines[config.PipelineNameMetrics]
+	if !ok {
+		return
+	}
+
+	obj.Spec.Config.Receivers.Object[config.ReceiverNameStatsD] = map[string]any{
+		configKeyEndpoint:      receiver.Endpoint,
+		"transport":            "udp",
+		"aggregation_interval": receiver.AggregationInterval.String(),
+		"enable_metric_type":   receiver.IsEnableMetricTypeEnabled(),
+	}
+	pipeline.Receivers = append(pipeline.Receivers, config.ReceiverNameStatsD)
+}
+
+// tracesConnectorNames specifies the connectors, which derive metrics from
+// the spans of the traces pipelines.
+var tracesConnectorNames = []string{
+	config.ConnectorNameServiceGraph,
+	config.ConnectorNameSpanMetrics,
+}
+
+// exemplarStrippingProcessorTypes specifies the types of the processors, which
+// aggregate data points without retaining their exemplars.
+var exemplarStrippingProcessorTypes = []string{"metricstransform"}
+
+// configureTracesConnector configures the given connector along with the
+// given metrics pipeline, which receives the metrics derived from the spans by
+// the connector and exports them via the given exporters. The connector is
+// attached to the traces pipelines via [connectTracesConnectors], once the
+// referenced collector configuration is merged.
+func configureTracesConnector(obj *otelv1beta1.OpenTelemetryCollector, connector, pipeline string, settings map[string]any, exporterNames []string) {
+	if obj.Spec.Config.Connectors == nil {
+		obj.Spec.Config.Connectors = &otelv1beta1.AnyConfig{}
+	}
+
+	if obj.Spec.Config.Connectors.Object == nil {
+		obj.Spec.Config.Connectors.Object = make(map[string]any)
+	}
+
+	obj.Spec.Config.Connectors.Object[connector] = settings
+	obj.Spec.Config.Service.Pipelines[pipeline] = &otelv1beta1.Pipeline{
+		Receivers:  []string{connector},
+		Processors: []string{resourceProcessorName, memoryLimiterProcessorName, batchProcessorName},
+		Exporters:  exporterNames,
+	}
+}
+
+// configureServiceGraphConnector configures the given Service Graph connector
+// along with the metrics pipeline, which receives the metrics of the service
+// graph and exports them via the given exporters.
+func (a *Actuator) configureServiceGraphConnector(obj *otelv1beta1.OpenTelemetryCollector, connector config.ServiceGraphConnectorConfig, exporterNames []string) {
+	if obj == nil {
+		return
+	}
+
+	// See the link below for more details about the settings of the
+	// Service Graph connector.
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/connector/servicegraphconnector
+	settings := map[string]any{}
+	if len(connector.LatencyHistogramBuckets) > 0 {
+		buckets := make([]string, 0, len(connector.LatencyHistogramBuckets))
+		for _, bucket := range connector.LatencyHistogramBuckets {
+			buckets = append(buckets, bucket.String())
+		}
+		settings["latency_histogram_buckets"] = buckets
+	}
+	if len(connector.Dimensions) > 0 {
+		settings["dimensions"] = connector.Dimensions
+	}
+
+	configureTracesConnector(obj, config.ConnectorNameServiceGraph, config.PipelineNameServiceGraph, settings, exporterNames)
+}
+
+// configureSpanMetricsConnector configures the given Span Metrics connector
+// along with the metrics pipeline, which receives the metrics derived from the
+// spans and exports them via the given exporters. The managed pipeline does
+// not contain any processor, which strips the exemplars.
+func (a *Actuator) configureSpanMetricsConnector(obj *otelv1beta1.OpenTelemetryCollector, connector config.SpanMetricsConnectorConfig, exporterNames []string) {
+	if obj == nil {
+		return
+	}
+
+	// See the link below for more details about the settings of the Span
+	// Metrics connector.
+	//
+	// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/connector/spanmetricsconnector
+	settings := map[string]any{}
+	if len(connector.Dimensions) > 0 {
+		dimensions := make([]any, 0, len(connector.Dimensions))
+		for _, dimension := range connector.Dimensions {
+			dimensions = append(dimensions, map[string]any{"name": dimension})
+		}
+		settings["dimensions"] = dimensions
+	}
+	if connector.Exemplars.IsEnabled() {
+		exemplars := map[string]any{configKeyEnabled: true}
+		if limit := connector.Exemplars.MaxPerDataPoint; limit != nil {
+			exemplars["max_per_data_point"] = *limit
+		}
+		settings["exemplars"] = exemplars
+	}
+
+	configureTracesConnector(obj, config.ConnectorNameSpanMetrics, config.PipelineNameSpanMetrics, settings, exporterNames)
+}
+
+// connectTracesConnectors adds the configured connectors, which derive metrics
+// from spans, as exporters to the traces pipelines of the given
+// [otelv1beta1.OpenTelemetryCollector]. The collector refuses to start with a
+// connector, which is not used as an exporter, hence an error is returned, if
+// there is no traces pipeline.
+func connectTracesConnectors(obj *otelv1beta1.OpenTelemetryCollector) error {
+	if obj == nil || obj.Spec.Config.Connectors == nil {
+		return nil
+	}
+
+	connectors := slices.DeleteFunc(slices.Clone(tracesConnectorNames), func(name string) bool {
+		_, ok := obj.Spec.Config.Connectors.Object[name]
+
+		return !ok
+	})
+	if len(connectors) == 0 {
+		return nil
+	}
+
+	var connected bool
+	for _, name := range slices.Sorted(maps.Keys(obj.Spec.Config.Service.Pipelines)) {
+		signal, _, _ := strings.Cut(name, "/")
+		if signal != "traces" {
+			continue
+		}
+
+		pipeline := obj.Spec.Config.Service.Pipelines[name]
+		for _, connector := range connectors {
+			if !slices.Contains(pipeline.Exporters, connector) {
+				pipeline.Exporters = append(pipeline.Exporters, connector)
+			}
+		}
+		connected = true
+	}
+
+	if !connected {
+		return fmt.Errorf("%s connectors require a traces pipeline of the referenced collector configuration", strings.Join(connectors, ", "))
+	}
+
+	return nil
+}
+
+// getExemplarStrippingProcessors returns the processors, which strip the
+// exemplars of the metrics derived by the Span Metrics connector, by the name
+// of their pipeline. The pipelines are the ones, which receive the metrics from
+// the connector, either directly or via forward connectors, e.g. pipelines of
+// the referenced collector configuration.
+func getExemplarStrippingProcessors(obj *otelv1beta1.OpenTelemetryCollector) map[string][]string {
+	pipelines := obj.Spec.Config.Service.Pipelines
+
+	// The receivers of the pipelines on the path of the exemplars
+	receivers := sets.New(config.ConnectorNameSpanMetrics)
+	path := sets.New[string]()
+	for {
+		size := path.Len()
+		for name, pipeline := range pipelines {
+			if path.Has(name) || !slices.ContainsFunc(pipeline.Receivers, receivers.Has) {
+				continue
+			}
+			path.Insert(name)
+			for _, exporter := range pipeline.Exporters {
+				exporterType, _, _ := strings.Cut(exporter, "/")
+				if exporterType == "forward" {
+					receivers.Insert(exporter)
+				}
+			}
+		}
+		if path.Len() == size {
+			break
+		}
+	}
+
+	result := make(map[string][]string)
+	for _, name := range sets.List(path) {
+		for _, processor := range pipelines[name].Processors {
+			processorType, _, _ := strings.Cut(processor, "/")
+			if slices.Contains(exemplarStrippingProcessorTypes, processorType) {
+				result[name] = append(result[name], processor)
+			}
+		}
+	}
+
+	return result
+}
+
+// getScrapeLimits returns the Prometheus settings for the given limits of the
+// scraped samples and labels, omitting the ones, which are not limited.
+func getScrapeLimits(limits config.ScrapeLimitsConfig) map[string]any {
+	items := map[string]any{
+		"sample_limit":             limits.SampleLimit,
+		"label_limit":              limits.LabelLimit,
+		"label_name_length_limit":  limits.LabelNameLengthLimit,
+		"label_value_length_limit": limits.LabelValueLengthLimit,
+	}
+	maps.DeleteFunc(items, func(_ string, v any) bool {
+		return v == 0
+	})
+
+	return items
+}
+
+// configureScrapeConfigs configures the given additional scrape jobs for the
+// Prometheus receiver, along with the volumes for their credentials.
+func (a *Actuator) configureScrapeConfigs(
+	obj *otelv1beta1.OpenTelemetryCollector,
+	scrapeConfigs []config.ScrapeConfig,
+	resources []gardencorev1beta1.NamedResourceReference,
+) {
+	const (
+		baseVolumeNameScrapeAuth      = "scrape-auth"
+		baseVolumeMountPathScrapeAuth = "/etc/auth/scrape"
+	)
+
+	if obj == nil || len(scrapeConfigs) == 0 {
+		return
+	}
+
+	receiver, ok := obj.Spec.Config.Receivers.Object[configKeyPrometheus].(map[string]any)
+	if !ok {
+		return
+	}
+	promConfig, ok := receiver["config"].(map[string]any)
+	if !ok {
+		return
+	}
+	jobs, ok := promConfig["scrape_configs"].([]any)
+	if !ok {
+		return
+	}
+
+	for i, sc := range scrapeConfigs {
+		job := map[string]any{
+			"job_name": sc.JobName,
+			"static_configs": []any{
+				map[string]any{
+					"targets": sc.Targets,
+				},
+			},
+		}
+
+		if sc.MetricsPath != "" {
+			job["metrics_path"] = sc.MetricsPath
+		}
+
+		if sc.Scheme != "" {
+			job["scheme"] = sc.Scheme
+		}
+
+		if sc.ScrapeInterval > 0 {
+			job["scrape_interval"] = model.Duration(sc.ScrapeInterval).String()
+		}
+
+		if sc.EnableHTTP2 != nil {
+			job["enable_http2"] = *sc.EnableHTTP2
+		}
+
+		if sc.FollowRedirects != nil {
+			job["follow_redirects"] = *sc.FollowRedirects
+		}
+
+		if sc.ProxyURL != "" {
+			job["proxy_url"] = sc.ProxyURL
+		}
+
+		if sc.BodySizeLimit != "" {
+			job["body_size_limit"] = sc.BodySizeLimit
+		}
+
+		if sc.HonorTimestamps != nil {
+			job["honor_timestamps"] = *sc.HonorTimestamps
+		}
+
+		if sc.TrackTimestampsStaleness != nil {
+			job["track_timestamps_staleness"] = *sc.TrackTimestampsStaleness
+		}
+
+		maps.Copy(job, getScrapeLimits(sc.Limits))
+
+		ref := scrapeConfigCredentials(sc)
+		if ref != nil {
+			volumeName := fmt.Sprintf("%s-%d", baseVolumeNameScrapeAuth, i)
+			volumeMountPath := fmt.Sprintf("%s-%d", baseVolumeMountPathScrapeAuth, i)
+			credentialsFile := filepath.Join(volumeMountPath, ref.ResourceRef.DataKey)
+
+			switch {
+			case sc.Authorization != nil:
+				job["authorization"] = map[string]any{
+					"type":             sc.Authorization.Type,
+					"credentials_file": credentialsFile,
+				}
+			case sc.BasicAuth != nil:
+				job["basic_auth"] = map[string]any{
+					"username":      sc.BasicAuth.Username,
+					"password_file": credentialsFile,
+				}
+			}
+
+			obj.Spec.Volumes = append(
+				obj.Spec.Volumes,
+				corev1.Volume{
+					Name: volumeName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: secretNameForResource(ref.ResourceRef.Name, resources),
+						},
+					},
+				},
+			)
+
+			obj.Spec.VolumeMounts = append(
+				obj.Spec.VolumeMounts,
+				corev1.VolumeMount{
+					Name:      volumeName,
+					MountPath: volumeMountPath,
+					ReadOnly:  true,
+				},
+			)
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	promConfig["scrape_configs"] = jobs
 }
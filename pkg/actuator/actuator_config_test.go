@@ -0,0 +1,509 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	"go.yaml.in/yaml/v4"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("mergeReferencedConfig", func() {
+	var obj *otelv1beta1.OpenTelemetryCollector
+
+	BeforeEach(func() {
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Receivers: otelv1beta1.AnyConfig{Object: map[string]any{"otlp": map[string]any{}}},
+					Exporters: otelv1beta1.AnyConfig{Object: map[string]any{"debug": map[string]any{}}},
+					Service: otelv1beta1.Service{
+						Extensions: []string{"health_check"},
+						Pipelines: map[string]*otelv1beta1.Pipeline{
+							"logs": {Receivers: []string{"otlp"}, Exporters: []string{"debug"}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	parse := func(data string) *otelv1beta1.Config {
+		var cfg otelv1beta1.Config
+		Expect(yaml.Unmarshal([]byte(data), &cfg)).To(Succeed())
+
+		return &cfg
+	}
+
+	It("should merge the components and pipelines", func() {
+		referenced := parse(`
+processors:
+  filter/drop: {}
+exporters:
+  otlp/archive:
+    endpoint: archive.example.org:4317
+extensions:
+  headers_setter: {}
+service:
+  extensions: [headers_setter]
+  pipelines:
+    logs/archive:
+      receivers: [otlp]
+      processors: [filter/drop]
+      exporters: [otlp/archive]
+`)
+
+		Expect(mergeReferencedConfig(obj, referenced)).To(Succeed())
+		Expect(obj.Spec.Config.Exporters.Object).To(HaveKey("otlp/archive"))
+		Expect(obj.Spec.Config.Processors.Object).To(HaveKey("filter/drop"))
+		Expect(obj.Spec.Config.Extensions.Object).To(HaveKey("headers_setter"))
+		Expect(obj.Spec.Config.Service.Extensions).To(Equal([]string{"health_check", "headers_setter"}))
+		Expect(obj.Spec.Config.Service.Pipelines).To(HaveKey("logs/archive"))
+		Expect(obj.Spec.Config.Service.Pipelines).To(HaveKey("logs"))
+	})
+
+	It("should fail to override a managed component", func() {
+		referenced := parse(`
+exporters:
+  debug:
+    verbosity: detailed
+`)
+
+		Expect(mergeReferencedConfig(obj, referenced)).To(MatchError(ContainSubstring(`exporter "debug" is managed by the extension`)))
+	})
+
+	It("should fail to override a managed pipeline", func() {
+		referenced := parse(`
+service:
+  pipelines:
+    logs:
+      receivers: [otlp]
+      exporters: [debug]
+`)
+
+		Expect(mergeReferencedConfig(obj, referenced)).To(MatchError(ContainSubstring(`pipeline "logs" is managed by the extension`)))
+	})
+
+	It("should fail to configure the service telemetry", func() {
+		referenced := parse(`
+service:
+  telemetry:
+    logs:
+      level: debug
+`)
+
+		Expect(mergeReferencedConfig(obj, referenced)).To(MatchError(ContainSubstring("service telemetry")))
+	})
+
+	It("should fail to enable a managed service extension again", func() {
+		referenced := parse(`
+service:
+  extensions: [health_check]
+`)
+
+		Expect(mergeReferencedConfig(obj, referenced)).To(MatchError(ContainSubstring(`service extension "health_check" is already enabled`)))
+		Expect(obj.Spec.Config.Service.Extensions).To(Equal([]string{"health_check"}))
+	})
+})
+
+var _ = Describe("getOtelCollectorHostMetrics", func() {
+	It("should run the hostmetrics receiver in daemonset mode", func() {
+		cfg := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Receivers: config.CollectorReceiversConfig{
+					HostMetricsReceiver: config.HostMetricsReceiverConfig{
+						Enabled:            new(true),
+						Scrapers:           []string{"cpu", "memory"},
+						CollectionInterval: time.Minute,
+					},
+				},
+			},
+		}
+		image := &imagevectorutils.Image{Name: "otel-collector", Repository: new("example.org/otelcol"), Tag: new("0.144.0")}
+
+		hostMetrics := (&Actuator{}).getOtelCollectorHostMetrics("shoot--local--local", cfg, image)
+		Expect(hostMetrics.Name).To(Equal("external-otelcol-hostmetrics"))
+		Expect(hostMetrics.Spec.Mode).To(Equal(otelv1beta1.ModeDaemonSet))
+		Expect(hostMetrics.Spec.Config.Receivers.Object).To(HaveKeyWithValue("hostmetrics", HaveKeyWithValue("scrapers", HaveLen(2))))
+		Expect(hostMetrics.Spec.VolumeMounts).To(ConsistOf(HaveField("ReadOnly", true)))
+	})
+})
+
+var _ = Describe("validateReferencedConfigPolicy", func() {
+	parse := func(data string) *otelv1beta1.Config {
+		var cfg otelv1beta1.Config
+		Expect(yaml.Unmarshal([]byte(data), &cfg)).To(Succeed())
+
+		return &cfg
+	}
+
+	It("should accept processors, exporters and connectors by default", func() {
+		referenced := parse(`
+processors:
+  filter/drop: {}
+exporters:
+  otlp/archive:
+    endpoint: archive.example.org:4317
+connectors:
+  forward/archive: {}
+`)
+
+		Expect((&Actuator{}).validateReferencedConfigPolicy(referenced)).To(Succeed())
+	})
+
+	It("should reject receivers and extensions by default", func() {
+		Expect((&Actuator{}).validateReferencedConfigPolicy(parse(`
+receivers:
+  otlp/tenant: {}
+`))).To(MatchError(ErrReferencedComponentNotAllowed))
+
+		Expect((&Actuator{}).validateReferencedConfigPolicy(parse(`
+extensions:
+  headers_setter: {}
+`))).To(MatchError(ErrReferencedComponentNotAllowed))
+	})
+
+	It("should accept receivers and extensions when allowed", func() {
+		act := &Actuator{allowReferencedReceivers: true, allowReferencedExtensions: true}
+
+		Expect(act.validateReferencedConfigPolicy(parse(`
+receivers:
+  otlp/tenant: {}
+extensions:
+  headers_setter: {}
+`))).To(Succeed())
+	})
+
+	It("should reject unsupported component types even when allowed", func() {
+		act := &Actuator{allowReferencedReceivers: true, allowReferencedExtensions: true}
+
+		for _, data := range []string{
+			"receivers:\n  filelog: {}\n",
+			"receivers:\n  hostmetrics/nodes: {}\n",
+			"processors:\n  k8sattributes: {}\n",
+			"exporters:\n  file/local: {}\n",
+			"extensions:\n  pprof: {}\n",
+			"extensions:\n  zpages: {}\n",
+		} {
+			Expect(act.validateReferencedConfigPolicy(parse(data))).To(MatchError(ContainSubstring("type is not supported")), data)
+		}
+	})
+})
+
+var _ = Describe("configureTailSampling", func() {
+	var obj *otelv1beta1.OpenTelemetryCollector
+
+	BeforeEach(func() {
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Processors: &otelv1beta1.AnyConfig{Object: map[string]any{
+						"tail_sampling": map[string]any{"decision_wait": "30s"},
+						"tail_sampling/errors": map[string]any{
+							"num_traces": 20000,
+							"policies":   []any{map[string]any{"name": "errors", "type": "status_code"}},
+						},
+						"batch": map[string]any{},
+					}},
+				},
+			},
+		}
+	})
+
+	It("should configure all tail sampling processors", func() {
+		configureTailSampling(obj, config.TailSamplingProcessorConfig{DecisionWait: 10 * time.Second, NumTraces: 100000})
+
+		Expect(obj.Spec.Config.Processors.Object).To(HaveKeyWithValue("tail_sampling", map[string]any{
+			"decision_wait": "10s",
+			"num_traces":    100000,
+		}))
+		Expect(obj.Spec.Config.Processors.Object).To(HaveKeyWithValue("tail_sampling/errors", map[string]any{
+			"decision_wait": "10s",
+			"num_traces":    100000,
+			"policies":      []any{map[string]any{"name": "errors", "type": "status_code"}},
+		}))
+		Expect(obj.Spec.Config.Processors.Object).To(HaveKeyWithValue("batch", map[string]any{}))
+	})
+
+	It("should retain the settings, which are not specified", func() {
+		configureTailSampling(obj, config.TailSamplingProcessorConfig{ExpectedNewTracesPerSec: 500})
+
+		Expect(obj.Spec.Config.Processors.Object).To(HaveKeyWithValue("tail_sampling", map[string]any{
+			"decision_wait":               "30s",
+			"expected_new_traces_per_sec": 500,
+		}))
+		Expect(obj.Spec.Config.Processors.Object["tail_sampling/errors"]).To(HaveKeyWithValue("num_traces", 20000))
+	})
+})
+
+var _ = Describe("connectTracesConnectors", func() {
+	var (
+		act *Actuator
+		obj *otelv1beta1.OpenTelemetryCollector
+	)
+
+	BeforeEach(func() {
+		act = &Actuator{}
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Service: otelv1beta1.Service{
+						Pipelines: map[string]*otelv1beta1.Pipeline{
+							"logs":          {Receivers: []string{"otlp"}, Exporters: []string{"debug"}},
+							"traces":        {Receivers: []string{"otlp/traces"}, Exporters: []string{"otlp/tempo"}},
+							"traces/sample": {Receivers: []string{"otlp/traces"}, Exporters: []string{"otlp/tempo"}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should connect the traces pipelines to the service graph pipeline", func() {
+		act.configureServiceGraphConnector(obj, config.ServiceGraphConnectorConfig{
+			Enabled:                 new(true),
+			LatencyHistogramBuckets: []time.Duration{100 * time.Millisecond, time.Second},
+			Dimensions:              []string{"k8s.namespace.name"},
+		}, []string{"debug"})
+		Expect(connectTracesConnectors(obj)).To(Succeed())
+
+		Expect(obj.Spec.Config.Connectors.Object).To(HaveKeyWithValue("servicegraph", map[string]any{
+			"latency_histogram_buckets": []string{"100ms", "1s"},
+			"dimensions":                []string{"k8s.namespace.name"},
+		}))
+		Expect(obj.Spec.Config.Service.Pipelines).To(HaveKeyWithValue("metrics/servicegraph", HaveField("Receivers", ConsistOf("servicegraph"))))
+		Expect(obj.Spec.Config.Service.Pipelines["metrics/servicegraph"].Exporters).To(ConsistOf("debug"))
+		Expect(obj.Spec.Config.Service.Pipelines["traces"].Exporters).To(ConsistOf("otlp/tempo", "servicegraph"))
+		Expect(obj.Spec.Config.Service.Pipelines["traces/sample"].Exporters).To(ConsistOf("otlp/tempo", "servicegraph"))
+		Expect(obj.Spec.Config.Service.Pipelines["logs"].Exporters).To(ConsistOf("debug"))
+	})
+
+	It("should fail without a traces pipeline", func() {
+		delete(obj.Spec.Config.Service.Pipelines, "traces")
+		delete(obj.Spec.Config.Service.Pipelines, "traces/sample")
+		act.configureServiceGraphConnector(obj, config.ServiceGraphConnectorConfig{Enabled: new(true)}, []string{"debug"})
+		Expect(connectTracesConnectors(obj)).To(MatchError(ContainSubstring("servicegraph connectors require a traces pipeline")))
+	})
+
+	It("should connect the span metrics connector with exemplars", func() {
+		act.configureServiceGraphConnector(obj, config.ServiceGraphConnectorConfig{Enabled: new(true)}, []string{"debug"})
+		act.configureSpanMetricsConnector(obj, config.SpanMetricsConnectorConfig{
+			Enabled:    new(true),
+			Dimensions: []string{"http.method"},
+			Exemplars:  config.SpanMetricsExemplarsConfig{Enabled: new(true), MaxPerDataPoint: new(int32(5))},
+		}, []string{"debug"})
+		Expect(connectTracesConnectors(obj)).To(Succeed())
+
+		Expect(obj.Spec.Config.Connectors.Object).To(HaveKeyWithValue("spanmetrics", map[string]any{
+			"dimensions": []any{map[string]any{"name": "http.method"}},
+			"exemplars":  map[string]any{"enabled": true, "max_per_data_point": int32(5)},
+		}))
+		Expect(obj.Spec.Config.Service.Pipelines).To(HaveKeyWithValue("metrics/spanmetrics", HaveField("Receivers", ConsistOf("spanmetrics"))))
+		Expect(obj.Spec.Config.Service.Pipelines["traces"].Exporters).To(ConsistOf("otlp/tempo", "servicegraph", "spanmetrics"))
+		Expect(getExemplarStrippingProcessors(obj)).To(BeEmpty())
+	})
+
+	It("should report the processors, which strip the exemplars", func() {
+		act.configureSpanMetricsConnector(obj, config.SpanMetricsConnectorConfig{Enabled: new(true)}, []string{"debug"})
+		obj.Spec.Config.Service.Pipelines["metrics/red"] = &otelv1beta1.Pipeline{
+			Receivers:  []string{"spanmetrics"},
+			Processors: []string{"metricstransform/aggregate", "batch"},
+			Exporters:  []string{"forward/red"},
+		}
+		obj.Spec.Config.Service.Pipelines["metrics/archive"] = &otelv1beta1.Pipeline{
+			Receivers:  []string{"forward/red"},
+			Processors: []string{"metricstransform"},
+			Exporters:  []string{"debug"},
+		}
+		obj.Spec.Config.Service.Pipelines["metrics/other"] = &otelv1beta1.Pipeline{
+			Receivers:  []string{"otlp"},
+			Processors: []string{"metricstransform"},
+			Exporters:  []string{"debug"},
+		}
+
+		Expect(getExemplarStrippingProcessors(obj)).To(Equal(map[string][]string{
+			"metrics/red":     {"metricstransform/aggregate"},
+			"metrics/archive": {"metricstransform"},
+		}))
+	})
+
+	It("should not change the pipelines without the connector", func() {
+		Expect(connectTracesConnectors(obj)).To(Succeed())
+		Expect(obj.Spec.Config.Service.Pipelines["traces"].Exporters).To(ConsistOf("otlp/tempo"))
+	})
+})
+
+var _ = Describe("waitUntilSeedManagedResourceHealthy", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.Background()
+		mr  *resourcesv1alpha1.ManagedResource
+	)
+
+	newActuator := func(timeout time.Duration) *Actuator {
+		s := runtime.NewScheme()
+		Expect(resourcesv1alpha1.AddToScheme(s)).To(Succeed())
+
+		act, err := New(
+			fake.NewClientBuilder().WithScheme(s).WithObjects(mr).WithStatusSubresource(mr).Build(),
+			WithManagedResourceHealthTimeout(timeout),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		return act
+	}
+
+	BeforeEach(func() {
+		mr = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: managedResourceName, Namespace: namespace, Generation: 1},
+			Status: resourcesv1alpha1.ManagedResourceStatus{
+				ObservedGeneration: 1,
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+					{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+	})
+
+	It("should succeed with a healthy managed resource", func() {
+		act := newActuator(time.Second)
+		Expect(act.waitUntilSeedManagedResourceHealthy(ctx, namespace)).To(Succeed())
+	})
+
+	It("should requeue with an unhealthy managed resource", func() {
+		mr.Status.Conditions[1].Status = gardencorev1beta1.ConditionFalse
+		act := newActuator(100 * time.Millisecond)
+
+		err := act.waitUntilSeedManagedResourceHealthy(ctx, namespace)
+		var requeueErr *reconcilerutils.RequeueAfterError
+		Expect(errors.As(err, &requeueErr)).To(BeTrue())
+		Expect(requeueErr.RequeueAfter).To(Equal(managedResourceHealthRequeueInterval))
+		Expect(requeueErr.Cause).To(MatchError(ContainSubstring("managed resource external-otelcol is not healthy after 100ms")))
+	})
+
+	It("should not wait without a timeout", func() {
+		mr.Status.Conditions = nil
+		act := newActuator(0)
+		Expect(act.waitUntilSeedManagedResourceHealthy(ctx, namespace)).To(Succeed())
+	})
+})
+
+var _ = Describe("validateHtpasswd", func() {
+	DescribeTable("should validate the entries of the htpasswd file",
+		func(data string, matcher types.GomegaMatcher) {
+			Expect(validateHtpasswd([]byte(data))).To(matcher)
+		},
+		Entry("single entry", "foo:$apr1$salt$hash\n", Succeed()),
+		Entry("comments and blank lines", "# clients\n\nfoo:$apr1$salt$hash\r\nbar:{SHA}hash\n", Succeed()),
+		Entry("empty file", "", MatchError("no credentials specified")),
+		Entry("comments only", "# no clients\n", MatchError("no credentials specified")),
+		Entry("missing hash", "foo:$apr1$salt$hash\nbar\n", MatchError("line 2 is not of the form user:hash")),
+		Entry("empty user", ":$apr1$salt$hash\n", MatchError("line 1 is not of the form user:hash")),
+		Entry("duplicate user", "foo:$apr1$salt$hash\nfoo:{SHA}hash\n", MatchError("line 2 specifies a duplicate user")),
+	)
+})
+
+var _ = Describe("validateSecretReferences", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx       = context.Background()
+		cfg       config.CollectorConfig
+		resources = []gardencorev1beta1.NamedResourceReference{{
+			Name: "htpasswd",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "htpasswd",
+			},
+		}}
+	)
+
+	BeforeEach(func() {
+		cfg = config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Receivers: config.CollectorReceiversConfig{
+					OTLPReceiver: config.OTLPReceiverConfig{
+						Auth: config.OTLPReceiverAuthConfig{
+							Htpasswd: &config.ResourceReference{
+								ResourceRef: config.ResourceReferenceDetails{Name: "htpasswd", DataKey: "users"},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	newActuator := func(data string) *Actuator {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ref-htpasswd", Namespace: namespace},
+			Data:       map[string][]byte{"users": []byte(data)},
+		}
+
+		act, err := New(fake.NewClientBuilder().WithObjects(secret).Build())
+		Expect(err).NotTo(HaveOccurred())
+
+		return act
+	}
+
+	It("should succeed with valid credentials", func() {
+		act := newActuator("foo:$apr1$salt$hash\n")
+		Expect(act.validateSecretReferences(ctx, namespace, cfg, resources)).To(Succeed())
+	})
+
+	It("should fail with an empty htpasswd file", func() {
+		act := newActuator("")
+		err := act.validateSecretReferences(ctx, namespace, cfg, resources)
+		Expect(err).To(MatchError(ErrInvalidHtpasswd))
+		Expect(err).To(MatchError(ContainSubstring(`spec.receivers.otlp.auth.htpasswd references data key "users" of secret resource htpasswd: no credentials specified`)))
+	})
+})
+
+var _ = Describe("setOwnerReferences", func() {
+	const namespace = "shoot--foo--bar"
+
+	ex := &extensionsv1alpha1.Extension{
+		ObjectMeta: metav1.ObjectMeta{Name: "otelcol", Namespace: namespace, UID: "1234"},
+	}
+
+	It("should set the owner reference on the objects in the namespace of the extension", func() {
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace}}
+		clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+
+		setOwnerReferences(ex, []client.Object{configMap, clusterRole})
+		setOwnerReferences(ex, []client.Object{configMap, clusterRole})
+
+		Expect(configMap.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+			APIVersion: "extensions.gardener.cloud/v1alpha1",
+			Kind:       "Extension",
+			Name:       "otelcol",
+			UID:        "1234",
+		}))
+		Expect(clusterRole.OwnerReferences).To(BeEmpty())
+	})
+})
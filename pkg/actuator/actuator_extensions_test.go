@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sortServiceExtensions", func() {
+	DescribeTable("should order the service extensions deterministically",
+		func(names []string, want []string) {
+			Expect(sortServiceExtensions(names)).To(Equal(want))
+		},
+		Entry("no extensions",
+			nil,
+			nil,
+		),
+		Entry("health_check comes first",
+			[]string{"bearertokenauth/exporter-otlp-http", "health_check"},
+			[]string{"health_check", "bearertokenauth/exporter-otlp-http"},
+		),
+		Entry("extensions of the same type are ordered by name",
+			[]string{"bearertokenauth/exporter-otlp-http", "health_check", "bearertokenauth/exporter-otlp-grpc"},
+			[]string{"health_check", "bearertokenauth/exporter-otlp-grpc", "bearertokenauth/exporter-otlp-http"},
+		),
+		Entry("storage comes before authentication",
+			[]string{"basicauth/server", "bearertokenauth/exporter-otlp-http", "file_storage", "pprof", "zpages", "health_check"},
+			[]string{"health_check", "pprof", "zpages", "file_storage", "bearertokenauth/exporter-otlp-http", "basicauth/server"},
+		),
+		Entry("unknown extensions come last",
+			[]string{"oauth2client", "health_check", "basicauth"},
+			[]string{"health_check", "basicauth", "oauth2client"},
+		),
+		Entry("duplicates are removed",
+			[]string{"health_check", "health_check"},
+			[]string{"health_check"},
+		),
+	)
+})
@@ -6,22 +6,36 @@ package actuator_test
 
 import (
 	"encoding/json"
+	"errors"
+	"time"
 
 	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	gardenerfeatures "github.com/gardener/gardener/pkg/features"
+	otelv1alpha1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1alpha1"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/actuator"
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	"github.com/gardener/gardener-extension-otelcol/pkg/metrics"
 )
 
 const localName = "local"
@@ -102,6 +116,7 @@ var _ = Describe("Actuator", Ordered, func() {
 			actuator.WithGardenerVersion("1.0.0"),
 			actuator.WithDecoder(decoder),
 			actuator.WithGardenletFeatures(featureGates),
+			actuator.WithAllowAnonymousOTLPReceiver(true),
 		}
 
 		// Serialize our test objects, so we can later re-use them.
@@ -151,10 +166,15 @@ var _ = Describe("Actuator", Ordered, func() {
 		}
 
 		Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+		Expect(k8sClient.Create(ctx, extResource)).To(Succeed())
 	})
 
 	AfterEach(func() {
 		Expect(k8sClient.Delete(ctx, cluster)).To(Succeed())
+		// Some tests modify the extension in memory, hence it is deleted by its original key.
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: shootNamespace.Name},
+		}))).To(Succeed())
 	})
 
 	It("should successfully create an actuator", func() {
@@ -165,7 +185,55 @@ var _ = Describe("Actuator", Ordered, func() {
 		Expect(act.Name()).To(Equal(actuator.Name))
 		Expect(act.ExtensionType()).To(Equal(actuator.ExtensionType))
 		Expect(act.FinalizerSuffix()).To(Equal(actuator.FinalizerSuffix))
-		Expect(act.ExtensionClass()).To(Equal(extensionsv1alpha1.ExtensionClassShoot))
+		Expect(act.ExtensionClasses()).To(ConsistOf(extensionsv1alpha1.ExtensionClassShoot))
+	})
+
+	It("should fail to create an actuator with an empty managed resource class", func() {
+		opts := append(actuatorOpts, actuator.WithManagedResourceClass(""))
+		act, err := actuator.New(k8sClient, opts...)
+
+		Expect(err).To(MatchError(ContainSubstring("empty managed resource class specified")))
+		Expect(act).To(BeNil())
+	})
+
+	It("should fail to create an actuator with a negative managed resource health timeout", func() {
+		opts := append(actuatorOpts, actuator.WithManagedResourceHealthTimeout(-time.Second))
+		act, err := actuator.New(k8sClient, opts...)
+
+		Expect(err).To(MatchError(ContainSubstring("invalid managed resource health timeout")))
+		Expect(act).To(BeNil())
+	})
+
+	It("should fail to create an actuator with an empty image pull secret name", func() {
+		opts := append(actuatorOpts, actuator.WithImagePullSecret(client.ObjectKey{Namespace: "garden"}))
+		act, err := actuator.New(k8sClient, opts...)
+
+		Expect(err).To(MatchError(actuator.ErrInvalidActuator))
+		Expect(act).To(BeNil())
+	})
+
+	It("should fail to create an actuator with an invalid default exporter endpoint", func() {
+		opts := append(actuatorOpts, actuator.WithDefaultExporter("otlp.example.org"))
+		act, err := actuator.New(k8sClient, opts...)
+
+		Expect(err).To(MatchError(actuator.ErrInvalidActuator))
+		Expect(act).To(BeNil())
+	})
+
+	It("should fail to create an actuator with an unsupported extension class", func() {
+		opts := append(actuatorOpts, actuator.WithExtensionClasses("garden"))
+		act, err := actuator.New(k8sClient, opts...)
+
+		Expect(err).To(MatchError(actuator.ErrInvalidActuator))
+		Expect(act).To(BeNil())
+	})
+
+	It("should successfully create an actuator for shoot and seed extension classes", func() {
+		opts := append(actuatorOpts, actuator.WithExtensionClasses(extensionsv1alpha1.ExtensionClassShoot, extensionsv1alpha1.ExtensionClassSeed))
+		act, err := actuator.New(k8sClient, opts...)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.ExtensionClasses()).To(ConsistOf(extensionsv1alpha1.ExtensionClassShoot, extensionsv1alpha1.ExtensionClassSeed))
 	})
 
 	It("should fail to reconcile when no cluster exists", func() {
@@ -191,6 +259,25 @@ var _ = Describe("Actuator", Ordered, func() {
 		Expect(err).To(MatchError(ContainSubstring("no provider config specified")))
 	})
 
+	It("should fail to reconcile with unknown fields in the provider config", func() {
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: []byte(`{
+				"apiVersion": "otelcol.extensions.gardener.cloud/v1alpha1",
+				"kind": "CollectorConfig",
+				"spec": {"exporter": {"debug": {"enabled": true}}}
+			}`),
+		}
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+
+		err = act.Reconcile(ctx, logger, extResource)
+		Expect(err).Should(HaveOccurred())
+		Expect(err).To(MatchError(ContainSubstring("contains unknown fields")))
+		Expect(err).To(MatchError(ContainSubstring(`unknown field "spec.exporter"`)))
+	})
+
 	It("should fail to reconcile with no exporters configured", func() {
 		emptyProviderConfig := config.CollectorConfig{
 			Spec: config.CollectorConfigSpec{
@@ -213,39 +300,86 @@ var _ = Describe("Actuator", Ordered, func() {
 		Expect(err).To(MatchError(ContainSubstring("no exporter enabled")))
 	})
 
-	It("should succeed on Reconcile", func() {
-		// Ensure we have valid provider config
+	It("should fail to reconcile with insecureSkipVerify when not allowed", func() {
+		insecureProviderConfig := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					OTLPHTTPExporter: config.OTLPHTTPExporterConfig{
+						Enabled:  new(true),
+						Endpoint: "https://example.org:4318",
+						TLS: &config.TLSConfig{
+							InsecureSkipVerify: new(true),
+						},
+					},
+				},
+			},
+		}
+
+		data, err := json.Marshal(insecureProviderConfig)
+		Expect(err).NotTo(HaveOccurred())
 		extResource.Spec.ProviderConfig = &runtime.RawExtension{
-			Raw: providerConfigData,
+			Raw: data,
 		}
 
 		act, err := actuator.New(k8sClient, actuatorOpts...)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(act).NotTo(BeNil())
-		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
 
-		// TODO(user): Add more tests
+		err = act.Reconcile(ctx, logger, extResource)
+		Expect(err).Should(HaveOccurred())
+		Expect(err).To(MatchError(actuator.ErrInsecureSkipVerifyNotAllowed))
 	})
 
-	It("should succeed on Delete", func() {
-		act, err := actuator.New(k8sClient, actuatorOpts...)
+	It("should fail to reconcile with an anonymous OTLP receiver by default", func() {
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: providerConfigData,
+		}
+
+		// The options of the suite allow anonymous clients, hence the
+		// actuator is created with the default policy here.
+		act, err := actuator.New(
+			k8sClient,
+			actuator.WithGardenerVersion("1.0.0"),
+			actuator.WithDecoder(decoder),
+			actuator.WithGardenletFeatures(featureGates),
+		)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(act).NotTo(BeNil())
-		Expect(act.Delete(ctx, logger, extResource)).To(Succeed())
 
-		// TODO(user): Add more tests
+		err = act.Reconcile(ctx, logger, extResource)
+		Expect(err).Should(HaveOccurred())
+		Expect(err).To(MatchError(actuator.ErrAnonymousOTLPReceiverNotAllowed))
 	})
 
-	It("should succeed on ForceDelete", func() {
-		act, err := actuator.New(k8sClient, actuatorOpts...)
+	It("should fail to reconcile without a CA when an explicit CA is required", func() {
+		noCAProviderConfig := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: config.CollectorExportersConfig{
+					OTLPHTTPExporter: config.OTLPHTTPExporterConfig{
+						Enabled:  new(true),
+						Endpoint: "https://example.org:4318",
+					},
+				},
+			},
+		}
+
+		data, err := json.Marshal(noCAProviderConfig)
+		Expect(err).NotTo(HaveOccurred())
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: data,
+		}
+
+		opts := append(actuatorOpts, actuator.WithRequireExplicitCA(true))
+		act, err := actuator.New(k8sClient, opts...)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(act).NotTo(BeNil())
-		Expect(act.ForceDelete(ctx, logger, extResource)).To(Succeed())
 
-		// TODO(user): Add more tests
+		err = act.Reconcile(ctx, logger, extResource)
+		Expect(err).Should(HaveOccurred())
+		Expect(err).To(MatchError(actuator.ErrExplicitCARequired))
 	})
 
-	It("should succeed on Restore", func() {
+	It("should succeed on Reconcile", func() {
 		// Ensure we have valid provider config
 		extResource.Spec.ProviderConfig = &runtime.RawExtension{
 			Raw: providerConfigData,
@@ -254,22 +388,1660 @@ var _ = Describe("Actuator", Ordered, func() {
 		act, err := actuator.New(k8sClient, actuatorOpts...)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(act).NotTo(BeNil())
-		Expect(act.Restore(ctx, logger, extResource)).To(Succeed())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+
+		var exporters dto.Metric
+		Expect(metrics.ConfigComponents.WithLabelValues(shootNamespace.Name, "exporters").Write(&exporters)).To(Succeed())
+		Expect(exporters.GetGauge().GetValue()).To(Equal(1.0))
+
+		var caExpiry dto.Metric
+		Expect(metrics.CertificateExpirySeconds.WithLabelValues(shootNamespace.Name, "ca-otelcol").Write(&caExpiry)).To(Succeed())
+		Expect(caExpiry.GetGauge().GetValue()).To(BeNumerically(">", float64(time.Now().Unix())))
 
 		// TODO(user): Add more tests
 	})
 
-	It("should succeed on Migrate", func() {
-		// Ensure we have valid provider config
+	It("should annotate the extension with the configuration hash on Reconcile", func() {
 		extResource.Spec.ProviderConfig = &runtime.RawExtension{
 			Raw: providerConfigData,
 		}
 
 		act, err := actuator.New(k8sClient, actuatorOpts...)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(act).NotTo(BeNil())
-		Expect(act.Migrate(ctx, logger, extResource)).To(Succeed())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
 
-		// TODO(user): Add more tests
+		var ext extensionsv1alpha1.Extension
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		Expect(ext.Annotations).To(HaveKeyWithValue(actuator.AnnotationConfigHash, Not(BeEmpty())))
+		configHash := ext.Annotations[actuator.AnnotationConfigHash]
+
+		// An unchanged configuration keeps the hash of the previous reconciliation.
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		Expect(ext.Annotations).To(HaveKeyWithValue(actuator.AnnotationConfigHash, configHash))
+
+		// An unchanged configuration still resets the keep-objects setting
+		// of the shoot managed resource, e.g. after a migration.
+		shootMR := &resourcesv1alpha1.ManagedResource{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: shootNamespace.Name, Name: "external-otelcol-shoot"}, shootMR)).To(Succeed())
+		patch := client.MergeFrom(shootMR.DeepCopy())
+		shootMR.Spec.KeepObjects = ptr.To(true)
+		Expect(k8sClient.Patch(ctx, shootMR, patch)).To(Succeed())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(shootMR), shootMR)).To(Succeed())
+		Expect(shootMR.Spec.KeepObjects).To(Equal(ptr.To(false)))
+
+		// A changed configuration updates the hash.
+		opts := append(actuatorOpts, actuator.WithShootUIDLabel(true))
+		act, err = actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		Expect(ext.Annotations).To(HaveKeyWithValue(actuator.AnnotationConfigHash, Not(Equal(configHash))))
+		configHash = ext.Annotations[actuator.AnnotationConfigHash]
+
+		// A changed class of the seed managed resource updates the hash.
+		opts = append(opts, actuator.WithManagedResourceClass("otelcol"))
+		act, err = actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		Expect(ext.Annotations).To(HaveKeyWithValue(actuator.AnnotationConfigHash, Not(Equal(configHash))))
+	})
+
+	It("should annotate the extension with the configuration of the Target Allocator on Reconcile", func() {
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: providerConfigData,
+		}
+
+		opts := append(actuatorOpts, actuator.WithTargetAllocatorConfigAnnotation(true))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+
+		var ext extensionsv1alpha1.Extension
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		Expect(ext.Annotations).To(HaveKey(actuator.AnnotationTargetAllocatorConfig))
+
+		var summary map[string]any
+		Expect(json.Unmarshal([]byte(ext.Annotations[actuator.AnnotationTargetAllocatorConfig]), &summary)).To(Succeed())
+		Expect(summary).To(HaveKeyWithValue("allocation_strategy", "consistent-hashing"))
+
+		// The annotation is removed, once disabled.
+		act, err = actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		Expect(ext.Annotations).NotTo(HaveKey(actuator.AnnotationTargetAllocatorConfig))
+	})
+
+	It("should fail to reconcile when a referenced data key does not exist", func() {
+		shootWithResources := shoot.DeepCopy()
+		shootWithResources.Spec.Resources = []corev1beta1.NamedResourceReference{{
+			Name: "otlp-token",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "otlp-token",
+			},
+		}}
+		data, err := json.Marshal(shootWithResources)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Spec.Shoot.Raw = data
+		Expect(k8sClient.Update(ctx, cluster)).To(Succeed())
+
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: []byte(`{
+  "apiVersion": "otelcol.extensions.gardener.cloud/v1alpha1",
+  "kind": "CollectorConfig",
+  "spec": {
+    "exporters": {
+      "otlp_http": {
+        "enabled": true,
+        "endpoint": "https://otlp.example.org",
+        "token": {"resourceRef": {"name": "otlp-token", "dataKey": "token"}}
+      }
+    }
+  }
+}`),
+		}
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The referenced secret has not been copied by gardenlet yet.
+		var requeueErr *reconcilerutils.RequeueAfterError
+		Expect(errors.As(act.Reconcile(ctx, logger, extResource), &requeueErr)).To(BeTrue())
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ref-otlp-token", Namespace: shootNamespace.Name},
+			Data:       map[string][]byte{"bearer": []byte("foo")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, secret)).To(Succeed()) })
+
+		Expect(act.Reconcile(ctx, logger, extResource)).To(MatchError(actuator.ErrMissingDataKey))
+	})
+
+	It("should roll out the collector when a referenced secret is rotated", func() {
+		shootWithResources := shoot.DeepCopy()
+		shootWithResources.Spec.Resources = []corev1beta1.NamedResourceReference{{
+			Name: "otlp-token",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "otlp-token",
+			},
+		}}
+		data, err := json.Marshal(shootWithResources)
+		Expect(err).NotTo(HaveOccurred())
+		cluster.Spec.Shoot.Raw = data
+		Expect(k8sClient.Update(ctx, cluster)).To(Succeed())
+
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: []byte(`{
+  "apiVersion": "otelcol.extensions.gardener.cloud/v1alpha1",
+  "kind": "CollectorConfig",
+  "spec": {
+    "exporters": {
+      "otlp_http": {
+        "enabled": true,
+        "endpoint": "https://otlp.example.org",
+        "token": {"resourceRef": {"name": "otlp-token", "dataKey": "token"}}
+      }
+    }
+  }
+}`),
+		}
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ref-otlp-token", Namespace: shootNamespace.Name},
+			Data:       map[string][]byte{"token": []byte("foo")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		DeferCleanup(func() { Expect(k8sClient.Delete(ctx, secret)).To(Succeed()) })
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+
+		var ext extensionsv1alpha1.Extension
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		configHash := ext.Annotations[actuator.AnnotationConfigHash]
+		Expect(configHash).NotTo(BeEmpty())
+
+		// The rotated secret changes the checksum of the collector pods.
+		secret.Data["token"] = []byte("bar")
+		Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(extResource), &ext)).To(Succeed())
+		Expect(ext.Annotations).To(HaveKeyWithValue(actuator.AnnotationConfigHash, Not(Equal(configHash))))
+	})
+
+	It("should render the self-monitoring of the Target Allocator", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, providerConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			metricsService *corev1.Service
+			collector      *otelv1beta1.OpenTelemetryCollector
+		)
+		for _, obj := range seedObjects {
+			switch o := obj.(type) {
+			case *corev1.Service:
+				if o.Name == "external-otelcol-targetallocator-metrics" {
+					metricsService = o
+				}
+			case *otelv1beta1.OpenTelemetryCollector:
+				collector = o
+			}
+		}
+		Expect(metricsService).NotTo(BeNil())
+		Expect(metricsService.Spec.Ports).To(ConsistOf(HaveField("Port", int32(8080))))
+		Expect(collector).NotTo(BeNil())
+
+		receiver := collector.Spec.Config.Receivers.Object["prometheus"].(map[string]any)
+		jobs := receiver["config"].(map[string]any)["scrape_configs"].([]any)
+		Expect(jobs).To(ContainElement(HaveKeyWithValue("job_name", config.TargetAllocatorScrapeJobName)))
+	})
+
+	It("should render the upstream Target Allocator without mTLS", func() {
+		opts := append(actuatorOpts, actuator.WithTargetAllocatorMTLS(false))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, providerConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			targetAllocator *otelv1alpha1.TargetAllocator
+			collector       *otelv1beta1.OpenTelemetryCollector
+		)
+		for _, obj := range seedObjects {
+			switch o := obj.(type) {
+			case *appsv1.Deployment:
+				Expect(o.Name).NotTo(Equal("external-otelcol-targetallocator"))
+			case *otelv1alpha1.TargetAllocator:
+				targetAllocator = o
+			case *otelv1beta1.OpenTelemetryCollector:
+				if o.Name == "external-otelcol" {
+					collector = o
+				}
+			}
+		}
+		Expect(targetAllocator).NotTo(BeNil())
+		Expect(targetAllocator.Spec.PrometheusCR.Enabled).To(BeTrue())
+		Expect(targetAllocator.Spec.PrometheusCR.AllowNamespaces).To(ConsistOf(shootNamespace.Name))
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Labels).To(HaveKeyWithValue("opentelemetry.io/target-allocator", targetAllocator.Name))
+		Expect(collector.Spec.Volumes).NotTo(ContainElement(HaveField("Name", "ca-cert")))
+		Expect(collector.Spec.Volumes).NotTo(ContainElement(HaveField("Name", "client-cert")))
+
+		receiver := collector.Spec.Config.Receivers.Object["prometheus"].(map[string]any)
+		Expect(receiver["target_allocator"]).To(HaveKeyWithValue("endpoint", "http://external-otelcol-targetallocator"))
+		Expect(receiver["target_allocator"]).NotTo(HaveKey("tls"))
+	})
+
+	It("should render the timestamp handling of the scrape configs", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+			{JobName: "foo", Targets: []string{"foo.example.org:9100"}, HonorTimestamps: new(true), TrackTimestampsStaleness: new(true)},
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+
+		receiver := collector.Spec.Config.Receivers.Object["prometheus"].(map[string]any)
+		jobs := receiver["config"].(map[string]any)["scrape_configs"].([]any)
+		Expect(jobs).To(ContainElement(And(
+			HaveKeyWithValue("job_name", "foo"),
+			HaveKeyWithValue("honor_timestamps", true),
+			HaveKeyWithValue("track_timestamps_staleness", true),
+		)))
+	})
+
+	It("should render the additional ports of the collector", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Ports = []config.PortConfig{{Name: "statsd", Port: 8125, Protocol: corev1.ProtocolUDP}}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Ports).To(ConsistOf(HaveField("ServicePort", And(
+			HaveField("Name", "statsd"),
+			HaveField("Port", int32(8125)),
+			HaveField("Protocol", corev1.ProtocolUDP),
+		))))
+		Expect(collector.Annotations).To(HaveKeyWithValue(
+			"networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports",
+			ContainSubstring(`{"protocol":"UDP","port":8125}`),
+		))
+	})
+
+	It("should render the statsd receiver", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Receivers.StatsDReceiver = config.StatsDReceiverConfig{
+			Enabled:             new(true),
+			Endpoint:            "0.0.0.0:8125",
+			AggregationInterval: 30 * time.Second,
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Receivers.Object).To(HaveKeyWithValue("statsd", And(
+			HaveKeyWithValue("endpoint", "0.0.0.0:8125"),
+			HaveKeyWithValue("transport", "udp"),
+			HaveKeyWithValue("aggregation_interval", "30s"),
+		)))
+		Expect(collector.Spec.Config.Service.Pipelines["metrics"].Receivers).To(ContainElement("statsd"))
+		Expect(collector.Spec.Ports).To(ContainElement(HaveField("ServicePort.Protocol", corev1.ProtocolUDP)))
+	})
+
+	It("should fail to render the hostmetrics receiver for shoot-class extensions", func() {
+		opts := append(actuatorOpts, actuator.WithAllowHostMetricsReceiver(true))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Receivers.HostMetricsReceiver = config.HostMetricsReceiverConfig{
+			Enabled:            new(true),
+			Scrapers:           []string{"cpu"},
+			CollectionInterval: time.Minute,
+		}
+
+		_, _, err = act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).To(MatchError(actuator.ErrHostMetricsReceiverNotAllowed))
+		Expect(err).To(MatchError(ContainSubstring("shoot-class extensions do not support spec.receivers.hostmetrics")))
+	})
+
+	It("should render the service discovery role of the Target Allocator", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.TargetAllocator.ServiceDiscoveryRole = config.ServiceDiscoveryRoleEndpointSlice
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var taConfigMap *corev1.ConfigMap
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*corev1.ConfigMap); ok && o.Name == "external-otelcol-targetallocator-config" {
+				taConfigMap = o
+			}
+		}
+		Expect(taConfigMap).NotTo(BeNil())
+		Expect(taConfigMap.Data["targetallocator.yaml"]).To(ContainSubstring("service_discovery_role: EndpointSlice"))
+	})
+
+	It("should render the pod annotations of the collector and Target Allocator", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.PodAnnotations = map[string]string{"sidecar.istio.io/inject": "false"}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			collector    *otelv1beta1.OpenTelemetryCollector
+			taDeployment *appsv1.Deployment
+		)
+		for _, obj := range seedObjects {
+			switch o := obj.(type) {
+			case *otelv1beta1.OpenTelemetryCollector:
+				if o.Name == "external-otelcol" {
+					collector = o
+				}
+			case *appsv1.Deployment:
+				if o.Name == "external-otelcol-targetallocator" {
+					taDeployment = o
+				}
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.PodAnnotations).To(HaveKeyWithValue("sidecar.istio.io/inject", "false"))
+		Expect(taDeployment).NotTo(BeNil())
+		Expect(taDeployment.Spec.Template.Annotations).To(HaveKeyWithValue("sidecar.istio.io/inject", "false"))
+	})
+
+	It("should render the revision history limit of the Target Allocator", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.TargetAllocator.RevisionHistoryLimit = new(int32(5))
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var taDeployment *appsv1.Deployment
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*appsv1.Deployment); ok && o.Name == "external-otelcol-targetallocator" {
+				taDeployment = o
+			}
+		}
+		Expect(taDeployment).NotTo(BeNil())
+		Expect(taDeployment.Spec.RevisionHistoryLimit).To(HaveValue(Equal(int32(5))))
+	})
+
+	It("should render the log level of the Target Allocator", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Logs.Level = config.LogLevelInfo
+		cfg.Spec.TargetAllocator.LogLevel = config.LogLevelDebug
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var taDeployment *appsv1.Deployment
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*appsv1.Deployment); ok && o.Name == "external-otelcol-targetallocator" {
+				taDeployment = o
+			}
+		}
+		Expect(taDeployment).NotTo(BeNil())
+		Expect(taDeployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--zap-log-level=debug"))
+
+		// The upstream Target Allocator is configured via its args.
+		opts := append(actuatorOpts, actuator.WithTargetAllocatorMTLS(false))
+		act, err = actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		seedObjects, _, err = act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var targetAllocator *otelv1alpha1.TargetAllocator
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1alpha1.TargetAllocator); ok {
+				targetAllocator = o
+			}
+		}
+		Expect(targetAllocator).NotTo(BeNil())
+		Expect(targetAllocator.Spec.Args).To(HaveKeyWithValue("zap-log-level", "debug"))
+	})
+
+	It("should render the metric name prefix", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.MetricNamePrefix = "myteam_"
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKey("transform/metric_name_prefix"))
+		processors := collector.Spec.Config.Service.Pipelines["metrics"].Processors
+		Expect(processors[len(processors)-2:]).To(Equal([]string{"transform/metric_name_prefix", "batch"}))
+	})
+
+	It("should render the metricstransform processor", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.MetricNamePrefix = "myteam_"
+		cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+			{
+				Include: "foo",
+				Action:  "update",
+				NewName: "bar",
+				Operations: []config.MetricsTransformOperationConfig{
+					{Action: "aggregate_labels", LabelSet: []string{"env"}, AggregationType: "sum"},
+				},
+			},
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKeyWithValue("metricstransform", map[string]any{
+			"transforms": []any{
+				map[string]any{
+					"include":    "foo",
+					"match_type": "strict",
+					"action":     "update",
+					"new_name":   "bar",
+					"operations": []any{
+						map[string]any{
+							"action":           "aggregate_labels",
+							"label_set":        []string{"env"},
+							"aggregation_type": "sum",
+						},
+					},
+				},
+			},
+		}))
+		processors := collector.Spec.Config.Service.Pipelines["metrics"].Processors
+		Expect(processors[len(processors)-3:]).To(Equal([]string{"metricstransform", "transform/metric_name_prefix", "batch"}))
+	})
+
+	It("should render unlimited retries of an exporter", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://example.org:4318",
+			RetryOnFailure: config.RetryOnFailureConfig{
+				Enabled:        new(true),
+				MaxElapsedTime: new(time.Duration(0)),
+			},
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKey("otlp_http"))
+		exporter, ok := collector.Spec.Config.Exporters.Object["otlp_http"].(map[string]any)
+		Expect(ok).To(BeTrue())
+		Expect(exporter["retry_on_failure"]).To(HaveKeyWithValue("max_elapsed_time", "0s"))
+	})
+
+	It("should expand the templates of the exporter endpoints", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://example.org:4318/{{.ShootName}}",
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Spec.Exporters.OTLPHTTPExporter.Endpoint).To(Equal("https://example.org:4318/{{.ShootName}}"))
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue("otlp_http", HaveKeyWithValue("endpoint", "https://example.org:4318/local")))
+	})
+
+	It("should fail to expand the templates of the exporter endpoints with unknown cluster variables", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://metrics.{{.Region}}.example.org:4318",
+		}
+
+		_, _, err = act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).To(MatchError(ContainSubstring(`cluster variable "Region" is not set`)))
+	})
+
+	It("should render the NetworkPolicies of the allowed OTLP receiver clients", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Receivers.OTLPReceiver.AllowedClients = []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"app": "foo"}},
+			{MatchLabels: map[string]string{"app": "bar"}},
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			collector       *otelv1beta1.OpenTelemetryCollector
+			networkPolicies []*networkingv1.NetworkPolicy
+		)
+		for _, obj := range seedObjects {
+			switch o := obj.(type) {
+			case *otelv1beta1.OpenTelemetryCollector:
+				if o.Name == "external-otelcol" {
+					collector = o
+				}
+			case *networkingv1.NetworkPolicy:
+				networkPolicies = append(networkPolicies, o)
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Annotations).To(HaveKeyWithValue(
+			"networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports",
+			`[{"protocol":"TCP","port":8888}]`,
+		))
+
+		Expect(networkPolicies).To(HaveLen(3))
+		Expect(networkPolicies[0].Name).To(Equal("external-otelcol-otlp-receiver"))
+		Expect(networkPolicies[0].Spec.PodSelector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/instance", shootNamespace.Name+".external-otelcol"))
+		Expect(networkPolicies[0].Spec.Ingress).To(HaveLen(1))
+		Expect(networkPolicies[0].Spec.Ingress[0].From).To(HaveLen(2))
+		Expect(networkPolicies[1].Name).To(Equal("external-otelcol-otlp-receiver-client-0"))
+		Expect(networkPolicies[1].Spec.PodSelector.MatchLabels).To(Equal(map[string]string{"app": "foo"}))
+		Expect(networkPolicies[2].Name).To(Equal("external-otelcol-otlp-receiver-client-1"))
+		Expect(networkPolicies[2].Spec.PodSelector.MatchLabels).To(Equal(map[string]string{"app": "bar"}))
+	})
+
+	It("should expose the OTLP receiver of the gateway only", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Gateway = config.GatewayConfig{Enabled: new(true), Replicas: 2}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		collectors := make(map[string]*otelv1beta1.OpenTelemetryCollector)
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok {
+				collectors[o.Name] = o
+			}
+		}
+		Expect(collectors).To(HaveKey("external-otelcol"))
+		Expect(collectors).To(HaveKey("external-otelcol-gateway"))
+
+		// The collector is reachable from the gateway only, which
+		// authenticates the clients.
+		Expect(collectors["external-otelcol"].Annotations).To(HaveKeyWithValue(
+			"networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports",
+			`[{"protocol":"TCP","port":8888}]`,
+		))
+		Expect(collectors["external-otelcol-gateway"].Annotations).To(HaveKeyWithValue(
+			"networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports",
+			`[{"protocol":"TCP","port":8888},{"protocol":"TCP","port":4317}]`,
+		))
+		Expect(collectors["external-otelcol-gateway"].Labels).To(HaveKeyWithValue(
+			"networking.resources.gardener.cloud/to-external-otelcol-collector-tcp-4317", "allowed",
+		))
+	})
+
+	It("should render the cookies of the OTLP HTTP exporter", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://example.org:4318",
+			Cookies:  config.CookiesConfig{Enabled: new(true)},
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		exporter, ok := collector.Spec.Config.Exporters.Object["otlp_http"].(map[string]any)
+		Expect(ok).To(BeTrue())
+		Expect(exporter).To(HaveKeyWithValue("cookies", map[string]any{"enabled": true}))
+	})
+
+	It("should render the file exporter as a fallback", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.FileExporter = config.FileExporterConfig{
+			Enabled:  new(true),
+			Path:     "/var/lib/otelcol/file-exporter/data.json",
+			Format:   config.FileExporterFormatJSON,
+			Rotation: config.FileExporterRotationConfig{MaxMegabytes: 100},
+			Fallback: new(true),
+		}
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKey("file"))
+		Expect(collector.Spec.Config.Connectors.Object).To(HaveKeyWithValue("failover/logs_events", map[string]any{
+			"priority_levels": []any{
+				[]string{"logs/events_primary"},
+				[]string{"logs/events_fallback"},
+			},
+		}))
+
+		pipelines := collector.Spec.Config.Service.Pipelines
+		Expect(pipelines["logs/events"].Exporters).To(Equal([]string{"failover/logs_events"}))
+		Expect(pipelines["logs/events_primary"].Exporters).To(Equal([]string{"debug"}))
+		Expect(pipelines["logs/events_fallback"].Exporters).To(Equal([]string{"file"}))
+
+		Expect(collector.Spec.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "file-exporter",
+			MountPath: "/var/lib/otelcol/file-exporter",
+		}))
+	})
+
+	It("should render the debug exporter writing to a file", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.DebugExporter.Path = "/var/lib/otelcol/debug/data.json"
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).NotTo(HaveKey("debug"))
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue("file/debug", map[string]any{
+			"path":   "/var/lib/otelcol/debug/data.json",
+			"format": "json",
+			"rotation": map[string]any{
+				"max_megabytes": 100,
+				"max_days":      0,
+				"max_backups":   1,
+				"localtime":     false,
+			},
+		}))
+		Expect(collector.Spec.Config.Service.Pipelines["logs/events"].Exporters).To(Equal([]string{"file/debug"}))
+
+		Expect(collector.Spec.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "debug-exporter",
+			MountPath: "/var/lib/otelcol/debug",
+		}))
+		Expect(collector.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name:         "debug-exporter",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}))
+	})
+
+	It("should render the default exporter", func() {
+		opts := append(
+			actuatorOpts,
+			actuator.WithDefaultExporter("https://otlp.example.org"),
+			actuator.WithDefaultExporterSecret(client.ObjectKey{Namespace: "garden", Name: "default-exporter"}),
+		)
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, providerConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKey("otlp_http/default"))
+		Expect(collector.Spec.Config.Service.Extensions).To(ContainElement("bearertokenauth/exporter-default"))
+		for _, name := range []string{config.PipelineNameLogs, config.PipelineNameEvents, config.PipelineNameMetrics} {
+			Expect(collector.Spec.Config.Service.Pipelines[name].Exporters).To(ConsistOf(config.ExporterNameDebug, "otlp_http/default"))
+		}
+	})
+
+	It("should skip the default exporter with the same endpoint as the OTLP HTTP exporter", func() {
+		opts := append(actuatorOpts, actuator.WithDefaultExporter("https://otlp.example.org"))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://otlp.example.org/",
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKey("otlp_http"))
+		Expect(collector.Spec.Config.Exporters.Object).NotTo(HaveKey("otlp_http/default"))
+	})
+
+	It("should raise the batch timeout to the shortest scrape interval", func() {
+		opts := append(actuatorOpts, actuator.WithBatchTimeoutAutoTuning(true))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+			{JobName: "foo", Targets: []string{"foo.example.org:9100"}, ScrapeInterval: 10 * time.Second},
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKeyWithValue("batch", HaveKeyWithValue("timeout", "10s")))
+	})
+
+	It("should render the OTLP push reader of the internal metrics", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://otlp.example.org",
+		}
+		cfg.Spec.Metrics.Push = config.MetricsPushConfig{
+			Enabled:  new(true),
+			Interval: 30 * time.Second,
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		telemetryMetrics := collector.Spec.Config.Service.Telemetry.Object["metrics"].(map[string]any)
+		Expect(telemetryMetrics["readers"]).To(ContainElement(HaveKeyWithValue("periodic", And(
+			HaveKeyWithValue("interval", int64(30000)),
+			HaveKeyWithValue("exporter", HaveKeyWithValue("otlp", And(
+				HaveKeyWithValue("protocol", "http/protobuf"),
+				HaveKeyWithValue("endpoint", "https://otlp.example.org/v1/metrics"),
+			))),
+		))))
+	})
+
+	It("should render the credentials of the OTLP HTTP exporter for the OTLP push reader", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://otlp.example.org",
+			Token: &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otlp-token", DataKey: "token"},
+			},
+			TLS: &config.TLSConfig{
+				CA: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "otlp-tls", DataKey: "ca.crt"},
+				},
+				Cert: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "otlp-tls", DataKey: "tls.crt"},
+				},
+				Key: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "otlp-tls", DataKey: "tls.key"},
+				},
+			},
+		}
+		cfg.Spec.Metrics.Push = config.MetricsPushConfig{
+			Enabled: new(true),
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		telemetryMetrics := collector.Spec.Config.Service.Telemetry.Object["metrics"].(map[string]any)
+		Expect(telemetryMetrics["readers"]).To(ContainElement(HaveKeyWithValue("periodic", HaveKeyWithValue("exporter", HaveKeyWithValue("otlp", And(
+			HaveKeyWithValue("certificate", HaveSuffix("/ca.crt")),
+			HaveKeyWithValue("client_certificate", HaveSuffix("/tls.crt")),
+			HaveKeyWithValue("client_key", HaveSuffix("/tls.key")),
+			HaveKeyWithValue("headers", ConsistOf(And(
+				HaveKeyWithValue("name", "Authorization"),
+				HaveKeyWithValue("value", MatchRegexp(`^Bearer \$\{file:/.+/token\}$`)),
+			))),
+		))))))
+	})
+
+	It("should render the naming of the internal metrics", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Metrics.Naming = config.MetricsNamingOTel
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		telemetryMetrics := collector.Spec.Config.Service.Telemetry.Object["metrics"].(map[string]any)
+		Expect(telemetryMetrics["readers"]).To(ContainElement(HaveKeyWithValue("pull", HaveKeyWithValue("exporter", HaveKeyWithValue("prometheus", And(
+			HaveKeyWithValue("without_units", true),
+			HaveKeyWithValue("without_type_suffix", true),
+		))))))
+	})
+
+	It("should render the Google Cloud exporter", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.GoogleCloudExporter = config.GoogleCloudExporterConfig{
+			Enabled:        new(true),
+			Project:        "my-project",
+			ServiceAccount: "otelcol@my-project.iam.gserviceaccount.com",
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			collector      *otelv1beta1.OpenTelemetryCollector
+			serviceAccount *corev1.ServiceAccount
+		)
+		for _, obj := range seedObjects {
+			switch o := obj.(type) {
+			case *otelv1beta1.OpenTelemetryCollector:
+				if o.Name == "external-otelcol" {
+					collector = o
+				}
+			case *corev1.ServiceAccount:
+				if o.Name == "external-otelcol-collector" {
+					serviceAccount = o
+				}
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue("googlecloud", HaveKeyWithValue("project", "my-project")))
+		Expect(collector.Spec.Config.Service.Pipelines["metrics"].Exporters).To(ContainElement("googlecloud"))
+		Expect(serviceAccount).NotTo(BeNil())
+		Expect(serviceAccount.Annotations).To(HaveKeyWithValue("iam.gke.io/gcp-service-account", "otelcol@my-project.iam.gserviceaccount.com"))
+	})
+
+	It("should render the annotations of the collector service account", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.GoogleCloudExporter = config.GoogleCloudExporterConfig{
+			Enabled:        new(true),
+			Project:        "my-project",
+			ServiceAccount: "otelcol@my-project.iam.gserviceaccount.com",
+		}
+		cfg.Spec.ServiceAccountAnnotations = map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/otelcol"}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var serviceAccount *corev1.ServiceAccount
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*corev1.ServiceAccount); ok && o.Name == "external-otelcol-collector" {
+				serviceAccount = o
+			}
+		}
+		Expect(serviceAccount).NotTo(BeNil())
+		Expect(serviceAccount.Annotations).To(Equal(map[string]string{
+			"iam.gke.io/gcp-service-account": "otelcol@my-project.iam.gserviceaccount.com",
+			"eks.amazonaws.com/role-arn":     "arn:aws:iam::111122223333:role/otelcol",
+		}))
+	})
+
+	It("should render the TLS versions and cipher suites of the exporters", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://example.org:4318",
+			TLS: &config.TLSConfig{
+				MinVersion:   config.TLSVersion12,
+				MaxVersion:   config.TLSVersion13,
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			},
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue(config.ExporterNameOTLPHTTP, HaveKeyWithValue("tls", And(
+			HaveKeyWithValue("min_version", "1.2"),
+			HaveKeyWithValue("max_version", "1.3"),
+			HaveKeyWithValue("cipher_suites", ConsistOf("TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384")),
+		))))
+	})
+
+	It("should render the system root CAs in addition to the CA of the exporters", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://example.org:4318",
+			TLS: &config.TLSConfig{
+				CA: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "private-ca", DataKey: "ca.crt"},
+				},
+				IncludeSystemCAs: new(true),
+			},
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue(config.ExporterNameOTLPHTTP, HaveKeyWithValue("tls", And(
+			HaveKeyWithValue("ca_file", HaveSuffix("/ca.crt")),
+			HaveKeyWithValue("include_system_ca_certs_pool", true),
+		))))
+	})
+
+	It("should render the normal level of the internal metrics without a level", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Metrics.Level = ""
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Service.Telemetry.Object).To(HaveKeyWithValue("metrics", HaveKeyWithValue("level", "normal")))
+	})
+
+	It("should remove the batch processor from the pipelines, when it is disabled", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Processors.Batch.Enabled = new(false)
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Processors.Object).NotTo(HaveKey("batch"))
+		Expect(collector.Spec.Config.Service.Pipelines).NotTo(BeEmpty())
+		for _, pipeline := range collector.Spec.Config.Service.Pipelines {
+			Expect(pipeline.Processors).NotTo(ContainElement("batch"))
+		}
+	})
+
+	It("should render the maximum request size of the exporters and of the batches", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Processors.Batch.SendBatchMaxSize = 500
+		cfg.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Batch = config.SendingQueueBatchConfig{
+			Sizer:   config.RequestSizerItems,
+			MaxSize: 500,
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKeyWithValue("batch", HaveKeyWithValue("send_batch_max_size", BeEquivalentTo(500))))
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue(config.ExporterNameOTLPHTTP, HaveKeyWithValue("sending_queue", HaveKeyWithValue("batch", And(
+			HaveKeyWithValue("sizer", "items"),
+			HaveKeyWithValue("min_size", BeEquivalentTo(0)),
+			HaveKeyWithValue("max_size", BeEquivalentTo(500)),
+		)))))
+	})
+
+	It("should render the start time metric of the Prometheus receiver", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Receivers.PrometheusReceiver.UseStartTimeMetric = new(true)
+		cfg.Spec.Receivers.PrometheusReceiver.StartTimeMetricRegex = "^(.+_)*process_start_time_seconds$"
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Receivers.Object).To(HaveKeyWithValue("prometheus", And(
+			HaveKeyWithValue("use_start_time_metric", true),
+			HaveKeyWithValue("start_time_metric_regex", "^(.+_)*process_start_time_seconds$"),
+		)))
+	})
+
+	It("should render the named processors of the forward pipelines", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+			{
+				Name:  "large",
+				Batch: &config.NamedBatchProcessorConfig{Timeout: 10 * time.Second, SendBatchSize: 1000, SendBatchMaxSize: 2000},
+			},
+			{
+				Name:          "strict",
+				MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{LimitPercentage: 50},
+			},
+		}
+		cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{{
+			Name:       "logs/archive",
+			From:       []string{config.PipelineNameLogs},
+			Processors: []string{"memory_limiter/strict", "batch/large"},
+		}}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKeyWithValue("batch/large", And(
+			HaveKeyWithValue("timeout", "10s"),
+			HaveKeyWithValue("send_batch_size", BeEquivalentTo(1000)),
+			HaveKeyWithValue("send_batch_max_size", BeEquivalentTo(2000)),
+		)))
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKeyWithValue("memory_limiter/strict", And(
+			HaveKeyWithValue("limit_mib", BeEquivalentTo(0)),
+			HaveKeyWithValue("limit_percentage", BeEquivalentTo(50)),
+			HaveKeyWithValue("spike_limit_percentage", BeEquivalentTo(10)),
+		)))
+		Expect(collector.Spec.Config.Service.Pipelines).To(HaveKeyWithValue("logs/archive", HaveField("Processors", Equal([]string{"memory_limiter/strict", "batch/large"}))))
+	})
+
+	It("should render the named exporters", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Exporters.Named = []config.NamedExporterConfig{
+			{
+				Name: "backup",
+				OTLPHTTPExporter: &config.OTLPHTTPExporterConfig{
+					Enabled:  new(true),
+					Endpoint: "https://backup.example.org:4318",
+					Token: &config.ResourceReference{
+						ResourceRef: config.ResourceReferenceDetails{Name: "backup-token", DataKey: "token"},
+					},
+				},
+			},
+			{
+				Name:          "verbose",
+				DebugExporter: &config.DebugExporterConfig{Enabled: new(true), Verbosity: config.DebugExporterVerbosityDetailed},
+			},
+			{
+				Name:             "disabled",
+				OTLPGRPCExporter: &config.OTLPGRPCExporterConfig{Enabled: new(false), Endpoint: "grpc.example.org:4317"},
+			},
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue("otlp_http/backup", And(
+			HaveKeyWithValue("endpoint", "https://backup.example.org:4318"),
+			HaveKeyWithValue("auth", map[string]any{"authenticator": "bearertokenauth/exporter-otlp-http-backup"}),
+		)))
+		Expect(collector.Spec.Config.Exporters.Object).To(HaveKeyWithValue("debug/verbose", HaveKeyWithValue("verbosity", config.DebugExporterVerbosityDetailed)))
+		Expect(collector.Spec.Config.Exporters.Object).NotTo(HaveKey("otlp_grpc/disabled"))
+		Expect(collector.Spec.Config.Extensions.Object).To(HaveKeyWithValue("bearertokenauth/exporter-otlp-http-backup", HaveKeyWithValue("filename", "/etc/auth/bearer-exporter-otlp-http-backup/token")))
+		Expect(collector.Spec.VolumeMounts).To(ContainElement(HaveField("Name", "bearer-token-auth-exporter-otlp-http-backup")))
+		Expect(collector.Spec.Config.Service.Pipelines[config.PipelineNameLogs].Exporters).To(ContainElements("debug/verbose", "otlp_http/backup"))
+	})
+
+	It("should render the update strategy of the collector in deployment mode", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Mode = config.CollectorModeDeployment
+		cfg.Spec.UpdateStrategy = config.UpdateStrategyConfig{
+			MaxUnavailable: new(intstr.FromInt32(0)),
+			MaxSurge:       new(intstr.FromInt32(1)),
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.DeploymentUpdateStrategy).To(Equal(appsv1.DeploymentStrategy{
+			Type: appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxUnavailable: new(intstr.FromInt32(0)),
+				MaxSurge:       new(intstr.FromInt32(1)),
+			},
+		}))
+	})
+
+	It("should derive the termination grace period of the collector from the shutdown timeout", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		getCollector := func(objects []client.Object) *otelv1beta1.OpenTelemetryCollector {
+			for _, obj := range objects {
+				if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+					return o
+				}
+			}
+			return nil
+		}
+		collector := getCollector(seedObjects)
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.TerminationGracePeriodSeconds).To(Equal(new(int64(60))))
+
+		cfg.Spec.ShutdownTimeout = 2 * time.Minute
+		seedObjects, _, err = act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		collector = getCollector(seedObjects)
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.TerminationGracePeriodSeconds).To(Equal(new(int64(125))))
+
+		// A short timeout does not lower the default grace period.
+		cfg.Spec.ShutdownTimeout = 10 * time.Second
+		seedObjects, _, err = act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		collector = getCollector(seedObjects)
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.TerminationGracePeriodSeconds).To(Equal(new(int64(60))))
+	})
+
+	It("should render the pod security context of the collector", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.SecurityContext = config.SecurityContextConfig{RunAsUser: 65532, RunAsGroup: 65533}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.PodSecurityContext).To(Equal(&corev1.PodSecurityContext{
+			RunAsNonRoot:   new(true),
+			RunAsUser:      new(int64(65532)),
+			RunAsGroup:     new(int64(65533)),
+			FSGroup:        new(int64(65533)),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		}))
+	})
+
+	It("should render the additional volumes of the collector", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Volumes = []config.VolumeConfig{
+			{Name: "storage", MountPath: "/var/lib/otelcol", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, *cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name:         "extra-storage",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}))
+		Expect(collector.Spec.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "extra-storage",
+			MountPath: "/var/lib/otelcol",
+		}))
+	})
+
+	It("should render the error mode of the transform processors", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := *providerConfig.DeepCopy()
+		cfg.Spec.Processors.ErrorMode = config.ErrorModeIgnore
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKeyWithValue("transform/events", HaveKeyWithValue("error_mode", "ignore")))
+		Expect(collector.Spec.Config.Processors.Object).To(HaveKeyWithValue("batch", Not(HaveKey("error_mode"))))
+	})
+
+	It("should render the resource attributes of the internal telemetry", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := *providerConfig.DeepCopy()
+		cfg.Spec.Metrics.ResourceAttributes = new(true)
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Service.Telemetry.Object).To(HaveKeyWithValue("resource", map[string]any{
+			"k8s.cluster.name":      "shoot--local--local",
+			"gardener.project.name": "local",
+			"gardener.shoot.name":   "local",
+		}))
+	})
+
+	It("should render the views of the disabled internal metrics", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := *providerConfig.DeepCopy()
+		cfg.Spec.Metrics.DisabledMetrics = []string{"otelcol_processor_batch_batch_send_size"}
+		seedObjects, _, err := act.RenderResources(shootNamespace.Name, cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var collector *otelv1beta1.OpenTelemetryCollector
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == "external-otelcol" {
+				collector = o
+			}
+		}
+		Expect(collector).NotTo(BeNil())
+		Expect(collector.Spec.Config.Service.Telemetry.Object).To(HaveKeyWithValue("metrics", HaveKeyWithValue("views", []any{
+			map[string]any{
+				"selector": map[string]any{"instrument_name": "otelcol_processor_batch_batch_send_size"},
+				"stream":   map[string]any{"aggregation": map[string]any{"drop": map[string]any{}}},
+			},
+		})))
+	})
+
+	It("should succeed on Reconcile in deployment mode", func() {
+		deploymentProviderConfig := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Mode:      config.CollectorModeDeployment,
+				Exporters: providerConfig.Spec.Exporters,
+			},
+		}
+
+		data, err := json.Marshal(deploymentProviderConfig)
+		Expect(err).NotTo(HaveOccurred())
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: data,
+		}
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+
+		var receivers dto.Metric
+		Expect(metrics.ConfigComponents.WithLabelValues(shootNamespace.Name, "receivers").Write(&receivers)).To(Succeed())
+		Expect(receivers.GetGauge().GetValue()).To(Equal(2.0))
+	})
+
+	It("should succeed on Reconcile with the OTLP gateway", func() {
+		gatewayProviderConfig := config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Exporters: providerConfig.Spec.Exporters,
+				Gateway: config.GatewayConfig{
+					Enabled:  new(true),
+					Replicas: 2,
+				},
+			},
+		}
+
+		data, err := json.Marshal(gatewayProviderConfig)
+		Expect(err).NotTo(HaveOccurred())
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: data,
+		}
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+	})
+
+	It("should succeed on Reconcile with the shoot UID label", func() {
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: providerConfigData,
+		}
+
+		opts := append(actuatorOpts, actuator.WithShootUIDLabel(true))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+	})
+
+	It("should succeed on Reconcile of a seed-class extension", func() {
+		seedExtResource := &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example-seed",
+				Namespace: projectNamespace.Name,
+			},
+			Spec: extensionsv1alpha1.ExtensionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:  actuator.ExtensionType,
+					Class: ptr.To(extensionsv1alpha1.ExtensionClassSeed),
+					ProviderConfig: &runtime.RawExtension{
+						Raw: providerConfigData,
+					},
+				},
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, seedExtResource)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, seedExtResource)).To(Succeed())
+		})
+
+		opts := append(actuatorOpts, actuator.WithExtensionClasses(extensionsv1alpha1.ExtensionClassSeed))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.Reconcile(ctx, logger, seedExtResource)).To(Succeed())
+		Expect(act.Delete(ctx, logger, seedExtResource)).To(Succeed())
+
+		// Seed-class extensions cannot reference the credentials of the
+		// clients, hence they require anonymous clients to be allowed.
+		opts = append(opts, actuator.WithAllowAnonymousOTLPReceiver(false))
+		act, err = actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		err = act.Reconcile(ctx, logger, seedExtResource)
+		Expect(err).To(MatchError(actuator.ErrAnonymousOTLPReceiverNotAllowed))
+		Expect(err).To(MatchError(ContainSubstring("seed-class extensions do not support resource references")))
+	})
+
+	It("should reconcile the hostmetrics receiver of a seed-class extension, if allowed", func() {
+		cfg := providerConfig.DeepCopy()
+		cfg.Spec.Receivers.HostMetricsReceiver = config.HostMetricsReceiverConfig{
+			Enabled:            new(true),
+			Scrapers:           []string{"cpu", "memory"},
+			CollectionInterval: time.Minute,
+		}
+		data, err := json.Marshal(cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		seedExtResource := &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example-seed-hostmetrics",
+				Namespace: projectNamespace.Name,
+			},
+			Spec: extensionsv1alpha1.ExtensionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:  actuator.ExtensionType,
+					Class: ptr.To(extensionsv1alpha1.ExtensionClassSeed),
+					ProviderConfig: &runtime.RawExtension{
+						Raw: data,
+					},
+				},
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, seedExtResource)).To(Succeed())
+		DeferCleanup(func() {
+			Expect(k8sClient.Delete(ctx, seedExtResource)).To(Succeed())
+		})
+
+		opts := append(actuatorOpts, actuator.WithExtensionClasses(extensionsv1alpha1.ExtensionClassSeed))
+		act, err := actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, seedExtResource)).To(MatchError(actuator.ErrHostMetricsReceiverNotAllowed))
+
+		opts = append(opts, actuator.WithAllowHostMetricsReceiver(true))
+		act, err = actuator.New(k8sClient, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act.Reconcile(ctx, logger, seedExtResource)).To(Succeed())
+		Expect(act.Delete(ctx, logger, seedExtResource)).To(Succeed())
+	})
+
+	It("should succeed on Delete", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.Delete(ctx, logger, extResource)).To(Succeed())
+
+		// TODO(user): Add more tests
+	})
+
+	It("should succeed on ForceDelete", func() {
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.ForceDelete(ctx, logger, extResource)).To(Succeed())
+
+		// TODO(user): Add more tests
+	})
+
+	It("should succeed on Restore", func() {
+		// Ensure we have valid provider config
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: providerConfigData,
+		}
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.Restore(ctx, logger, extResource)).To(Succeed())
+
+		// TODO(user): Add more tests
+	})
+
+	It("should succeed on Migrate", func() {
+		// Ensure we have valid provider config
+		extResource.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: providerConfigData,
+		}
+
+		act, err := actuator.New(k8sClient, actuatorOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(act).NotTo(BeNil())
+		Expect(act.Reconcile(ctx, logger, extResource)).To(Succeed())
+		Expect(act.Migrate(ctx, logger, extResource)).To(Succeed())
+
+		// The seed objects are not kept, since their secrets are
+		// deleted.
+		var mr resourcesv1alpha1.ManagedResource
+		err = k8sClient.Get(ctx, client.ObjectKey{Namespace: shootNamespace.Name, Name: "external-otelcol"}, &mr)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
 	})
 })
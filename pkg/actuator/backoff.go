@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"sync"
+	"time"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/metrics"
+)
+
+const (
+	// DefaultManagedResourceFailureThreshold is the default number of
+	// consecutive failures of the managed resource operations for a
+	// cluster, after which the extension is reported as degraded.
+	DefaultManagedResourceFailureThreshold = 5
+
+	// managedResourceBackoffInitialDelay is the requeue delay after the
+	// first failure of the managed resource operations for a cluster.
+	managedResourceBackoffInitialDelay = 5 * time.Second
+
+	// managedResourceBackoffMaxDelay is the max requeue delay after
+	// consecutive failures of the managed resource operations for a
+	// cluster.
+	managedResourceBackoffMaxDelay = 5 * time.Minute
+
+	// managedResourceBackoffJitter is the jitter factor applied to the
+	// requeue delay.
+	managedResourceBackoffJitter = 0.2
+)
+
+// managedResourceBackoff tracks the consecutive failures of the managed
+// resource operations per cluster, and requeues the failed operations with an
+// exponential backoff with jitter, so that a persistently broken cluster does
+// not result in a hot-loop.
+type managedResourceBackoff struct {
+	mu       sync.Mutex
+	failures map[string]int
+
+	// threshold is the number of consecutive failures, after which the
+	// returned errors are marked with an error code, which reports the
+	// extension as degraded.
+	threshold int
+}
+
+// newManagedResourceBackoff creates a new [managedResourceBackoff] with the
+// given failure threshold.
+func newManagedResourceBackoff(threshold int) *managedResourceBackoff {
+	return &managedResourceBackoff{
+		failures:  make(map[string]int),
+		threshold: threshold,
+	}
+}
+
+// do invokes the given managed resource operation for the given cluster. On
+// success the consecutive failures of the cluster are reset. On failure a
+// [reconcilerutils.RequeueAfterError] is returned, which requeues the
+// extension after a backoff delay derived from the consecutive failures.
+func (b *managedResourceBackoff) do(cluster string, fn func() error) error {
+	err := fn()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil {
+		delete(b.failures, cluster)
+		metrics.ManagedResourceConsecutiveFailures.DeleteLabelValues(cluster)
+
+		return nil
+	}
+
+	b.failures[cluster]++
+	failures := b.failures[cluster]
+	metrics.ManagedResourceConsecutiveFailures.WithLabelValues(cluster).Set(float64(failures))
+
+	if failures >= b.threshold {
+		err = v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorRetryableInfraDependencies)
+	}
+
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        err,
+		RequeueAfter: wait.Jitter(backoffDelay(failures), managedResourceBackoffJitter),
+	}
+}
+
+// backoffDelay returns the exponential backoff delay (without jitter) after
+// the given number of consecutive failures.
+func backoffDelay(failures int) time.Duration {
+	delay := managedResourceBackoffInitialDelay
+	for i := 1; i < failures && delay < managedResourceBackoffMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, managedResourceBackoffMaxDelay)
+}
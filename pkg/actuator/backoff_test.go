@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"errors"
+	"time"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("managedResourceBackoff", func() {
+	const cluster = "shoot--local--local"
+
+	var errFailed = errors.New("failed")
+
+	DescribeTable("backoffDelay",
+		func(failures int, want time.Duration) {
+			Expect(backoffDelay(failures)).To(Equal(want))
+		},
+		Entry("first failure", 1, 5*time.Second),
+		Entry("second failure", 2, 10*time.Second),
+		Entry("fourth failure", 4, 40*time.Second),
+		Entry("capped", 20, 5*time.Minute),
+	)
+
+	It("should requeue with backoff and report degraded after the threshold", func() {
+		b := newManagedResourceBackoff(2)
+
+		err := b.do(cluster, func() error { return errFailed })
+		var requeueErr *reconcilerutils.RequeueAfterError
+		Expect(errors.As(err, &requeueErr)).To(BeTrue())
+		Expect(requeueErr.RequeueAfter).To(BeNumerically(">=", 5*time.Second))
+		Expect(v1beta1helper.ExtractErrorCodes(requeueErr.Cause)).To(BeEmpty())
+
+		err = b.do(cluster, func() error { return errFailed })
+		Expect(errors.As(err, &requeueErr)).To(BeTrue())
+		Expect(requeueErr.RequeueAfter).To(BeNumerically(">=", 10*time.Second))
+		Expect(v1beta1helper.ExtractErrorCodes(requeueErr.Cause)).To(ConsistOf(gardencorev1beta1.ErrorRetryableInfraDependencies))
+	})
+
+	It("should reset the consecutive failures on success", func() {
+		b := newManagedResourceBackoff(2)
+
+		Expect(b.do(cluster, func() error { return errFailed })).To(HaveOccurred())
+		Expect(b.do(cluster, func() error { return nil })).To(Succeed())
+		Expect(b.failures).NotTo(HaveKey(cluster))
+	})
+})
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/batchprocessor"
+	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	configinstall "github.com/gardener/gardener-extension-otelcol/pkg/apis/config/install"
+)
+
+// The collector cannot be run in envtest, so the rendered settings of the
+// components are validated and run in-process with the factories of the
+// collector instead, which catches settings refused by the collector.
+var _ = Describe("Collector configuration", func() {
+	var (
+		ctx = context.Background()
+
+		// sendingQueue specifies additional settings of the sending
+		// queue of the OTLP HTTP exporter.
+		sendingQueue string
+
+		// The fake OTLP backend records the number of data points of
+		// each received request.
+		backend    *httptest.Server
+		mu         sync.Mutex
+		dataPoints []int
+	)
+
+	BeforeEach(func() {
+		dataPoints = nil
+		backend = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.URL.Path).To(Equal("/v1/metrics"))
+			Expect(r.Header.Get("Content-Encoding")).To(Equal("gzip"))
+			reader, err := gzip.NewReader(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			body, err := io.ReadAll(reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			req := pmetricotlp.NewExportRequest()
+			Expect(req.UnmarshalProto(body)).To(Succeed())
+			mu.Lock()
+			dataPoints = append(dataPoints, req.Metrics().DataPointCount())
+			mu.Unlock()
+
+			resp, err := pmetricotlp.NewExportResponse().MarshalProto()
+			Expect(err).NotTo(HaveOccurred())
+			w.Header().Set("Content-Type", "application/x-protobuf")
+			_, _ = w.Write(resp)
+		}))
+		DeferCleanup(backend.Close)
+
+		sendingQueue = ""
+	})
+
+	received := func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]int{}, dataPoints...)
+	}
+
+	// render renders the collector for a provider config, which is decoded
+	// and defaulted like the ones of the extension resources.
+	render := func() *otelv1beta1.OpenTelemetryCollector {
+		scheme := runtime.NewScheme()
+		configinstall.Install(scheme)
+		decoder := serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder()
+
+		data := fmt.Sprintf(`
+apiVersion: otelcol.extensions.gardener.cloud/v1alpha1
+kind: CollectorConfig
+spec:
+  exporters:
+    otlp_http:
+      enabled: true
+      endpoint: %s
+      sending_queue:
+        num_consumers: 1
+%s
+`, backend.URL, sendingQueue)
+
+		var cfg config.CollectorConfig
+		Expect(runtime.DecodeInto(decoder, []byte(data), &cfg)).To(Succeed())
+
+		act, err := New(fake.NewClientBuilder().Build(), WithDecoder(decoder), WithAllowAnonymousOTLPReceiver(true))
+		Expect(err).NotTo(HaveOccurred())
+
+		seedObjects, _, err := act.RenderResources("shoot--foo--bar", cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, obj := range seedObjects {
+			if o, ok := obj.(*otelv1beta1.OpenTelemetryCollector); ok && o.Name == otelCollectorName {
+				return o
+			}
+		}
+		Fail("collector not rendered")
+
+		return nil
+	}
+
+	// unmarshal unmarshals the given rendered settings into the default
+	// configuration of the component and validates it as the collector
+	// does on startup.
+	unmarshal := func(factory component.Factory, settings any) component.Config {
+		componentCfg := factory.CreateDefaultConfig()
+		Expect(settings).To(BeAssignableToTypeOf(map[string]any{}))
+		Expect(confmap.NewFromStringMap(settings.(map[string]any)).Unmarshal(componentCfg)).To(Succeed())
+		Expect(xconfmap.Validate(componentCfg)).To(Succeed())
+
+		return componentCfg
+	}
+
+	newMetrics := func(n int) pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		metric.SetName("foo")
+		gauge := metric.SetEmptyGauge()
+		for i := range n {
+			gauge.DataPoints().AppendEmpty().SetIntValue(int64(i))
+		}
+
+		return md
+	}
+
+	export := func(collector *otelv1beta1.OpenTelemetryCollector, md pmetric.Metrics) {
+		factory := otlphttpexporter.NewFactory()
+		exporterCfg := unmarshal(factory, collector.Spec.Config.Exporters.Object[config.ExporterNameOTLPHTTP])
+
+		exp, err := factory.CreateMetrics(ctx, exportertest.NewNopSettings(factory.Type()), exporterCfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exp.Start(ctx, componenttest.NewNopHost())).To(Succeed())
+		DeferCleanup(exp.Shutdown, ctx)
+
+		Expect(exp.ConsumeMetrics(ctx, md)).To(Succeed())
+	}
+
+	It("should render valid settings of the processors", func() {
+		collector := render()
+
+		for name, factory := range map[string]processor.Factory{
+			batchProcessorName:         batchprocessor.NewFactory(),
+			memoryLimiterProcessorName: memorylimiterprocessor.NewFactory(),
+		} {
+			unmarshal(factory, collector.Spec.Config.Processors.Object[name])
+		}
+	})
+
+	It("should export the metrics to the OTLP backend", func() {
+		export(render(), newMetrics(3))
+		Eventually(received).Should(Equal([]int{3}))
+	})
+
+	It("should split the requests above the maximum size", func() {
+		sendingQueue = `
+        batch:
+          sizer: items
+          max_size: 2`
+
+		export(render(), newMetrics(5))
+		Eventually(received).Should(ConsistOf(2, 2, 1))
+	})
+})
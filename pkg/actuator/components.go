@@ -0,0 +1,476 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
+	"go.opentelemetry.io/collector/processor/batchprocessor"
+	"go.opentelemetry.io/collector/processor/memorylimiterprocessor"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	"github.com/gardener/gardener-extension-otelcol/pkg/imagevector"
+)
+
+// collectorDistributionComponents provides the types of the components, which
+// are included in a distribution of the collector.
+type collectorDistributionComponents struct {
+	receivers  []string
+	processors []string
+	exporters  []string
+	connectors []string
+	extensions []string
+}
+
+// contribComponents are the components of the opentelemetry-collector-contrib
+// distribution, which are known to the extension.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-releases/blob/main/distributions/otelcol-contrib/manifest.yaml
+var contribComponents = collectorDistributionComponents{
+	receivers: []string{
+		"filelog", "fluentforward", "hostmetrics", "httpcheck", "jaeger",
+		"journald", "k8s_cluster", "k8s_events", "k8sobjects", "kafka",
+		"kubeletstats", "nop", "otlp", "prometheus", "prometheus_simple",
+		"receiver_creator", "statsd", "syslog", "tcplog", "udplog", "zipkin",
+	},
+	processors: []string{
+		"attributes", "batch", "cumulativetodelta", "deltatocumulative",
+		"filter", "groupbyattrs", "groupbytrace", "interval", "k8sattributes",
+		"memory_limiter", "metricstransform", "probabilistic_sampler",
+		"redaction", "resource", "resourcedetection", "span",
+		"tail_sampling", "transform",
+	},
+	exporters: []string{
+		"debug", "file", "googlecloud", "kafka", "loadbalancing", "nop",
+		"otlp", "otlp_grpc", "otlp_http", "otlphttp", "prometheus",
+		"prometheusremotewrite", "zipkin",
+	},
+	connectors: []string{
+		"count", "exceptions", "failover", "forward", "roundrobin", "routing",
+		"servicegraph", "spanmetrics",
+	},
+	extensions: []string{
+		"basicauth", "bearertokenauth", "file_storage", "headers_setter",
+		"health_check", "oauth2client", "oidc", "pprof", "zpages",
+	},
+}
+
+// k8sComponents are the components of the opentelemetry-collector-k8s
+// distribution, which are known to the extension.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-releases/blob/main/distributions/otelcol-k8s/manifest.yaml
+var k8sComponents = collectorDistributionComponents{
+	receivers: []string{
+		"filelog", "fluentforward", "hostmetrics", "httpcheck", "jaeger",
+		"journald", "k8s_cluster", "k8s_events", "k8sobjects",
+		"kubeletstats", "nop", "otlp", "prometheus", "receiver_creator",
+		"zipkin",
+	},
+	processors: []string{
+		"attributes", "batch", "cumulativetodelta", "deltatocumulative",
+		"filter", "groupbyattrs", "interval", "k8sattributes",
+		"memory_limiter", "probabilistic_sampler", "redaction", "resource",
+		"resourcedetection", "span", "tail_sampling", "transform",
+	},
+	exporters: []string{
+		"debug", "file", "loadbalancing", "nop", "otlp", "otlp_grpc",
+		"otlp_http", "otlphttp",
+	},
+	connectors: []string{
+		"count", "exceptions", "failover", "forward", "roundrobin", "routing",
+		"servicegraph", "spanmetrics",
+	},
+	extensions: []string{
+		"basicauth", "bearertokenauth", "file_storage", "headers_setter",
+		"health_check", "k8s_observer", "oauth2client", "oidc", "pprof",
+		"zpages",
+	},
+}
+
+// referencedComponents are the components, which may be merged from the
+// collector configuration referenced by the shoot owner. Components, which
+// access the seed nodes or the seed cluster, write to the filesystem of the
+// collector, or expose its internals, such as the filelog, hostmetrics,
+// k8sattributes, file or pprof components, are not supported.
+var referencedComponents = collectorDistributionComponents{
+	receivers: []string{"jaeger", "kafka", "otlp", "zipkin"},
+	processors: []string{
+		"attributes", "batch", "cumulativetodelta", "deltatocumulative",
+		"filter", "groupbyattrs", "groupbytrace", "interval",
+		"memory_limiter", "metricstransform", "probabilistic_sampler",
+		"redaction", "resource", "span", "tail_sampling", "transform",
+	},
+	exporters: []string{
+		"debug", "googlecloud", "kafka", "nop", "otlp", "otlp_grpc",
+		"otlp_http", "otlphttp", "prometheusremotewrite", "zipkin",
+	},
+	connectors: []string{
+		"count", "exceptions", "failover", "forward", "roundrobin", "routing",
+		"servicegraph", "spanmetrics",
+	},
+	extensions: []string{
+		"basicauth", "bearertokenauth", "headers_setter", "oauth2client",
+		"oidc",
+	},
+}
+
+// collectorImageNames maps the distributions of the collector to the names of
+// their images in the image vector.
+var collectorImageNames = map[config.CollectorDistribution]string{
+	config.CollectorDistributionContrib: imagevector.ImageNameOTelCollector,
+	config.CollectorDistributionK8s:     imagevector.ImageNameOTelCollectorK8s,
+}
+
+// collectorImageName returns the name of the collector image in the image
+// vector for the given distribution. The contrib distribution is used, if
+// none is specified.
+func collectorImageName(distribution config.CollectorDistribution) string {
+	if name, ok := collectorImageNames[distribution]; ok {
+		return name
+	}
+
+	return imagevector.ImageNameOTelCollector
+}
+
+// collectorImageComponents maps the names of the collector images and their
+// known tags to the components of their distribution. Images with other tags,
+// e.g. overridden via the image vector, are not checked.
+var collectorImageComponents = map[string]map[string]collectorDistributionComponents{
+	imagevector.ImageNameOTelCollector: {
+		"0.144.0": contribComponents,
+	},
+	imagevector.ImageNameOTelCollectorK8s: {
+		"0.144.0": k8sComponents,
+	},
+}
+
+// validateCollectors validates the configuration of each
+// [otelv1beta1.OpenTelemetryCollector] of the given objects, once it is fully
+// rendered. The collectors are expected to run the given image.
+func validateCollectors(objects []client.Object, image *imagevectorutils.Image) error {
+	for _, obj := range objects {
+		otelCollector, ok := obj.(*otelv1beta1.OpenTelemetryCollector)
+		if !ok {
+			continue
+		}
+
+		if err := validateConnectorPipelines(otelCollector); err != nil {
+			return fmt.Errorf("invalid connectors of the collector configuration: %w", err)
+		}
+		if err := validateStorageExtensions(otelCollector); err != nil {
+			return fmt.Errorf("invalid storage extensions of the collector configuration: %w", err)
+		}
+		if err := validateOTTLStatements(otelCollector); err != nil {
+			return fmt.Errorf("invalid OTTL statements of the collector configuration: %w", err)
+		}
+		if err := validateCollectorComponents(otelCollector, image); err != nil {
+			return err
+		}
+		if err := validateComponentSettings(otelCollector); err != nil {
+			return fmt.Errorf("invalid settings of the collector configuration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateCollectorComponents returns an error, if the given collector
+// configures a component, which is not available in the given collector
+// image.
+func validateCollectorComponents(obj *otelv1beta1.OpenTelemetryCollector, image *imagevectorutils.Image) error {
+	if obj == nil || image == nil || image.Tag == nil {
+		return nil
+	}
+
+	available, ok := collectorImageComponents[image.Name][*image.Tag]
+	if !ok {
+		return nil
+	}
+
+	items := []struct {
+		kind      string
+		config    *otelv1beta1.AnyConfig
+		available []string
+	}{
+		{"receiver", &obj.Spec.Config.Receivers, available.receivers},
+		{"processor", obj.Spec.Config.Processors, available.processors},
+		{"exporter", &obj.Spec.Config.Exporters, available.exporters},
+		{"connector", obj.Spec.Config.Connectors, available.connectors},
+		{"extension", obj.Spec.Config.Extensions, available.extensions},
+	}
+
+	for _, item := range items {
+		if item.config == nil {
+			continue
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(item.config.Object)) {
+			componentType, _, _ := strings.Cut(name, "/")
+			if !slices.Contains(item.available, componentType) {
+				return fmt.Errorf("%s %s not available in collector image %s", item.kind, name, image.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// componentFactories provides the factories of the components, whose settings
+// are validated with the libraries of the collector, by the kind of the
+// components. The libraries may be of a newer version than the collector
+// image, so only the components with stable settings are included.
+var componentFactories = map[string][]component.Factory{
+	"processor": {batchprocessor.NewFactory(), memorylimiterprocessor.NewFactory()},
+	"exporter":  {otlphttpexporter.NewFactory()},
+}
+
+// validateComponentSettings returns an error naming each component of the
+// given collector, whose settings are refused by the collector on startup,
+// e.g. settings of an unknown key or with an invalid value. Only components
+// with a factory in [componentFactories] are validated.
+func validateComponentSettings(obj *otelv1beta1.OpenTelemetryCollector) error {
+	if obj == nil {
+		return nil
+	}
+
+	items := []struct {
+		kind   string
+		config *otelv1beta1.AnyConfig
+	}{
+		{"processor", obj.Spec.Config.Processors},
+		{"exporter", &obj.Spec.Config.Exporters},
+	}
+
+	var errs []error
+	for _, item := range items {
+		if item.config == nil {
+			continue
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(item.config.Object)) {
+			componentType, _, _ := strings.Cut(name, "/")
+			idx := slices.IndexFunc(componentFactories[item.kind], func(factory component.Factory) bool {
+				return factory.Type().String() == componentType
+			})
+			if idx < 0 {
+				continue
+			}
+
+			if err := validateComponentConfig(componentFactories[item.kind][idx], item.config.Object[name]); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %w", item.kind, name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateComponentConfig unmarshals the given settings of a component into
+// the default configuration of the given factory and validates the result the
+// same way as the collector.
+func validateComponentConfig(factory component.Factory, settings any) error {
+	var settingsMap map[string]any
+	switch v := settings.(type) {
+	case nil:
+	case map[string]any:
+		settingsMap = v
+	default:
+		return fmt.Errorf("settings of type %T are not a map", settings)
+	}
+
+	cfg := factory.CreateDefaultConfig()
+	if err := confmap.NewFromStringMap(settingsMap).Unmarshal(cfg); err != nil {
+		return err
+	}
+
+	return xconfmap.Validate(cfg)
+}
+
+// ottlStatementKeys provides the keys of the settings of the transform
+// processor, which provide the groups of OTTL statements.
+var ottlStatementKeys = []string{"trace_statements", "metric_statements", "log_statements"}
+
+// ottlConditionKeys provides the keys of the settings of the filter processor
+// by signal, which provide the OTTL conditions.
+var ottlConditionKeys = map[string][]string{
+	"traces":  {"span", "spanevent"},
+	"metrics": {"metric", "datapoint"},
+	"logs":    {"log_record"},
+}
+
+// validateOTTLStatements returns an error naming each OTTL statement of the
+// transform processors and each OTTL condition of the filter processors of
+// the given collector, which is refused by the OTTL parser, along with its
+// index. The statements are parsed one by one, so that the collector does
+// not crash on startup with a malformed statement, e.g. of the referenced
+// collector configuration.
+func validateOTTLStatements(obj *otelv1beta1.OpenTelemetryCollector) error {
+	if obj == nil || obj.Spec.Config.Processors == nil {
+		return nil
+	}
+
+	var (
+		transformFactory = transformprocessor.NewFactory()
+		filterFactory    = filterprocessor.NewFactory()
+		errs             []error
+	)
+	for _, name := range slices.Sorted(maps.Keys(obj.Spec.Config.Processors.Object)) {
+		settings, ok := obj.Spec.Config.Processors.Object[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		processorType, _, _ := strings.Cut(name, "/")
+		switch processorType {
+		case "transform":
+			for _, key := range ottlStatementKeys {
+				groups, _ := settings[key].([]any)
+				for i, group := range groups {
+					// The statements are either given as a flat
+					// list or in groups with a context.
+					g, ok := group.(map[string]any)
+					if !ok {
+						if err := validateComponentConfig(transformFactory, map[string]any{key: []any{group}}); err != nil {
+							errs = append(errs, fmt.Errorf("processor %s: %s[%d]: %w", name, key, i, err))
+						}
+						continue
+					}
+
+					statements, _ := g["statements"].([]any)
+					for j, statement := range statements {
+						single := maps.Clone(g)
+						single["statements"] = []any{statement}
+						if err := validateComponentConfig(transformFactory, map[string]any{key: []any{single}}); err != nil {
+							errs = append(errs, fmt.Errorf("processor %s: %s[%d].statements[%d]: %w", name, key, i, j, err))
+						}
+					}
+				}
+			}
+		case "filter":
+			for _, signal := range slices.Sorted(maps.Keys(ottlConditionKeys)) {
+				contexts, _ := settings[signal].(map[string]any)
+				for _, key := range ottlConditionKeys[signal] {
+					conditions, _ := contexts[key].([]any)
+					for i, condition := range conditions {
+						single := map[string]any{signal: map[string]any{key: []any{condition}}}
+						if err := validateComponentConfig(filterFactory, single); err != nil {
+							errs = append(errs, fmt.Errorf("processor %s: %s.%s[%d]: %w", name, signal, key, i, err))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// hasServiceExtension returns whether an extension of the given type is
+// enabled in the service of the given collector configuration, which may be
+// nil.
+func hasServiceExtension(cfg *otelv1beta1.Config, extensionType string) bool {
+	if cfg == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(cfg.Service.Extensions, func(name string) bool {
+		componentType, _, _ := strings.Cut(name, "/")
+
+		return componentType == extensionType
+	})
+}
+
+// validateConnectorPipelines returns an error naming each connector of the
+// given collector, which is not used as an exporter by one pipeline and as a
+// receiver by another one. The collector refuses to start with such a
+// connector, e.g. a connector of the referenced collector configuration
+// without the pipeline consuming its data.
+func validateConnectorPipelines(obj *otelv1beta1.OpenTelemetryCollector) error {
+	if obj == nil || obj.Spec.Config.Connectors == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, connector := range slices.Sorted(maps.Keys(obj.Spec.Config.Connectors.Object)) {
+		var exportedBy, receivedBy []string
+		for _, name := range slices.Sorted(maps.Keys(obj.Spec.Config.Service.Pipelines)) {
+			pipeline := obj.Spec.Config.Service.Pipelines[name]
+			if pipeline == nil {
+				continue
+			}
+			if slices.Contains(pipeline.Exporters, connector) {
+				exportedBy = append(exportedBy, name)
+			}
+			if slices.Contains(pipeline.Receivers, connector) {
+				receivedBy = append(receivedBy, name)
+			}
+		}
+
+		switch {
+		case len(exportedBy) == 0 && len(receivedBy) == 0:
+			errs = append(errs, fmt.Errorf("connector %s is not used by any pipeline", connector))
+		case len(exportedBy) == 0:
+			errs = append(errs, fmt.Errorf("connector %s is used as a receiver by pipelines %s, but not as an exporter by any pipeline", connector, strings.Join(receivedBy, ", ")))
+		case len(receivedBy) == 0:
+			errs = append(errs, fmt.Errorf("connector %s is used as an exporter by pipelines %s, but not as a receiver by any pipeline", connector, strings.Join(exportedBy, ", ")))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateStorageExtensions returns an error naming each exporter of the given
+// collector, whose sending queue references a storage extension, e.g. a
+// `file_storage' extension of the referenced collector configuration, which is
+// not configured or not enabled in the `service.extensions' setting. The
+// collector refuses to start with such an exporter.
+func validateStorageExtensions(obj *otelv1beta1.OpenTelemetryCollector) error {
+	if obj == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(obj.Spec.Config.Exporters.Object)) {
+		exporter, ok := obj.Spec.Config.Exporters.Object[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		queue, ok := exporter["sending_queue"].(map[string]any)
+		if !ok {
+			continue
+		}
+		storage, ok := queue["storage"].(string)
+		if !ok || storage == "" {
+			continue
+		}
+
+		var configured bool
+		if obj.Spec.Config.Extensions != nil {
+			_, configured = obj.Spec.Config.Extensions.Object[storage]
+		}
+
+		switch {
+		case !configured:
+			errs = append(errs, fmt.Errorf("exporter %s references storage extension %s, which is not configured", name, storage))
+		case !slices.Contains(obj.Spec.Config.Service.Extensions, storage):
+			errs = append(errs, fmt.Errorf("exporter %s references storage extension %s, which is not enabled in service.extensions", name, storage))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	otelv1beta1 "github.com/gardener/gardener/third_party/open-telemetry/opentelemetry-operator/apis/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validateCollectorComponents", func() {
+	var obj *otelv1beta1.OpenTelemetryCollector
+
+	BeforeEach(func() {
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Receivers: otelv1beta1.AnyConfig{Object: map[string]any{"otlp": map[string]any{}}},
+					Processors: &otelv1beta1.AnyConfig{Object: map[string]any{
+						"batch":            map[string]any{},
+						"transform/events": map[string]any{},
+					}},
+					Exporters: otelv1beta1.AnyConfig{Object: map[string]any{"otlp_http/default": map[string]any{}}},
+				},
+			},
+		}
+	})
+
+	image := func(tag string) *imagevectorutils.Image {
+		return &imagevectorutils.Image{Name: "otel-collector", Repository: new("example.org/otelcol"), Tag: new(tag)}
+	}
+
+	It("should succeed with the components of the distribution", func() {
+		Expect(validateCollectorComponents(obj, image("0.144.0"))).To(Succeed())
+	})
+
+	It("should fail with a component missing in the distribution", func() {
+		obj.Spec.Config.Exporters.Object["awsxray"] = map[string]any{}
+		Expect(validateCollectorComponents(obj, image("0.144.0"))).To(MatchError("exporter awsxray not available in collector image example.org/otelcol:0.144.0"))
+	})
+
+	It("should fail with a component missing in the k8s distribution", func() {
+		obj.Spec.Config.Processors.Object["metricstransform"] = map[string]any{}
+		k8sImage := &imagevectorutils.Image{Name: "otel-collector-k8s", Repository: new("example.org/otelcol-k8s"), Tag: new("0.144.0")}
+		Expect(validateCollectorComponents(obj, image("0.144.0"))).To(Succeed())
+		Expect(validateCollectorComponents(obj, k8sImage)).To(MatchError("processor metricstransform not available in collector image example.org/otelcol-k8s:0.144.0"))
+	})
+
+	It("should not check images with an unknown tag", func() {
+		obj.Spec.Config.Exporters.Object["awsxray"] = map[string]any{}
+		Expect(validateCollectorComponents(obj, image("0.1.0"))).To(Succeed())
+	})
+})
+
+var _ = Describe("validateConnectorPipelines", func() {
+	var obj *otelv1beta1.OpenTelemetryCollector
+
+	BeforeEach(func() {
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Connectors: &otelv1beta1.AnyConfig{Object: map[string]any{"forward/logs": map[string]any{}}},
+					Service: otelv1beta1.Service{
+						Pipelines: map[string]*otelv1beta1.Pipeline{
+							"logs":      {Receivers: []string{"otlp"}, Exporters: []string{"forward/logs"}},
+							"logs/copy": {Receivers: []string{"forward/logs"}, Exporters: []string{"debug"}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("should succeed with connected connectors", func() {
+		Expect(validateConnectorPipelines(obj)).To(Succeed())
+	})
+
+	It("should succeed without connectors", func() {
+		obj.Spec.Config.Connectors = nil
+		Expect(validateConnectorPipelines(obj)).To(Succeed())
+	})
+
+	It("should fail with a connector without a consuming pipeline", func() {
+		delete(obj.Spec.Config.Service.Pipelines, "logs/copy")
+		Expect(validateConnectorPipelines(obj)).To(MatchError("connector forward/logs is used as an exporter by pipelines logs, but not as a receiver by any pipeline"))
+	})
+
+	It("should fail with a connector without a producing pipeline", func() {
+		obj.Spec.Config.Service.Pipelines["logs"].Exporters = []string{"debug"}
+		Expect(validateConnectorPipelines(obj)).To(MatchError("connector forward/logs is used as a receiver by pipelines logs/copy, but not as an exporter by any pipeline"))
+	})
+
+	It("should name each unused connector", func() {
+		obj.Spec.Config.Connectors.Object["servicegraph"] = map[string]any{}
+		obj.Spec.Config.Connectors.Object["spanmetrics"] = map[string]any{}
+		Expect(validateConnectorPipelines(obj)).To(MatchError("connector servicegraph is not used by any pipeline\nconnector spanmetrics is not used by any pipeline"))
+	})
+})
+
+var _ = Describe("validateStorageExtensions", func() {
+	var obj *otelv1beta1.OpenTelemetryCollector
+
+	BeforeEach(func() {
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Exporters: otelv1beta1.AnyConfig{Object: map[string]any{
+						"debug": map[string]any{"verbosity": "basic"},
+						"otlp_http/backup": map[string]any{
+							"endpoint":      "https://backup.example.org:4318",
+							"sending_queue": map[string]any{"storage": "file_storage/queue"},
+						},
+					}},
+					Extensions: &otelv1beta1.AnyConfig{Object: map[string]any{
+						"file_storage/queue": map[string]any{"directory": "/var/lib/otelcol/queue"},
+					}},
+					Service: otelv1beta1.Service{
+						Extensions: []string{"health_check", "file_storage/queue"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should succeed with an enabled storage extension", func() {
+		Expect(validateStorageExtensions(obj)).To(Succeed())
+	})
+
+	It("should succeed without a referenced storage extension", func() {
+		obj.Spec.Config.Exporters.Object["otlp_http/backup"] = map[string]any{"endpoint": "https://backup.example.org:4318"}
+		obj.Spec.Config.Extensions = nil
+		Expect(validateStorageExtensions(obj)).To(Succeed())
+	})
+
+	It("should fail with a storage extension, which is not configured", func() {
+		obj.Spec.Config.Extensions = nil
+		Expect(validateStorageExtensions(obj)).To(MatchError("exporter otlp_http/backup references storage extension file_storage/queue, which is not configured"))
+	})
+
+	It("should fail with a storage extension, which is not enabled", func() {
+		obj.Spec.Config.Service.Extensions = []string{"health_check"}
+		Expect(validateStorageExtensions(obj)).To(MatchError("exporter otlp_http/backup references storage extension file_storage/queue, which is not enabled in service.extensions"))
+	})
+
+	It("should name each misconfigured exporter", func() {
+		obj.Spec.Config.Exporters.Object["otlp_grpc"] = map[string]any{
+			"endpoint":      "otlp.example.org:4317",
+			"sending_queue": map[string]any{"storage": "file_storage"},
+		}
+		obj.Spec.Config.Service.Extensions = nil
+		Expect(validateStorageExtensions(obj)).To(MatchError("exporter otlp_grpc references storage extension file_storage, which is not configured\n" +
+			"exporter otlp_http/backup references storage extension file_storage/queue, which is not enabled in service.extensions"))
+	})
+})
+
+var _ = Describe("validateOTTLStatements", func() {
+	var obj *otelv1beta1.OpenTelemetryCollector
+
+	BeforeEach(func() {
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Processors: &otelv1beta1.AnyConfig{Object: map[string]any{
+						"batch": map[string]any{},
+						"transform/foo": map[string]any{
+							"log_statements": []any{
+								map[string]any{
+									"context": "log",
+									"statements": []any{
+										`set(attributes["foo"], "bar")`,
+										`delete_key(attributes, "baz")`,
+									},
+								},
+							},
+						},
+						"filter/foo": map[string]any{
+							"logs": map[string]any{
+								"log_record": []any{`attributes["foo"] == "bar"`},
+							},
+						},
+					}},
+				},
+			},
+		}
+	})
+
+	It("should succeed with valid statements and conditions", func() {
+		Expect(validateOTTLStatements(obj)).To(Succeed())
+	})
+
+	It("should succeed without processors", func() {
+		obj.Spec.Config.Processors = nil
+		Expect(validateOTTLStatements(obj)).To(Succeed())
+	})
+
+	It("should name the index of a malformed statement", func() {
+		obj.Spec.Config.Processors.Object["transform/foo"] = map[string]any{
+			"log_statements": []any{
+				map[string]any{
+					"context": "log",
+					"statements": []any{
+						`set(attributes["foo"], "bar")`,
+						`set(attributes["foo"], `,
+					},
+				},
+			},
+		}
+
+		err := validateOTTLStatements(obj)
+		Expect(err).To(MatchError(ContainSubstring("processor transform/foo: log_statements[0].statements[1]: ")))
+		Expect(err).NotTo(MatchError(ContainSubstring("statements[0]")))
+	})
+
+	It("should name the index of a malformed statement of a flat list", func() {
+		obj.Spec.Config.Processors.Object["transform/foo"] = map[string]any{
+			"log_statements": []any{`set(log.attributes["foo"], "bar")`, `unknown_function(log.body)`},
+		}
+		Expect(validateOTTLStatements(obj)).To(MatchError(ContainSubstring("processor transform/foo: log_statements[1]: ")))
+	})
+
+	It("should name the index of a malformed condition", func() {
+		obj.Spec.Config.Processors.Object["filter/foo"] = map[string]any{
+			"logs": map[string]any{
+				"log_record": []any{`attributes["foo"] == "bar"`, `attributes["foo"] ==`},
+			},
+		}
+		Expect(validateOTTLStatements(obj)).To(MatchError(ContainSubstring("processor filter/foo: logs.log_record[1]: ")))
+	})
+})
+
+var _ = Describe("validateComponentSettings", func() {
+	var obj *otelv1beta1.OpenTelemetryCollector
+
+	BeforeEach(func() {
+		obj = &otelv1beta1.OpenTelemetryCollector{
+			Spec: otelv1beta1.OpenTelemetryCollectorSpec{
+				Config: otelv1beta1.Config{
+					Processors: &otelv1beta1.AnyConfig{Object: map[string]any{
+						"batch":          map[string]any{"timeout": "5s", "send_batch_size": 1000, "send_batch_max_size": 2000},
+						"memory_limiter": map[string]any{"check_interval": "1s", "limit_percentage": 75},
+						"transform/foo":  map[string]any{"unknown": true},
+					}},
+					Exporters: otelv1beta1.AnyConfig{Object: map[string]any{
+						"otlp_http":        map[string]any{"endpoint": "https://otlp.example.org:4318"},
+						"otlp_http/backup": map[string]any{"endpoint": "https://backup.example.org:4318"},
+						"debug":            nil,
+					}},
+				},
+			},
+		}
+	})
+
+	It("should succeed with valid settings", func() {
+		Expect(validateComponentSettings(obj)).To(Succeed())
+	})
+
+	It("should fail with an unknown setting", func() {
+		obj.Spec.Config.Exporters.Object["otlp_http/backup"] = map[string]any{
+			"endpoint": "https://backup.example.org:4318",
+			"unknown":  true,
+		}
+		Expect(validateComponentSettings(obj)).To(MatchError(And(
+			ContainSubstring("exporter otlp_http/backup"),
+			ContainSubstring("unknown"),
+		)))
+	})
+
+	It("should fail with an invalid value of each component", func() {
+		obj.Spec.Config.Processors.Object["batch"] = map[string]any{"send_batch_size": 2000, "send_batch_max_size": 1000}
+		obj.Spec.Config.Processors.Object["memory_limiter"] = map[string]any{"check_interval": "1s"}
+		err := validateComponentSettings(obj)
+		Expect(err).To(MatchError(ContainSubstring("processor batch: ")))
+		Expect(err).To(MatchError(ContainSubstring("processor memory_limiter: ")))
+	})
+
+	It("should fail with settings, which are not a map", func() {
+		obj.Spec.Config.Exporters.Object["otlp_http"] = "https://otlp.example.org:4318"
+		Expect(validateComponentSettings(obj)).To(MatchError(ContainSubstring("exporter otlp_http: settings of type string are not a map")))
+	})
+})
+
+var _ = Describe("hasServiceExtension", func() {
+	It("should find an enabled extension of the given type", func() {
+		cfg := &otelv1beta1.Config{Service: otelv1beta1.Service{Extensions: []string{"health_check", "headers_setter/tenant"}}}
+		Expect(hasServiceExtension(cfg, "headers_setter")).To(BeTrue())
+	})
+
+	It("should not find an extension, which is not enabled", func() {
+		cfg := &otelv1beta1.Config{
+			Extensions: &otelv1beta1.AnyConfig{Object: map[string]any{"headers_setter": map[string]any{}}},
+		}
+		Expect(hasServiceExtension(cfg, "headers_setter")).To(BeFalse())
+	})
+
+	It("should not find an extension without a configuration", func() {
+		Expect(hasServiceExtension(nil, "headers_setter")).To(BeFalse())
+	})
+})
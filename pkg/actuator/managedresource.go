@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/managedresources/builder"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// managedResourceSecretMaxSize is the maximum size of the data of a Secret of
+// the seed ManagedResource. Objects are limited to 1 MiB by etcd, some
+// headroom is kept for the metadata of the Secret.
+const managedResourceSecretMaxSize = 900 * 1024
+
+// newSeedRegistry returns a new registry for the objects of the seed
+// ManagedResource.
+func newSeedRegistry() *managedresources.Registry {
+	return managedresources.NewRegistry(
+		kubernetes.SeedScheme,
+		kubernetes.SeedCodec,
+		kubernetes.SeedSerializer,
+	)
+}
+
+// serializeManagedResourceObjects serializes the given objects into the data
+// of the Secrets of a ManagedResource, using registries returned by the given
+// function. The objects are serialized into the data of a single Secret,
+// unless its compressed size exceeds the given maximum size. The objects are
+// then distributed in their order across as many Secrets as needed, based on
+// the compressed size of each object on its own, which bounds the compressed
+// size of the objects together. An error is returned, if a single object
+// exceeds the maximum size.
+func serializeManagedResourceObjects(
+	newRegistry func() *managedresources.Registry,
+	maxSize int,
+	objects ...client.Object,
+) ([]map[string][]byte, error) {
+	serialize := func(objects []client.Object) (map[string][]byte, int, error) {
+		data, err := newRegistry().AddAllAndSerialize(objects...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		size := 0
+		for _, value := range data {
+			size += len(value)
+		}
+
+		return data, size, nil
+	}
+
+	data, size, err := serialize(objects)
+	if err != nil {
+		return nil, err
+	}
+	if size <= maxSize {
+		return []map[string][]byte{data}, nil
+	}
+
+	var (
+		chunks    [][]client.Object
+		chunkSize int
+	)
+	for i, obj := range objects {
+		_, size, err := serialize(objects[i : i+1])
+		if err != nil {
+			return nil, err
+		}
+		if size > maxSize {
+			return nil, fmt.Errorf(
+				"%w: %s %s has a serialized size of %d bytes, which exceeds %d bytes",
+				ErrManagedResourceObjectTooLarge, reflect.TypeOf(obj).Elem().Name(), client.ObjectKeyFromObject(obj), size, maxSize,
+			)
+		}
+
+		// The object does not fit into the current Secret, so the
+		// object starts the next one.
+		if len(chunks) == 0 || chunkSize+size > maxSize {
+			chunks = append(chunks, nil)
+			chunkSize = 0
+		}
+		chunks[len(chunks)-1] = append(chunks[len(chunks)-1], obj)
+		chunkSize += size
+	}
+
+	result := make([]map[string][]byte, 0, len(chunks))
+	for _, chunk := range chunks {
+		data, _, err := serialize(chunk)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data)
+	}
+
+	return result, nil
+}
+
+// createSeedManagedResource creates or updates the ManagedResource, which
+// deploys the collector resources into the seed cluster, using the configured
+// ManagedResource class. The ManagedResource references a Secret for each of
+// the given data.
+func (a *Actuator) createSeedManagedResource(ctx context.Context, namespace string, data []map[string][]byte) error {
+	if len(data) == 1 {
+		if a.managedResourceClass == v1beta1constants.SeedResourceManagerClass {
+			return managedresources.CreateForSeed(ctx, a.client, namespace, managedResourceName, false, data[0])
+		}
+
+		return managedresources.Create(
+			ctx,
+			a.client,
+			namespace,
+			managedResourceName,
+			nil,
+			true,
+			a.managedResourceClass,
+			data[0],
+			new(false),
+			nil,
+			nil,
+		)
+	}
+
+	var (
+		secrets    = make([]*builder.Secret, 0, len(data))
+		secretRefs = make([]corev1.LocalObjectReference, 0, len(data))
+	)
+	for i, secretData := range data {
+		name, secret := managedresources.NewSecret(a.client, namespace, fmt.Sprintf("%s-%d", managedResourceName, i), secretData, true)
+		secrets = append(secrets, secret)
+		secretRefs = append(secretRefs, corev1.LocalObjectReference{Name: name})
+	}
+
+	mr := managedresources.New(a.client, namespace, managedResourceName, a.managedResourceClass, new(false), nil, nil, nil)
+	if a.managedResourceClass == v1beta1constants.SeedResourceManagerClass {
+		mr = managedresources.NewForSeed(a.client, namespace, managedResourceName, false)
+	}
+
+	for _, secret := range secrets {
+		if err := secret.Reconcile(ctx); err != nil {
+			return fmt.Errorf("could not create or update secret of managed resources: %w", err)
+		}
+	}
+
+	if err := mr.WithSecretRefs(secretRefs).Reconcile(ctx); err != nil {
+		return fmt.Errorf("could not create or update managed resource: %w", err)
+	}
+
+	return nil
+}
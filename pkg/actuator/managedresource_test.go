@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("serializeManagedResourceObjects", func() {
+	// The data of the ConfigMaps is random, so that the size limit applies
+	// to roughly the given size despite the compression.
+	newConfigMap := func(name string, size int) *corev1.ConfigMap {
+		random := make([]byte, size)
+		for i := range random {
+			random[i] = byte(rand.IntN(256))
+		}
+
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shoot--local--local"},
+			Data:       map[string]string{"data": base64.StdEncoding.EncodeToString(random)},
+		}
+	}
+
+	objectNames := func(data map[string][]byte) []string {
+		Expect(data).To(HaveKey(resourcesv1alpha1.CompressedDataKey))
+		Expect(len(data[resourcesv1alpha1.CompressedDataKey])).To(BeNumerically("<=", 4*1024))
+
+		manifests, err := io.ReadAll(brotli.NewReader(bytes.NewReader(data[resourcesv1alpha1.CompressedDataKey])))
+		Expect(err).NotTo(HaveOccurred())
+
+		var names []string
+		for line := range strings.Lines(string(manifests)) {
+			if name, ok := strings.CutPrefix(line, "  name: "); ok {
+				names = append(names, strings.TrimSpace(name))
+			}
+		}
+
+		return names
+	}
+
+	It("should serialize small objects into a single secret", func() {
+		data, err := serializeManagedResourceObjects(newSeedRegistry, 4*1024,
+			newConfigMap("a", 1024),
+			newConfigMap("b", 1024),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveLen(1))
+		Expect(objectNames(data[0])).To(Equal([]string{"a", "b"}))
+	})
+
+	It("should distribute large objects across multiple secrets", func() {
+		data, err := serializeManagedResourceObjects(newSeedRegistry, 4*1024,
+			newConfigMap("a", 2048),
+			newConfigMap("b", 2048),
+			newConfigMap("c", 512),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveLen(2))
+		Expect(objectNames(data[0])).To(Equal([]string{"a"}))
+		Expect(objectNames(data[1])).To(Equal([]string{"b", "c"}))
+	})
+
+	It("should reject a single object exceeding the maximum size", func() {
+		_, err := serializeManagedResourceObjects(newSeedRegistry, 4*1024,
+			[]client.Object{newConfigMap("a", 1024), newConfigMap("b", 8*1024)}...,
+		)
+		Expect(err).To(MatchError(ErrManagedResourceObjectTooLarge))
+		Expect(err).To(MatchError(ContainSubstring("ConfigMap shoot--local--local/b")))
+	})
+})
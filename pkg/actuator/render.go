@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"errors"
+	"fmt"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-otelcol/pkg/imagevector"
+)
+
+// RenderResources returns the resources, which are deployed into the seed
+// and shoot cluster for the given provider config of a shoot-class extension
+// in the given namespace. Unlike [Actuator.Reconcile] it does not require
+// access to any cluster, hence the secrets generated during reconciliation
+// are referenced by their base names.
+func (a *Actuator) RenderResources(namespace string, cfg config.CollectorConfig) (seedObjects []client.Object, shootObjects []client.Object, err error) {
+	if err := validation.Validate(cfg); err != nil {
+		return nil, nil, err
+	}
+
+	if err := a.validateTLSPolicy(cfg); err != nil {
+		return nil, nil, err
+	}
+
+	if err := a.validateReceiverAuthPolicy(cfg, false); err != nil {
+		return nil, nil, err
+	}
+
+	if err := a.validateHostMetricsPolicy(cfg, false); err != nil {
+		return nil, nil, err
+	}
+
+	// Only the name of the shoot is known from the namespace, so templates
+	// of the exporter endpoints referencing other cluster variables fail.
+	_, _, shootName := parseShootNamespaceAttributes(namespace)
+	cfg, err = expandEndpoints(cfg, config.EndpointVariables{ShootName: shootName})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The referenced resources are copied into the namespace of the
+	// cluster by gardenlet, hence they are not available when rendering.
+	if cfg.Spec.ConfigRef != nil {
+		return nil, nil, errors.New("referenced collector configurations are not supported when rendering")
+	}
+
+	taImage, err := imagevector.Images().FindImage(imagevector.ImageNameOTelTargetAllocator)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find image: %w", err)
+	}
+
+	collectorImage, err := imagevector.Images().FindImage(collectorImageName(cfg.Spec.Distribution))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find image: %w", err)
+	}
+
+	taConfigMap, err := a.getTargetAllocatorConfigMap(namespace, cfg.Spec.TargetAllocator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secretWithName := func(name string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+
+	_, seedObjects = a.getSeedObjects(seedObjectsParams{
+		namespace:                 namespace,
+		cfg:                       cfg,
+		caSecret:                  secretWithName(secretNameCACertificate),
+		clientSecret:              secretWithName(secretNameClientCertificate),
+		serverSecret:              secretWithName(secretNameServerCertificate),
+		taConfigMap:               taConfigMap,
+		shootKubeconfigSecretName: v1beta1constants.SecretNameGenericTokenKubeconfig,
+		collectorImage:            collectorImage,
+		taImage:                   taImage,
+		defaultExporter:           a.isDefaultExporterEnabled(nil) && !a.isDuplicateOfDefaultExporter(cfg),
+	})
+
+	if err := validateCollectors(seedObjects, collectorImage); err != nil {
+		return nil, nil, err
+	}
+
+	shootAccessSecret := gardenerutils.NewShootAccessSecret(shootAccessSecretName, namespace)
+	shootObjects = []client.Object{
+		a.getEventsClusterRole(),
+		a.getEventsClusterRoleBinding(shootAccessSecret.ServiceAccountName),
+	}
+
+	return seedObjects, shootObjects, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrServiceMonitorSecrets is an error which is returned when the
+// ServiceMonitors discovered by the Target Allocator reference secrets, which
+// the Target Allocator cannot deliver to the collector.
+var ErrServiceMonitorSecrets = errors.New("secrets of the service monitors cannot be delivered by the target allocator")
+
+// getServiceMonitorSecretRefs returns the secrets referenced by the endpoints
+// of the given [monitoringv1.ServiceMonitor] by their field path. The secrets
+// are located in the namespace of the ServiceMonitor.
+func getServiceMonitorSecretRefs(sm *monitoringv1.ServiceMonitor) map[string]*corev1.SecretKeySelector {
+	refs := make(map[string]*corev1.SecretKeySelector)
+	for i, ep := range sm.Spec.Endpoints {
+		path := fmt.Sprintf("spec.endpoints[%d]", i)
+
+		if ep.BasicAuth != nil {
+			refs[path+".basicAuth.username"] = &ep.BasicAuth.Username
+			refs[path+".basicAuth.password"] = &ep.BasicAuth.Password
+		}
+		if ep.Authorization != nil {
+			refs[path+".authorization.credentials"] = ep.Authorization.Credentials
+		}
+		if ep.OAuth2 != nil {
+			refs[path+".oauth2.clientId"] = ep.OAuth2.ClientID.Secret
+			refs[path+".oauth2.clientSecret"] = &ep.OAuth2.ClientSecret
+		}
+		if ep.TLSConfig != nil {
+			refs[path+".tlsConfig.ca"] = ep.TLSConfig.CA.Secret
+			refs[path+".tlsConfig.cert"] = ep.TLSConfig.Cert.Secret
+			refs[path+".tlsConfig.keySecret"] = ep.TLSConfig.KeySecret
+		}
+	}
+
+	maps.DeleteFunc(refs, func(_ string, ref *corev1.SecretKeySelector) bool {
+		return ref == nil || ref.Name == ""
+	})
+
+	return refs
+}
+
+// policyRulesAllow returns whether the given [rbacv1.PolicyRule] items grant
+// all of the given verbs on the resource of the core API group.
+func policyRulesAllow(rules []rbacv1.PolicyRule, resource string, verbs ...string) bool {
+	for _, verb := range verbs {
+		allowed := slices.ContainsFunc(rules, func(rule rbacv1.PolicyRule) bool {
+			return slices.Contains(rule.APIGroups, "") &&
+				slices.Contains(rule.Resources, resource) &&
+				(slices.Contains(rule.Verbs, verb) || slices.Contains(rule.Verbs, rbacv1.VerbAll))
+		})
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// listServiceMonitors returns the ServiceMonitors in the given namespace, which
+// match the selector of the Target Allocator. No ServiceMonitors are returned
+// without the ServiceMonitor CRD, since nothing is discovered in this case.
+func (a *Actuator) listServiceMonitors(ctx context.Context, namespace string) ([]monitoringv1.ServiceMonitor, error) {
+	var monitors monitoringv1.ServiceMonitorList
+	if err := a.client.List(
+		ctx,
+		&monitors,
+		client.InNamespace(namespace),
+		client.MatchingLabels{configKeyPrometheus: labelValuePrometheusShoot},
+	); err != nil {
+		if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list service monitors: %w", err)
+	}
+
+	return monitors.Items, nil
+}
+
+// validateServiceMonitorSecrets validates that the secrets referenced by the
+// given ServiceMonitors in the namespace, which are discovered by the Target
+// Allocator, are delivered to the collector.
+//
+// Otherwise the Target Allocator delivers invalid secrets, which results in
+// failed scrapes of the respective targets without any hint about the cause.
+func (a *Actuator) validateServiceMonitorSecrets(ctx context.Context, namespace string, monitors []monitoringv1.ServiceMonitor) error {
+	canReadSecrets := policyRulesAllow(a.getTargetAllocatorRole(namespace).Rules, "secrets", "get", "list", "watch")
+
+	var errs []error
+	for i := range monitors {
+		sm := &monitors[i]
+		refs := getServiceMonitorSecretRefs(sm)
+		for _, path := range slices.Sorted(maps.Keys(refs)) {
+			ref := refs[path]
+			prefix := fmt.Sprintf("service monitor %s references secret %s in %s", sm.Name, ref.Name, path)
+
+			switch {
+			case !a.targetAllocatorMTLS:
+				errs = append(errs, fmt.Errorf("%s, which is not delivered without mTLS", prefix))
+
+				continue
+			case !canReadSecrets:
+				errs = append(errs, fmt.Errorf("%s, which the target allocator is not allowed to read", prefix))
+
+				continue
+			}
+
+			var secret corev1.Secret
+			if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &secret); err != nil {
+				if apierrors.IsNotFound(err) {
+					errs = append(errs, fmt.Errorf("%s, which does not exist", prefix))
+
+					continue
+				}
+
+				return fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+			}
+
+			if _, ok := secret.Data[ref.Key]; !ok {
+				errs = append(errs, fmt.Errorf("%s, which has no %q data key", prefix, ref.Key))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrServiceMonitorSecrets, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("validateServiceMonitorSecrets", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx     = context.Background()
+		monitor *monitoringv1.ServiceMonitor
+		secret  *corev1.Secret
+		act     *Actuator
+	)
+
+	newActuator := func(objs ...client.Object) *Actuator {
+		s := runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(monitoringv1.AddToScheme(s)).To(Succeed())
+
+		act, err := New(fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build())
+		Expect(err).NotTo(HaveOccurred())
+
+		return act
+	}
+
+	validate := func() error {
+		monitors, err := act.listServiceMonitors(ctx, namespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		return act.validateServiceMonitorSecrets(ctx, namespace, monitors)
+	}
+
+	BeforeEach(func() {
+		monitor = &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-state-metrics",
+				Namespace: namespace,
+				Labels:    map[string]string{configKeyPrometheus: labelValuePrometheusShoot},
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{Port: "metrics"}},
+			},
+		}
+		monitor.Spec.Endpoints[0].BasicAuth = &monitoringv1.BasicAuth{
+			Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-auth"}, Key: "username"},
+			Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-auth"}, Key: "password"},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "scrape-auth", Namespace: namespace},
+			Data:       map[string][]byte{"username": []byte("foo"), "password": []byte("bar")},
+		}
+	})
+
+	It("should succeed with the referenced secrets", func() {
+		act = newActuator(monitor, secret)
+		Expect(validate()).To(Succeed())
+	})
+
+	It("should ignore the service monitors of other prometheis", func() {
+		monitor.Labels[configKeyPrometheus] = "seed"
+		act = newActuator(monitor)
+		Expect(validate()).To(Succeed())
+	})
+
+	It("should fail with a missing secret", func() {
+		act = newActuator(monitor)
+		Expect(validate()).To(SatisfyAll(
+			MatchError(ErrServiceMonitorSecrets),
+			MatchError(ContainSubstring("service monitor kube-state-metrics references secret scrape-auth in spec.endpoints[0].basicAuth.password, which does not exist")),
+		))
+	})
+
+	It("should fail with a missing data key", func() {
+		delete(secret.Data, "password")
+		act = newActuator(monitor, secret)
+		Expect(validate()).To(MatchError(ContainSubstring(`which has no "password" data key`)))
+	})
+
+	It("should fail without mTLS", func() {
+		act = newActuator(monitor, secret)
+		act.targetAllocatorMTLS = false
+		Expect(validate()).To(MatchError(ContainSubstring("which is not delivered without mTLS")))
+	})
+
+	It("should list the matching service monitors only", func() {
+		other := monitor.DeepCopy()
+		other.Name = "seed-monitor"
+		other.Labels[configKeyPrometheus] = "seed"
+		act = newActuator(monitor, other)
+
+		monitors, err := act.listServiceMonitors(ctx, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(monitors).To(ConsistOf(HaveField("Name", "kube-state-metrics")))
+	})
+
+	It("should list no service monitors without the service monitor CRD", func() {
+		var err error
+		act, err = New(fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build())
+		Expect(err).NotTo(HaveOccurred())
+
+		monitors, err := act.listServiceMonitors(ctx, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(monitors).To(BeEmpty())
+		Expect(validate()).To(Succeed())
+	})
+})
+
+var _ = Describe("policyRulesAllow", func() {
+	It("should require all verbs to be granted", func() {
+		act := &Actuator{}
+		rules := act.getTargetAllocatorRole("foo").Rules
+		Expect(policyRulesAllow(rules, "secrets", "get", "list", "watch")).To(BeTrue())
+		Expect(policyRulesAllow(rules, "secrets", "create")).To(BeFalse())
+		Expect(policyRulesAllow(rules, "configmaps", "get")).To(BeFalse())
+	})
+})
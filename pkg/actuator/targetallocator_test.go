@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package actuator
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+)
+
+var _ = Describe("getTargetAllocatorConfigMap", func() {
+	const namespace = "shoot--foo--bar"
+
+	var act *Actuator
+
+	BeforeEach(func() {
+		act = &Actuator{}
+	})
+
+	render := func(cfg config.TargetAllocatorConfig) map[string]any {
+		configMap, err := act.getTargetAllocatorConfigMap(namespace, cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configMap.Name).To(Equal("external-otelcol-targetallocator-config"))
+		Expect(configMap.Namespace).To(Equal(namespace))
+		Expect(configMap.Labels).To(HaveKeyWithValue("observability.gardener.cloud/app", "external-otelcol"))
+		Expect(configMap.Data).To(HaveKey("targetallocator.yaml"))
+
+		var taConfig map[string]any
+		Expect(yaml.Unmarshal([]byte(configMap.Data["targetallocator.yaml"]), &taConfig)).To(Succeed())
+
+		return taConfig
+	}
+
+	It("should render the allocation settings", func() {
+		taConfig := render(config.TargetAllocatorConfig{})
+		Expect(taConfig).To(HaveKeyWithValue("allocation_strategy", "consistent-hashing"))
+		Expect(taConfig).To(HaveKeyWithValue("filter_strategy", "relabel-config"))
+		Expect(taConfig).To(HaveKeyWithValue("collector_not_ready_grace_period", "30s"))
+		Expect(taConfig).To(HaveKeyWithValue("collector_namespace", namespace))
+	})
+
+	It("should select the collector pods", func() {
+		taConfig := render(config.TargetAllocatorConfig{})
+		Expect(taConfig).To(HaveKeyWithValue("collector_selector", map[string]any{
+			"matchLabels": map[string]any{
+				"app.kubernetes.io/component":  "opentelemetry-collector",
+				"app.kubernetes.io/instance":   namespace + ".external-otelcol",
+				"app.kubernetes.io/managed-by": "opentelemetry-operator",
+				"app.kubernetes.io/name":       "external-otelcol-collector",
+				"app.kubernetes.io/part-of":    "opentelemetry",
+			},
+		}))
+	})
+
+	It("should discover the service monitors of the shoot namespace only", func() {
+		taConfig := render(config.TargetAllocatorConfig{})
+		Expect(taConfig).To(HaveKeyWithValue("prometheus_cr", And(
+			HaveKeyWithValue("enabled", true),
+			HaveKeyWithValue("scrape_interval", "30s"),
+			HaveKeyWithValue("allow_namespaces", ConsistOf(namespace)),
+			HaveKeyWithValue("deny_namespaces", BeNil()),
+			HaveKeyWithValue("service_monitor_selector", map[string]any{
+				"matchLabels": map[string]any{"prometheus": "shoot"},
+			}),
+			HaveKeyWithValue("pod_monitor_selector", BeNil()),
+			HaveKeyWithValue("probe_selector", BeNil()),
+			HaveKeyWithValue("scrape_config_selector", BeNil()),
+			Not(HaveKey("service_discovery_role")),
+		)))
+	})
+
+	It("should render the scrape interval", func() {
+		taConfig := render(config.TargetAllocatorConfig{ScrapeInterval: 2 * time.Minute})
+		Expect(taConfig).To(HaveKeyWithValue("prometheus_cr", HaveKeyWithValue("scrape_interval", "2m0s")))
+	})
+
+	It("should render the service discovery role", func() {
+		taConfig := render(config.TargetAllocatorConfig{ServiceDiscoveryRole: config.ServiceDiscoveryRoleEndpointSlice})
+		Expect(taConfig).To(HaveKeyWithValue("prometheus_cr", HaveKeyWithValue("service_discovery_role", "EndpointSlice")))
+	})
+})
+
+var _ = Describe("getTargetAllocatorConfigSummary", func() {
+	const namespace = "shoot--foo--bar"
+
+	It("should summarize the allocation strategy and the selectors", func() {
+		act := &Actuator{}
+		configMap, err := act.getTargetAllocatorConfigMap(namespace, config.TargetAllocatorConfig{ServiceDiscoveryRole: config.ServiceDiscoveryRoleEndpointSlice})
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := getTargetAllocatorConfigSummary(configMap)
+		Expect(err).NotTo(HaveOccurred())
+
+		var summary map[string]any
+		Expect(json.Unmarshal([]byte(data), &summary)).To(Succeed())
+		Expect(summary).To(HaveKeyWithValue("checksum", Not(BeEmpty())))
+		Expect(summary).To(HaveKeyWithValue("allocation_strategy", "consistent-hashing"))
+		Expect(summary).To(HaveKeyWithValue("filter_strategy", "relabel-config"))
+		Expect(summary).To(HaveKeyWithValue("collector_selector", HaveKeyWithValue("matchLabels", HaveKeyWithValue("app.kubernetes.io/instance", namespace+".external-otelcol"))))
+		Expect(summary).To(HaveKeyWithValue("prometheus_cr", map[string]any{
+			"allow_namespaces":         []any{namespace},
+			"service_monitor_selector": map[string]any{"matchLabels": map[string]any{"prometheus": "shoot"}},
+			"service_discovery_role":   "EndpointSlice",
+		}))
+	})
+
+	It("should change the checksum with the configuration", func() {
+		act := &Actuator{}
+		configMap, err := act.getTargetAllocatorConfigMap(namespace, config.TargetAllocatorConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		summary, err := getTargetAllocatorConfigSummary(configMap)
+		Expect(err).NotTo(HaveOccurred())
+
+		configMap, err = act.getTargetAllocatorConfigMap(namespace, config.TargetAllocatorConfig{ServiceDiscoveryRole: config.ServiceDiscoveryRoleEndpointSlice})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getTargetAllocatorConfigSummary(configMap)).NotTo(Equal(summary))
+	})
+})
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template/parse"
+)
+
+// EndpointVariables provides the values of the cluster variables, which may be
+// referenced by the templates of the exporter endpoints, e.g.
+// `https://metrics.{{.Region}}.example.org'.
+//
+// +k8s:deepcopy-gen=false
+type EndpointVariables struct {
+	// Region specifies the region of the shoot cluster.
+	Region string
+
+	// Provider specifies the provider type of the shoot cluster, e.g.
+	// `aws'.
+	Provider string
+
+	// ShootName specifies the name of the shoot cluster.
+	ShootName string
+
+	// SeedName specifies the name of the seed cluster, which hosts the
+	// control plane of the shoot cluster.
+	SeedName string
+}
+
+// values returns the values of the cluster variables by their names, as
+// referenced by the templates of the exporter endpoints.
+func (v EndpointVariables) values() map[string]string {
+	return map[string]string{
+		"Region":    v.Region,
+		"Provider":  v.Provider,
+		"ShootName": v.ShootName,
+		"SeedName":  v.SeedName,
+	}
+}
+
+// IsEndpointTemplate is a predicate which returns whether the given endpoint
+// is a template referencing the cluster variables or not.
+func IsEndpointTemplate(endpoint string) bool {
+	return strings.Contains(endpoint, "{{")
+}
+
+// ExpandEndpoint returns the given endpoint with the cluster variables
+// referenced by its template replaced by the given values. Only plain
+// references of the variables, e.g. `{{.Region}}', are supported, so that no
+// functions or control structures are evaluated. An error is returned, if the
+// template is invalid or references a variable without a value.
+func ExpandEndpoint(endpoint string, vars EndpointVariables) (string, error) {
+	if !IsEndpointTemplate(endpoint) {
+		return endpoint, nil
+	}
+
+	trees, err := parse.Parse("endpoint", endpoint, "", "")
+	if err != nil {
+		return "", err
+	}
+	tree, ok := trees["endpoint"]
+	if !ok || len(trees) != 1 {
+		return "", errors.New("unsupported template definitions, only references of the cluster variables are allowed")
+	}
+
+	var (
+		values = vars.values()
+		sb     strings.Builder
+	)
+	for _, node := range tree.Root.Nodes {
+		switch node := node.(type) {
+		case *parse.TextNode:
+			sb.Write(node.Text)
+		case *parse.ActionNode:
+			name, ok := variableName(node)
+			if !ok {
+				return "", fmt.Errorf("unsupported action %s, only references of the cluster variables are allowed", node)
+			}
+
+			value, ok := values[name]
+			if !ok {
+				return "", fmt.Errorf("unknown cluster variable %q", name)
+			}
+			if value == "" {
+				return "", fmt.Errorf("cluster variable %q is not set", name)
+			}
+
+			sb.WriteString(value)
+		default:
+			return "", fmt.Errorf("unsupported action %s, only references of the cluster variables are allowed", node)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// variableName returns the name of the cluster variable referenced by the
+// given action, e.g. `Region' for `{{.Region}}', and whether the action is a
+// plain reference of a variable or not.
+func variableName(node *parse.ActionNode) (string, bool) {
+	if node.Pipe == nil || len(node.Pipe.Decl) > 0 || len(node.Pipe.Cmds) != 1 {
+		return "", false
+	}
+
+	args := node.Pipe.Cmds[0].Args
+	if len(args) != 1 {
+		return "", false
+	}
+
+	field, ok := args[0].(*parse.FieldNode)
+	if !ok || len(field.Ident) != 1 {
+		return "", false
+	}
+
+	return field.Ident[0], true
+}
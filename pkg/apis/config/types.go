@@ -5,9 +5,13 @@
 package config
 
 import (
+	"slices"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // MetricsVerbosityLevel specifies the verbosity of the internal collector
@@ -92,6 +96,67 @@ const (
 	CompressionNone Compression = "none"
 )
 
+// CollectorMode specifies the deployment mode of the collector.
+type CollectorMode string
+
+const (
+	// CollectorModeStatefulSet deploys the collector as a StatefulSet
+	// along with a Target Allocator, which distributes the scrape targets
+	// of the Prometheus receiver.
+	CollectorModeStatefulSet CollectorMode = "statefulset"
+	// CollectorModeDeployment deploys the collector as a stateless
+	// Deployment without a Target Allocator and Prometheus receiver.
+	CollectorModeDeployment CollectorMode = "deployment"
+)
+
+// CollectorDistribution specifies the distribution of the collector, which
+// determines the image and the available components of the collector.
+type CollectorDistribution string
+
+const (
+	// CollectorDistributionContrib specifies the opentelemetry-collector-contrib
+	// distribution.
+	CollectorDistributionContrib CollectorDistribution = "contrib"
+	// CollectorDistributionK8s specifies the opentelemetry-collector-k8s
+	// distribution, which is tailored to Kubernetes.
+	CollectorDistributionK8s CollectorDistribution = "k8s"
+)
+
+// ErrorMode specifies how the processors, which evaluate OTTL statements or
+// conditions, handle errors.
+//
+// See the link below for more details.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl#error-mode
+type ErrorMode string
+
+const (
+	// ErrorModeIgnore logs the errors and continues with the next
+	// statement.
+	ErrorModeIgnore ErrorMode = "ignore"
+	// ErrorModeSilent ignores the errors without logging them and
+	// continues with the next statement.
+	ErrorModeSilent ErrorMode = "silent"
+	// ErrorModePropagate returns the errors up the pipeline, which causes
+	// the payload to be dropped.
+	ErrorModePropagate ErrorMode = "propagate"
+)
+
+// ServiceDiscoveryRole specifies the Kubernetes resource, which is used by the
+// Target Allocator to discover the endpoints of the services selected by the
+// ServiceMonitors.
+type ServiceDiscoveryRole string
+
+const (
+	// ServiceDiscoveryRoleEndpoints specifies that the endpoints are
+	// discovered via Endpoints.
+	ServiceDiscoveryRoleEndpoints ServiceDiscoveryRole = "Endpoints"
+	// ServiceDiscoveryRoleEndpointSlice specifies that the endpoints are
+	// discovered via EndpointSlices, which scale better for services with
+	// many endpoints.
+	ServiceDiscoveryRoleEndpointSlice ServiceDiscoveryRole = "EndpointSlice"
+)
+
 // RetryOnFailureConfig provides the retry policy for an exporter.
 type RetryOnFailureConfig struct {
 	// Enabled specifies whether retry on failure is enabled or not.
@@ -105,14 +170,76 @@ type RetryOnFailureConfig struct {
 	MaxInterval time.Duration
 
 	// MaxElapsedTime specifies the maximum amount of time spent trying to
-	// send a batch. If set to 0, the retries are never stopped.
-	MaxElapsedTime time.Duration
+	// send a batch. If set to 0, the retries are never stopped. If unset,
+	// the default of the collector applies.
+	MaxElapsedTime *time.Duration
 
 	// Multiplier specifies the factor by which the retry interval is
 	// multiplied on each attempt.
 	Multiplier float64
 }
 
+// SendingQueueConfig provides the sending queue settings for an exporter.
+type SendingQueueConfig struct {
+	// Enabled specifies whether the sending queue is enabled or not.
+	Enabled *bool
+
+	// NumConsumers specifies the number of consumers, which dequeue
+	// batches from the queue.
+	NumConsumers int
+
+	// QueueSize specifies the maximum number of batches kept in the
+	// queue.
+	QueueSize int
+
+	// BlockOnOverflow specifies whether the pipeline is blocked when the
+	// queue is full, instead of dropping the data.
+	BlockOnOverflow *bool
+
+	// Batch specifies the sizing of the requests sent by the exporter.
+	Batch SendingQueueBatchConfig
+}
+
+// RequestSizer specifies how the size of the requests of an exporter is
+// measured.
+type RequestSizer string
+
+const (
+	// RequestSizerItems measures the size of the requests in the number of
+	// spans, data points or log records.
+	RequestSizerItems RequestSizer = "items"
+	// RequestSizerBytes measures the size of the requests in the number of
+	// bytes of the serialized data.
+	RequestSizerBytes RequestSizer = "bytes"
+)
+
+// SendingQueueBatchConfig provides the settings for the sizing of the
+// requests sent by an exporter.
+type SendingQueueBatchConfig struct {
+	// Sizer specifies how the size of the requests is measured.
+	Sizer RequestSizer
+
+	// MaxSize specifies the maximum size of the requests, above which the
+	// requests are split.
+	MaxSize int
+}
+
+// IsEnabled is a predicate which returns whether the requests of the exporter
+// are split or not.
+func (cfg SendingQueueBatchConfig) IsEnabled() bool {
+	return cfg.MaxSize > 0
+}
+
+// IsBlockOnOverflowEnabled is a predicate which returns whether the pipeline
+// is blocked when the queue is full or not.
+func (cfg SendingQueueConfig) IsBlockOnOverflowEnabled() bool {
+	if cfg.BlockOnOverflow != nil {
+		return *cfg.BlockOnOverflow
+	}
+
+	return false
+}
+
 // OTLPHTTPExporterConfig provides the OTLP HTTP Exporter configuration settings.
 //
 // See [OTLP HTTP Exporter] for more details.
@@ -177,10 +304,16 @@ type OTLPHTTPExporterConfig struct {
 	// RetryOnFailure specifies the retry policy of the exporter.
 	RetryOnFailure RetryOnFailureConfig
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	SendingQueue SendingQueueConfig
+
 	// Compression specifies the compression to use.
 	//
 	// Possible options are gzip, zstd, snappy and none.
 	Compression Compression
+
+	// Cookies specifies the cookie handling settings of the HTTP client.
+	Cookies CookiesConfig
 }
 
 // IsEnabled is a predicate which returns whether the exporter is enabled or
@@ -193,6 +326,23 @@ func (cfg OTLPHTTPExporterConfig) IsEnabled() bool {
 	return false
 }
 
+// CookiesConfig provides the cookie handling settings of an HTTP client.
+type CookiesConfig struct {
+	// Enabled specifies whether the HTTP client stores the cookies of the
+	// server responses and sends them with the subsequent requests.
+	Enabled *bool
+}
+
+// IsEnabled is a predicate which returns whether the cookie handling is
+// enabled or not.
+func (cfg CookiesConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
 // DebugExporterVerbosity specifies the verbosity level for the debug exporter.
 type DebugExporterVerbosity string
 
@@ -212,6 +362,42 @@ type DebugExporterConfig struct {
 
 	// Verbosity specifies the verbosity level for the debug exporter.
 	Verbosity DebugExporterVerbosity
+
+	// Pipelines specifies additional debug exporters, which are
+	// configured for a single pipeline only.
+	Pipelines []PipelineDebugExporterConfig
+
+	// Path specifies the absolute path of a file, to which the debug
+	// output is written instead of the standard output of the collector.
+	Path string
+}
+
+// PipelineDebugExporterConfig provides the settings for a debug exporter,
+// which is configured for a single pipeline only.
+type PipelineDebugExporterConfig struct {
+	// Pipeline specifies the name of the pipeline.
+	Pipeline string
+
+	// Verbosity specifies the verbosity level for the debug exporter.
+	Verbosity DebugExporterVerbosity
+}
+
+// ExporterName returns the name of the debug exporter for the pipeline in the
+// collector configuration, e.g. `debug/logs_events' for the `logs/events'
+// pipeline.
+func (cfg PipelineDebugExporterConfig) ExporterName() string {
+	return ExporterNameDebug + "/" + strings.ReplaceAll(cfg.Pipeline, "/", "_")
+}
+
+// ExporterName returns the name of the debug exporter in the collector
+// configuration. When the debug output is written to a file, the exporter is
+// a file exporter named `file/debug'.
+func (cfg DebugExporterConfig) ExporterName() string {
+	if cfg.Path != "" {
+		return ExporterNameDebugFile
+	}
+
+	return ExporterNameDebug
 }
 
 // IsEnabled is a predicate which returns whether the exporter is enabled or
@@ -261,6 +447,9 @@ type OTLPGRPCExporterConfig struct {
 	// RetryOnFailure specifies the retry policy of the exporter.
 	RetryOnFailure RetryOnFailureConfig
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	SendingQueue SendingQueueConfig
+
 	// Compression specifies the compression to use. The default value is
 	// [CompressionGzip].
 	Compression Compression
@@ -276,6 +465,150 @@ func (cfg OTLPGRPCExporterConfig) IsEnabled() bool {
 	return false
 }
 
+// FileExporterFormat specifies the format of the data written by the file
+// exporter.
+type FileExporterFormat string
+
+const (
+	// FileExporterFormatJSON specifies that the data is written as JSON
+	// lines.
+	FileExporterFormatJSON FileExporterFormat = "json"
+	// FileExporterFormatProto specifies that the data is written as
+	// length-prefixed protobuf messages.
+	FileExporterFormatProto FileExporterFormat = "proto"
+)
+
+// FileExporterRotationConfig provides the rotation settings of the file
+// written by the file exporter.
+type FileExporterRotationConfig struct {
+	// MaxMegabytes specifies the maximum size (in megabytes) of the file,
+	// before it is rotated.
+	MaxMegabytes int
+
+	// MaxDays specifies the maximum number of days to retain the rotated
+	// files.
+	MaxDays int
+
+	// MaxBackups specifies the maximum number of rotated files to retain.
+	MaxBackups int
+
+	// LocalTime specifies whether the timestamps in the names of the
+	// rotated files use the local time instead of UTC.
+	LocalTime *bool
+}
+
+// FileExporterConfig provides the File Exporter config settings.
+//
+// See [File Exporter] for more details.
+//
+// [File Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/fileexporter
+type FileExporterConfig struct {
+	// Enabled specifies whether the file exporter is enabled or not.
+	Enabled *bool
+
+	// Path specifies the absolute path of the file, to which the data is
+	// written.
+	Path string
+
+	// Format specifies the format of the written data.
+	Format FileExporterFormat
+
+	// Rotation specifies the rotation settings of the file.
+	Rotation FileExporterRotationConfig
+
+	// Fallback specifies whether the file exporter is used as a fallback
+	// of the other exporters only.
+	Fallback *bool
+}
+
+// IsEnabled is a predicate which returns whether the exporter is enabled or
+// not.
+func (cfg FileExporterConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// IsFallbackEnabled is a predicate which returns whether the exporter is
+// enabled as a fallback of the other exporters or not.
+func (cfg FileExporterConfig) IsFallbackEnabled() bool {
+	if !cfg.IsEnabled() || cfg.Fallback == nil {
+		return false
+	}
+
+	return *cfg.Fallback
+}
+
+const (
+	// ExporterNameDebug is the name of the debug exporter in the collector
+	// configuration.
+	ExporterNameDebug = "debug"
+	// ExporterNameFile is the name of the file exporter in the collector
+	// configuration.
+	ExporterNameFile = "file"
+	// ExporterNameDebugFile is the name of the file exporter in the
+	// collector configuration, which writes the debug output to a file.
+	ExporterNameDebugFile = ExporterNameFile + "/" + ExporterNameDebug
+	// ExporterNameOTLPHTTP is the name of the OTLP HTTP exporter in the
+	// collector configuration.
+	ExporterNameOTLPHTTP = "otlp_http"
+	// ExporterNameOTLPGRPC is the name of the OTLP gRPC exporter in the
+	// collector configuration.
+	ExporterNameOTLPGRPC = "otlp_grpc"
+	// ExporterNameGoogleCloud is the name of the Google Cloud exporter in
+	// the collector configuration.
+	ExporterNameGoogleCloud = "googlecloud"
+)
+
+const (
+	// ProcessorNameMemoryLimiter is the name of the memory limiter processor
+	// in the collector configuration.
+	ProcessorNameMemoryLimiter = "memory_limiter"
+	// ProcessorNameBatch is the name of the batch processor in the
+	// collector configuration.
+	ProcessorNameBatch = "batch"
+	// ProcessorNameTransform is the name of the transform processor in the
+	// collector configuration.
+	ProcessorNameTransform = "transform"
+)
+
+// GoogleCloudExporterConfig provides the settings for the Google Cloud
+// exporter.
+//
+// See [Google Cloud Exporter] for more details.
+//
+// [Google Cloud Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/googlecloudexporter
+type GoogleCloudExporterConfig struct {
+	// Enabled specifies whether the Google Cloud exporter is enabled or
+	// not.
+	Enabled *bool
+
+	// Project specifies the ID of the Google Cloud project, to which the
+	// data is exported.
+	Project string
+
+	// ServiceAccount specifies the email of the Google service account,
+	// which is impersonated by the collector via Workload Identity.
+	ServiceAccount string
+}
+
+// AnnotationGCPServiceAccount is the annotation of the service account of the
+// collector, which binds it to the Google service account of the Google Cloud
+// exporter via Workload Identity.
+const AnnotationGCPServiceAccount = "iam.gke.io/gcp-service-account"
+
+// IsEnabled is a predicate which returns whether the exporter is enabled or
+// not.
+func (cfg GoogleCloudExporterConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
 // CollectorExportersConfig provides the OTLP exporter settings.
 type CollectorExportersConfig struct {
 	// OTLPGRPCExporter provides the OTLP gRPC Exporter settings.
@@ -286,6 +619,524 @@ type CollectorExportersConfig struct {
 
 	// DebugExporter provides the settings for the debug exporter.
 	DebugExporter DebugExporterConfig
+
+	// FileExporter provides the settings for the file exporter.
+	FileExporter FileExporterConfig
+
+	// GoogleCloudExporter provides the settings for the Google Cloud
+	// exporter.
+	GoogleCloudExporter GoogleCloudExporterConfig
+
+	// Named provides additional named instances of the exporters.
+	Named []NamedExporterConfig
+}
+
+// NamedExporterConfig provides the settings for a named instance of an
+// exporter. Exactly one of the exporter types must be specified.
+type NamedExporterConfig struct {
+	// Name specifies the name of the instance.
+	Name string
+
+	// OTLPGRPCExporter provides the OTLP gRPC Exporter settings.
+	OTLPGRPCExporter *OTLPGRPCExporterConfig
+
+	// OTLPHTTPExporter provides the OTLP HTTP Exporter settings.
+	OTLPHTTPExporter *OTLPHTTPExporterConfig
+
+	// DebugExporter provides the settings for the debug exporter.
+	DebugExporter *DebugExporterConfig
+}
+
+// ExporterType returns the type of the named exporter, e.g. `otlp_http', or
+// an empty string if no exporter type is specified.
+func (cfg NamedExporterConfig) ExporterType() string {
+	switch {
+	case cfg.OTLPGRPCExporter != nil:
+		return ExporterNameOTLPGRPC
+	case cfg.OTLPHTTPExporter != nil:
+		return ExporterNameOTLPHTTP
+	case cfg.DebugExporter != nil:
+		return ExporterNameDebug
+	}
+
+	return ""
+}
+
+// IsEnabled is a predicate which returns whether the exporter is enabled or
+// not.
+func (cfg NamedExporterConfig) IsEnabled() bool {
+	switch {
+	case cfg.OTLPGRPCExporter != nil:
+		return cfg.OTLPGRPCExporter.IsEnabled()
+	case cfg.OTLPHTTPExporter != nil:
+		return cfg.OTLPHTTPExporter.IsEnabled()
+	case cfg.DebugExporter != nil:
+		return cfg.DebugExporter.IsEnabled()
+	}
+
+	return false
+}
+
+// ExporterName returns the name of the named exporter in the collector
+// configuration, e.g. `otlp_http/backup' for the `backup' instance of the
+// OTLP HTTP exporter.
+func (cfg NamedExporterConfig) ExporterName() string {
+	return cfg.ExporterType() + "/" + cfg.Name
+}
+
+// EnabledExporterNames returns the sorted names of the enabled exporters.
+func (cfg CollectorExportersConfig) EnabledExporterNames() []string {
+	names := make([]string, 0)
+	if cfg.DebugExporter.IsEnabled() {
+		names = append(names, cfg.DebugExporter.ExporterName())
+	}
+	if cfg.FileExporter.IsEnabled() {
+		names = append(names, ExporterNameFile)
+	}
+	if cfg.GoogleCloudExporter.IsEnabled() {
+		names = append(names, ExporterNameGoogleCloud)
+	}
+	if cfg.OTLPGRPCExporter.IsEnabled() {
+		names = append(names, ExporterNameOTLPGRPC)
+	}
+	if cfg.OTLPHTTPExporter.IsEnabled() {
+		names = append(names, ExporterNameOTLPHTTP)
+	}
+	for _, exporter := range cfg.Named {
+		if exporter.IsEnabled() {
+			names = append(names, exporter.ExporterName())
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// OTLPReceiverConfig provides the OTLP Receiver configuration settings.
+//
+// See [OTLP Receiver] for more details.
+//
+// [OTLP Receiver]: https://github.com/open-telemetry/opentelemetry-collector/tree/main/receiver/otlpreceiver
+type OTLPReceiverConfig struct {
+	// IncludeMetadata specifies whether the client metadata (e.g. the
+	// incoming request headers) is propagated to the pipeline context.
+	IncludeMetadata *bool
+
+	// Auth specifies the authentication settings for the clients of the
+	// receiver.
+	Auth OTLPReceiverAuthConfig
+
+	// AllowedClients selects the pods in the control plane namespace of
+	// the shoot, which are allowed to push to the receiver.
+	AllowedClients []metav1.LabelSelector
+}
+
+// OTLPReceiverAuthConfig provides the authentication settings for the clients
+// of the OTLP receiver.
+type OTLPReceiverAuthConfig struct {
+	// Token references the bearer token, which the clients must provide.
+	Token *ResourceReference
+
+	// Htpasswd references the htpasswd file with the credentials of the
+	// clients for basic authentication.
+	Htpasswd *ResourceReference
+}
+
+// IsConfigured is a predicate which returns whether the clients of the
+// receiver are required to authenticate or not.
+func (cfg OTLPReceiverAuthConfig) IsConfigured() bool {
+	return cfg.Token != nil || cfg.Htpasswd != nil
+}
+
+// IsIncludeMetadataEnabled is a predicate which returns whether the client
+// metadata is propagated by the receiver or not.
+func (cfg OTLPReceiverConfig) IsIncludeMetadataEnabled() bool {
+	if cfg.IncludeMetadata != nil {
+		return *cfg.IncludeMetadata
+	}
+
+	return false
+}
+
+// SelfScrapeJobName is the name of the scrape job of the Prometheus receiver,
+// which scrapes the internal metrics of the collector.
+const SelfScrapeJobName = "external-otelcol"
+
+// TargetAllocatorScrapeJobName is the name of the scrape job of the Prometheus
+// receiver, which scrapes the metrics of the Target Allocator.
+const TargetAllocatorScrapeJobName = "external-otelcol-targetallocator"
+
+// ScrapeAuthorizationConfig provides the settings for the Authorization
+// header of the scrape requests.
+type ScrapeAuthorizationConfig struct {
+	// Type specifies the authentication type of the header.
+	Type string
+
+	// Credentials references the credentials for the header.
+	Credentials *ResourceReference
+}
+
+// ScrapeBasicAuthConfig provides the basic authentication settings of the
+// scrape requests.
+type ScrapeBasicAuthConfig struct {
+	// Username specifies the username for basic authentication.
+	Username string
+
+	// Password references the password for basic authentication.
+	Password *ResourceReference
+}
+
+// ScrapeConfig provides the settings for an additional scrape job of the
+// Prometheus receiver.
+type ScrapeConfig struct {
+	// JobName specifies the name of the scrape job.
+	JobName string
+
+	// Targets specifies the static targets of the job in the form of
+	// `host:port'.
+	Targets []string
+
+	// MetricsPath specifies the HTTP resource path, from which metrics
+	// are fetched.
+	MetricsPath string
+
+	// Scheme specifies the URL scheme used for the scrape requests.
+	Scheme string
+
+	// ScrapeInterval specifies how frequently the targets are scraped.
+	ScrapeInterval time.Duration
+
+	// Authorization specifies the Authorization header of the scrape
+	// requests.
+	Authorization *ScrapeAuthorizationConfig
+
+	// BasicAuth specifies the basic authentication settings of the
+	// scrape requests.
+	BasicAuth *ScrapeBasicAuthConfig
+
+	// EnableHTTP2 specifies whether HTTP/2 is enabled for the scrape
+	// requests.
+	EnableHTTP2 *bool
+
+	// FollowRedirects specifies whether the scrape requests follow HTTP
+	// 3xx redirects.
+	FollowRedirects *bool
+
+	// ProxyURL specifies the URL of the proxy used for the scrape
+	// requests.
+	ProxyURL string
+
+	// BodySizeLimit specifies the maximum size of the uncompressed
+	// response body of a scrape, e.g. `10MB'.
+	BodySizeLimit string
+
+	// HonorTimestamps specifies whether the timestamps exposed by the
+	// target are kept.
+	HonorTimestamps *bool
+
+	// TrackTimestampsStaleness specifies whether the samples with
+	// timestamps exposed by the target are marked as stale, when they
+	// disappear from the target.
+	TrackTimestampsStaleness *bool
+
+	// Limits specifies the limits of the scraped samples and labels of
+	// the job.
+	Limits ScrapeLimitsConfig
+}
+
+// ScrapeLimitsConfig provides the limits of the scraped samples and labels,
+// which protect the collector against a cardinality explosion. A value of
+// zero means no limit.
+type ScrapeLimitsConfig struct {
+	// SampleLimit specifies the maximum number of samples per scrape.
+	SampleLimit int
+
+	// LabelLimit specifies the maximum number of labels per sample.
+	LabelLimit int
+
+	// LabelNameLengthLimit specifies the maximum length of a label name.
+	LabelNameLengthLimit int
+
+	// LabelValueLengthLimit specifies the maximum length of a label value.
+	LabelValueLengthLimit int
+}
+
+// PrometheusReceiverConfig provides the Prometheus Receiver configuration
+// settings.
+//
+// See [Prometheus Receiver] for more details.
+//
+// [Prometheus Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/prometheusreceiver
+type PrometheusReceiverConfig struct {
+	// ScrapeConfigs specifies additional scrape jobs with static targets,
+	// which are scraped by the receiver along with the targets provided
+	// by the Target Allocator.
+	ScrapeConfigs []ScrapeConfig
+
+	// NativeHistograms specifies whether native histograms are scraped
+	// and preserved by the receiver.
+	NativeHistograms *bool
+
+	// Limits specifies the default limits of the scraped samples and
+	// labels of all scrape jobs of the receiver.
+	Limits ScrapeLimitsConfig
+
+	// UseStartTimeMetric specifies whether the start time of the
+	// cumulative metrics is derived from the start time metric of the
+	// scraped target.
+	UseStartTimeMetric *bool
+
+	// StartTimeMetricRegex specifies the regular expression matching the
+	// name of the start time metric.
+	StartTimeMetricRegex string
+}
+
+// IsNativeHistogramsEnabled is a predicate which returns whether native
+// histograms are scraped and preserved by the receiver or not.
+func (cfg PrometheusReceiverConfig) IsNativeHistogramsEnabled() bool {
+	if cfg.NativeHistograms != nil {
+		return *cfg.NativeHistograms
+	}
+
+	return false
+}
+
+// IsUseStartTimeMetricEnabled is a predicate which returns whether the start
+// time of the cumulative metrics is derived from the start time metric of the
+// scraped target or not.
+func (cfg PrometheusReceiverConfig) IsUseStartTimeMetricEnabled() bool {
+	if cfg.UseStartTimeMetric != nil {
+		return *cfg.UseStartTimeMetric
+	}
+
+	return false
+}
+
+// ReceiverNameStatsD is the name of the StatsD receiver, which is also the
+// name of its port exposed by the collector.
+const ReceiverNameStatsD = "statsd"
+
+// StatsDReceiverConfig provides the StatsD Receiver configuration settings.
+//
+// See [StatsD Receiver] for more details.
+//
+// [StatsD Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/statsdreceiver
+type StatsDReceiverConfig struct {
+	// Enabled specifies whether the StatsD receiver is enabled or not.
+	Enabled *bool
+
+	// Endpoint specifies the UDP address in the form of `host:port', on
+	// which the receiver listens.
+	Endpoint string
+
+	// AggregationInterval specifies the interval, at which the received
+	// metrics are aggregated.
+	AggregationInterval time.Duration
+
+	// EnableMetricType specifies whether the StatsD metric type is added
+	// as an attribute to the metrics.
+	EnableMetricType *bool
+}
+
+// IsEnabled is a predicate which returns whether the StatsD receiver is
+// enabled or not.
+func (cfg StatsDReceiverConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// IsEnableMetricTypeEnabled is a predicate which returns whether the StatsD
+// metric type is added as an attribute to the metrics or not.
+func (cfg StatsDReceiverConfig) IsEnableMetricTypeEnabled() bool {
+	if cfg.EnableMetricType != nil {
+		return *cfg.EnableMetricType
+	}
+
+	return false
+}
+
+// HostMetricsReceiverConfig provides the Host Metrics Receiver configuration
+// settings.
+//
+// See [Host Metrics Receiver] for more details.
+//
+// [Host Metrics Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/hostmetricsreceiver
+type HostMetricsReceiverConfig struct {
+	// Enabled specifies whether the host metrics receiver is enabled or
+	// not.
+	Enabled *bool
+
+	// Scrapers specifies the scrapers of the receiver.
+	Scrapers []string
+
+	// CollectionInterval specifies the interval, at which the metrics are
+	// collected.
+	CollectionInterval time.Duration
+}
+
+// IsEnabled is a predicate which returns whether the host metrics receiver is
+// enabled or not.
+func (cfg HostMetricsReceiverConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// CollectorReceiversConfig provides the collector receivers settings.
+type CollectorReceiversConfig struct {
+	// OTLPReceiver provides the OTLP Receiver settings.
+	OTLPReceiver OTLPReceiverConfig
+
+	// PrometheusReceiver provides the Prometheus Receiver settings.
+	PrometheusReceiver PrometheusReceiverConfig
+
+	// StatsDReceiver provides the StatsD Receiver settings.
+	StatsDReceiver StatsDReceiverConfig
+
+	// HostMetricsReceiver provides the Host Metrics Receiver settings.
+	HostMetricsReceiver HostMetricsReceiverConfig
+}
+
+const (
+	// PipelineNameLogs is the name of the managed logs pipeline, which
+	// receives logs via the OTLP receiver.
+	PipelineNameLogs = "logs"
+	// PipelineNameEvents is the name of the managed logs pipeline, which
+	// receives the Kubernetes events of the shoot cluster.
+	PipelineNameEvents = "logs/events"
+	// PipelineNameMetrics is the name of the managed metrics pipeline,
+	// which receives metrics via the Prometheus receiver.
+	PipelineNameMetrics = "metrics"
+	// PipelineNameServiceGraph is the name of the managed metrics
+	// pipeline, which receives the metrics of the service graph from the
+	// Service Graph connector.
+	PipelineNameServiceGraph = "metrics/servicegraph"
+	// PipelineNameSpanMetrics is the name of the managed metrics pipeline,
+	// which receives the metrics derived from the spans from the Span
+	// Metrics connector.
+	PipelineNameSpanMetrics = "metrics/spanmetrics"
+)
+
+// FailoverPipelineNames returns the names of the pipelines, which receive the
+// data of the given managed pipeline via the failover connector, when the file
+// exporter is enabled as a fallback, e.g. `logs/events_primary' and
+// `logs/events_fallback' for the `logs/events' pipeline.
+func FailoverPipelineNames(pipeline string) (primary, fallback string) {
+	signal, name, _ := strings.Cut(pipeline, "/")
+	prefix := signal + "/"
+	if name != "" {
+		prefix += name + "_"
+	}
+
+	return prefix + "primary", prefix + "fallback"
+}
+
+// ForwardPipelineConfig provides the settings for an additional pipeline of
+// the collector, which receives data from other pipelines via the [Forward
+// Connector].
+//
+// [Forward Connector]: https://github.com/open-telemetry/opentelemetry-collector/tree/main/connector/forwardconnector
+type ForwardPipelineConfig struct {
+	// Name specifies the name of the pipeline, which is prefixed with the
+	// signal type of the pipeline, e.g. `logs/archive'.
+	Name string
+
+	// From specifies the names of the pipelines, which forward their data
+	// to this pipeline.
+	From []string
+
+	// Processors specifies the names of the processors of the pipeline in
+	// the order they are applied.
+	Processors []string
+
+	// Exporters specifies the names of the exporters of the pipeline. If
+	// not specified, all enabled exporters are used.
+	Exporters []string
+}
+
+// CollectorPipelinesConfig provides the settings for the additional pipelines
+// of the collector.
+type CollectorPipelinesConfig struct {
+	// Forward specifies the pipelines, which are chained to other pipelines
+	// via the forward connector.
+	Forward []ForwardPipelineConfig
+}
+
+// UpdateStrategyConfig provides the settings of the rolling update of the
+// collector pods.
+type UpdateStrategyConfig struct {
+	// MaxUnavailable specifies the maximum number or percentage of pods,
+	// which are unavailable during the update.
+	MaxUnavailable *intstr.IntOrString
+
+	// MaxSurge specifies the maximum number or percentage of pods, which
+	// are created in addition to the desired number of pods during the
+	// update.
+	MaxSurge *intstr.IntOrString
+}
+
+// IsConfigured is a predicate which returns whether the rolling update is
+// configured or not.
+func (cfg UpdateStrategyConfig) IsConfigured() bool {
+	return cfg.MaxUnavailable != nil || cfg.MaxSurge != nil
+}
+
+// SecurityContextConfig provides the settings of the security context of the
+// collector pods.
+type SecurityContextConfig struct {
+	// RunAsUser specifies the UID, with which the collector runs.
+	RunAsUser int64
+
+	// RunAsGroup specifies the GID, with which the collector runs.
+	RunAsGroup int64
+}
+
+// StartupProbeConfig provides the settings for the startup probe of the
+// collector.
+type StartupProbeConfig struct {
+	// FailureThreshold specifies the number of consecutive failures of the
+	// probe, after which the collector container is restarted.
+	FailureThreshold int32
+
+	// PeriodSeconds specifies how often (in seconds) the probe is
+	// performed.
+	PeriodSeconds int32
+}
+
+// GatewayConfig provides the settings for the OTLP gateway, which is deployed
+// in front of the collector.
+type GatewayConfig struct {
+	// Enabled specifies whether the OTLP gateway is deployed or not.
+	Enabled *bool
+
+	// Replicas specifies the number of replicas of the OTLP gateway.
+	Replicas int32
+}
+
+// IsEnabled is a predicate which returns whether the OTLP gateway is enabled
+// or not.
+func (cfg GatewayConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// DNSConfig provides the DNS settings of the collector and Target Allocator
+// pods.
+type DNSConfig struct {
+	// Policy specifies the DNS policy of the pods.
+	Policy corev1.DNSPolicy
+
+	// Config specifies the DNS parameters of the pods, which are merged
+	// with the ones generated from the DNS policy.
+	Config *corev1.PodDNSConfig
 }
 
 // CollectorLogsConfig provides the settings for the collector internal logs.
@@ -310,18 +1161,576 @@ type CollectorLogsConfig struct {
 type CollectorMetricsConfig struct {
 	// Level specifies the collector internal metrics verbosity level.
 	Level MetricsVerbosityLevel
+
+	// ResourceAttributes specifies whether the internal telemetry of the
+	// collector carries the resource attributes of the cluster.
+	ResourceAttributes *bool
+
+	// Push provides the settings of the periodic reader, which pushes the
+	// internal metrics via OTLP.
+	Push MetricsPushConfig
+
+	// Naming specifies the naming convention of the internal metrics
+	// exposed by the Prometheus reader.
+	Naming MetricsNaming
+
+	// Prometheus provides the settings of the Prometheus reader.
+	Prometheus MetricsPrometheusConfig
+
+	// DisabledMetrics specifies the names of the instruments of the
+	// internal metrics, which are dropped.
+	DisabledMetrics []string
+}
+
+// MetricsNaming specifies the naming convention of the internal metrics of the
+// collector exposed by the Prometheus reader.
+type MetricsNaming string
+
+const (
+	// MetricsNamingPrometheus specifies that the names of the internal
+	// metrics carry the unit and type suffixes.
+	MetricsNamingPrometheus MetricsNaming = "prometheus"
+	// MetricsNamingOTel specifies that the names of the internal metrics
+	// match the names of the OTel instruments.
+	MetricsNamingOTel MetricsNaming = "otel"
+)
+
+// MetricsPrometheusConfig provides the settings of the Prometheus reader,
+// which exposes the internal metrics of the collector.
+type MetricsPrometheusConfig struct {
+	// WithoutUnits specifies whether the unit suffix is omitted from the
+	// names of the internal metrics.
+	WithoutUnits *bool
+
+	// WithoutTypeSuffix specifies whether the type suffix is omitted from
+	// the names of the internal metrics.
+	WithoutTypeSuffix *bool
+}
+
+// IsWithoutUnits is a predicate which returns whether the unit suffix is
+// omitted from the names of the internal metrics or not.
+func (cfg CollectorMetricsConfig) IsWithoutUnits() bool {
+	if cfg.Prometheus.WithoutUnits != nil {
+		return *cfg.Prometheus.WithoutUnits
+	}
+
+	return cfg.Naming == MetricsNamingOTel
+}
+
+// IsWithoutTypeSuffix is a predicate which returns whether the type suffix is
+// omitted from the names of the internal metrics or not.
+func (cfg CollectorMetricsConfig) IsWithoutTypeSuffix() bool {
+	if cfg.Prometheus.WithoutTypeSuffix != nil {
+		return *cfg.Prometheus.WithoutTypeSuffix
+	}
+
+	return cfg.Naming == MetricsNamingOTel
+}
+
+// MetricsPushProtocol specifies the protocol of the periodic reader, which
+// pushes the internal metrics of the collector via OTLP.
+type MetricsPushProtocol string
+
+const (
+	// MetricsPushProtocolGRPC specifies that the internal metrics are
+	// pushed via OTLP/gRPC.
+	MetricsPushProtocolGRPC MetricsPushProtocol = "grpc"
+	// MetricsPushProtocolHTTPProtobuf specifies that the internal metrics
+	// are pushed via OTLP/HTTP with protobuf encoding.
+	MetricsPushProtocolHTTPProtobuf MetricsPushProtocol = "http/protobuf"
+)
+
+// MetricsPushConfig provides the settings of the periodic reader, which
+// pushes the internal metrics of the collector via OTLP.
+type MetricsPushConfig struct {
+	// Enabled specifies whether the internal metrics are pushed or not.
+	Enabled *bool
+
+	// Endpoint specifies the endpoint, to which the internal metrics are
+	// pushed. If empty, the metrics endpoint of the OTLP HTTP exporter is
+	// used.
+	Endpoint string
+
+	// Protocol specifies the protocol, via which the internal metrics are
+	// pushed.
+	Protocol MetricsPushProtocol
+
+	// Interval specifies the interval, at which the internal metrics are
+	// pushed.
+	Interval time.Duration
+}
+
+// IsEnabled is a predicate which returns whether the internal metrics are
+// pushed via OTLP or not.
+func (cfg MetricsPushConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// IsRolloutOnSecretRotationEnabled is a predicate which returns whether the
+// collector pods are rolled out on changes of the referenced secrets or not.
+func (cfg CollectorConfigSpec) IsRolloutOnSecretRotationEnabled() bool {
+	if cfg.RolloutOnSecretRotation != nil {
+		return *cfg.RolloutOnSecretRotation
+	}
+
+	return true
+}
+
+// IsResourceAttributesEnabled is a predicate which returns whether the
+// internal telemetry of the collector carries the resource attributes of the
+// cluster or not.
+func (cfg CollectorMetricsConfig) IsResourceAttributesEnabled() bool {
+	if cfg.ResourceAttributes != nil {
+		return *cfg.ResourceAttributes
+	}
+
+	return false
+}
+
+// MetricsTransformOperationConfig provides the settings for an operation of a
+// transform of the Metrics Transform processor.
+type MetricsTransformOperationConfig struct {
+	// Action specifies the action of the operation.
+	Action string
+
+	// Label specifies the label the operation applies to.
+	Label string
+
+	// NewLabel specifies the new name of the label.
+	NewLabel string
+
+	// LabelValue specifies the label value, which is deleted.
+	LabelValue string
+
+	// NewValue specifies the value of the label.
+	NewValue string
+
+	// LabelSet specifies the labels, which are kept by an aggregation.
+	LabelSet []string
+
+	// AggregatedValues specifies the label values, which are aggregated.
+	AggregatedValues []string
+
+	// AggregationType specifies how the data points are aggregated.
+	AggregationType string
+}
+
+// MetricsTransformConfig provides the settings for a transform of the Metrics
+// Transform processor.
+type MetricsTransformConfig struct {
+	// Include specifies the name of the metrics, which are transformed.
+	Include string
+
+	// MatchType specifies how Include is matched against the metric
+	// names.
+	MatchType string
+
+	// Action specifies the action of the transform.
+	Action string
+
+	// NewName specifies the new name of the metric.
+	NewName string
+
+	// Operations specifies the operations, which are applied to the
+	// labels and data points of the metrics.
+	Operations []MetricsTransformOperationConfig
+}
+
+// MetricsTransformProcessorConfig provides the Metrics Transform processor
+// configuration settings.
+//
+// See [Metrics Transform Processor] for more details.
+//
+// [Metrics Transform Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/metricstransformprocessor
+type MetricsTransformProcessorConfig struct {
+	// Transforms specifies the transforms of the processor in the order
+	// they are applied.
+	Transforms []MetricsTransformConfig
+}
+
+// BatchProcessorConfig provides the Batch processor configuration settings.
+//
+// See [Batch Processor] for more details.
+//
+// [Batch Processor]: https://github.com/open-telemetry/opentelemetry-collector/tree/main/processor/batchprocessor
+type BatchProcessorConfig struct {
+	// Enabled specifies whether the Batch processor is enabled or not.
+	Enabled *bool
+
+	// SendBatchMaxSize specifies the maximum number of items of a batch,
+	// which overrides the one of the extension.
+	SendBatchMaxSize int
+}
+
+// IsEnabled is a predicate which returns whether the Batch processor is
+// enabled or not.
+func (cfg BatchProcessorConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return true
+}
+
+// CollectorProcessorsConfig provides the settings, which apply to the
+// processors of the collector.
+type CollectorProcessorsConfig struct {
+	// ErrorMode specifies how the processors, which evaluate OTTL
+	// statements or conditions, handle errors.
+	ErrorMode ErrorMode
+
+	// MetricsTransform specifies the settings of the Metrics Transform
+	// processor of the metrics pipeline.
+	MetricsTransform MetricsTransformProcessorConfig
+
+	// Batch specifies the settings of the Batch processor of the
+	// pipelines.
+	Batch BatchProcessorConfig
+
+	// Named provides additional named instances of the processors.
+	Named []NamedProcessorConfig
+
+	// TailSampling specifies the settings of the Tail Sampling processors
+	// of the referenced collector configuration.
+	TailSampling TailSamplingProcessorConfig
+}
+
+// ProcessorNameTailSampling is the name of the Tail Sampling processor in the
+// collector configuration.
+const ProcessorNameTailSampling = "tail_sampling"
+
+// TailSamplingProcessorConfig provides the settings, which apply to the Tail
+// Sampling processors of the referenced collector configuration. The settings,
+// which are not specified, are the ones of the referenced processors.
+type TailSamplingProcessorConfig struct {
+	// DecisionWait specifies the time since the first span of a trace,
+	// after which the sampling decision is made.
+	DecisionWait time.Duration
+
+	// NumTraces specifies the number of traces kept in memory.
+	NumTraces int
+
+	// ExpectedNewTracesPerSec specifies the expected number of new traces
+	// per second, which is used to allocate the data structures upfront.
+	ExpectedNewTracesPerSec int
+}
+
+// NamedBatchProcessorConfig provides the settings for a named instance of the
+// Batch processor. The settings, which are not specified, are the ones of the
+// extension.
+type NamedBatchProcessorConfig struct {
+	// Timeout specifies the time, after which a batch is sent regardless
+	// of its size.
+	Timeout time.Duration
+
+	// SendBatchSize specifies the number of items, after which a batch is
+	// sent regardless of the timeout.
+	SendBatchSize int
+
+	// SendBatchMaxSize specifies the maximum number of items of a batch.
+	SendBatchMaxSize int
+}
+
+// NamedMemoryLimiterProcessorConfig provides the settings for a named instance
+// of the Memory Limiter processor. The settings, which are not specified, are
+// the ones of the extension.
+type NamedMemoryLimiterProcessorConfig struct {
+	// CheckInterval specifies the interval, at which the memory usage is
+	// checked.
+	CheckInterval time.Duration
+
+	// LimitPercentage specifies the maximum memory usage in percent of
+	// the available memory.
+	LimitPercentage int
+
+	// SpikeLimitPercentage specifies the maximum spike of the memory usage
+	// between two checks in percent of the available memory.
+	SpikeLimitPercentage int
+}
+
+// NamedTransformProcessorConfig provides the settings for a named instance of
+// the Transform processor.
+type NamedTransformProcessorConfig struct {
+	// MetricStatements specifies the OTTL statements, which are applied
+	// to the metrics.
+	MetricStatements []string
+
+	// LogStatements specifies the OTTL statements, which are applied to
+	// the logs.
+	LogStatements []string
+}
+
+// NamedProcessorConfig provides the settings for a named instance of a
+// processor, which is referenced by the pipelines. Exactly one of the
+// processor types must be specified.
+type NamedProcessorConfig struct {
+	// Name specifies the name of the instance.
+	Name string
+
+	// Batch provides the Batch processor settings.
+	Batch *NamedBatchProcessorConfig
+
+	// MemoryLimiter provides the Memory Limiter processor settings.
+	MemoryLimiter *NamedMemoryLimiterProcessorConfig
+
+	// Transform provides the Transform processor settings.
+	Transform *NamedTransformProcessorConfig
+}
+
+// ProcessorType returns the type of the named processor, e.g. `batch', or an
+// empty string if no processor type is specified.
+func (cfg NamedProcessorConfig) ProcessorType() string {
+	switch {
+	case cfg.Batch != nil:
+		return ProcessorNameBatch
+	case cfg.MemoryLimiter != nil:
+		return ProcessorNameMemoryLimiter
+	case cfg.Transform != nil:
+		return ProcessorNameTransform
+	}
+
+	return ""
+}
+
+// ProcessorName returns the name of the named processor in the collector
+// configuration, e.g. `batch/large' for the `large' instance of the Batch
+// processor.
+func (cfg NamedProcessorConfig) ProcessorName() string {
+	return cfg.ProcessorType() + "/" + cfg.Name
+}
+
+// ConnectorNameServiceGraph is the name of the Service Graph connector in the
+// collector configuration.
+const ConnectorNameServiceGraph = "servicegraph"
+
+// ServiceGraphConnectorConfig provides the Service Graph Connector
+// configuration settings.
+type ServiceGraphConnectorConfig struct {
+	// Enabled specifies whether the Service Graph connector is enabled or
+	// not.
+	Enabled *bool
+
+	// LatencyHistogramBuckets specifies the buckets of the histograms of
+	// the request latency between the services.
+	LatencyHistogramBuckets []time.Duration
+
+	// Dimensions specifies the additional attributes of the spans, which
+	// are added as labels to the metrics of the service graph.
+	Dimensions []string
+}
+
+// IsEnabled is a predicate which returns whether the Service Graph connector
+// is enabled or not.
+func (cfg ServiceGraphConnectorConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// ConnectorNameSpanMetrics is the name of the Span Metrics connector in the
+// collector configuration.
+const ConnectorNameSpanMetrics = "spanmetrics"
+
+// SpanMetricsExemplarsConfig provides the settings of the exemplars of the
+// Span Metrics connector.
+type SpanMetricsExemplarsConfig struct {
+	// Enabled specifies whether the metrics carry exemplars, which
+	// reference the trace and span IDs of the spans.
+	Enabled *bool
+
+	// MaxPerDataPoint specifies the maximum number of exemplars per data
+	// point.
+	MaxPerDataPoint *int32
+}
+
+// IsEnabled is a predicate which returns whether the exemplars are enabled or
+// not.
+func (cfg SpanMetricsExemplarsConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// SpanMetricsConnectorConfig provides the Span Metrics Connector
+// configuration settings.
+type SpanMetricsConnectorConfig struct {
+	// Enabled specifies whether the Span Metrics connector is enabled or
+	// not.
+	Enabled *bool
+
+	// Dimensions specifies the additional attributes of the spans, which
+	// are added as labels to the metrics.
+	Dimensions []string
+
+	// Exemplars specifies the settings of the exemplars.
+	Exemplars SpanMetricsExemplarsConfig
+}
+
+// IsEnabled is a predicate which returns whether the Span Metrics connector
+// is enabled or not.
+func (cfg SpanMetricsConnectorConfig) IsEnabled() bool {
+	if cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+
+	return false
+}
+
+// CollectorConnectorsConfig provides the settings of the connectors of the
+// collector.
+type CollectorConnectorsConfig struct {
+	// ServiceGraphConnector provides the Service Graph connector settings.
+	ServiceGraphConnector ServiceGraphConnectorConfig
+
+	// SpanMetricsConnector provides the Span Metrics connector settings.
+	SpanMetricsConnector SpanMetricsConnectorConfig
+}
+
+// TargetAllocatorConfig provides the settings of the Target Allocator.
+type TargetAllocatorConfig struct {
+	// ServiceDiscoveryRole specifies the Kubernetes resource, which is
+	// used to discover the endpoints of the services.
+	ServiceDiscoveryRole ServiceDiscoveryRole
+
+	// RevisionHistoryLimit specifies the number of old ReplicaSets of the
+	// Target Allocator deployment, which are retained.
+	RevisionHistoryLimit *int32
+
+	// LogLevel specifies the log level of the Target Allocator.
+	LogLevel LogLevel
+
+	// ScrapeInterval specifies the scrape interval, which applies to the
+	// endpoints of the discovered ServiceMonitors without an interval.
+	ScrapeInterval time.Duration
+}
+
+// PortConfig provides the settings for an additional port of the collector,
+// e.g. of a receiver configured via [CollectorConfigSpec.ConfigRef].
+type PortConfig struct {
+	// Name specifies the name of the port.
+	Name string
+
+	// Port specifies the number of the port.
+	Port int32
+
+	// Protocol specifies the protocol of the port.
+	Protocol corev1.Protocol
+}
+
+// VolumeConfig provides the settings for an additional volume of the
+// collector, e.g. for the components configured via
+// [CollectorConfigSpec.ConfigRef].
+type VolumeConfig struct {
+	// Name specifies the name of the volume.
+	Name string
+
+	// MountPath specifies the path, at which the volume is mounted.
+	MountPath string
+
+	// ResourceRef references a key of a Secret or ConfigMap of the shoot,
+	// which is mounted read-only.
+	ResourceRef *ResourceReferenceDetails
+
+	// EmptyDir specifies a writable volume, which shares the lifetime of
+	// the collector pod.
+	EmptyDir *corev1.EmptyDirVolumeSource
 }
 
 // CollectorConfigSpec specifies the desired state of [CollectorConfig]
 type CollectorConfigSpec struct {
+	// Mode specifies the deployment mode of the collector.
+	Mode CollectorMode
+
+	// Distribution specifies the distribution of the collector.
+	Distribution CollectorDistribution
+
 	// Exporters specifies the exporters configuration of the collector.
 	Exporters CollectorExportersConfig
 
+	// Receivers specifies the receivers configuration of the collector.
+	Receivers CollectorReceiversConfig
+
+	// Pipelines specifies the additional pipelines of the collector.
+	Pipelines CollectorPipelinesConfig
+
+	// Processors specifies the settings, which apply to the processors
+	// of the collector.
+	Processors CollectorProcessorsConfig
+
+	// Connectors specifies the settings of the connectors of the
+	// collector.
+	Connectors CollectorConnectorsConfig
+
+	// TargetAllocator specifies the settings of the Target Allocator.
+	TargetAllocator TargetAllocatorConfig
+
+	// PodAnnotations specifies additional annotations of the collector
+	// and Target Allocator pods.
+	PodAnnotations map[string]string
+
+	// ServiceAccountAnnotations specifies additional annotations of the
+	// service account of the collector.
+	ServiceAccountAnnotations map[string]string
+
+	// MetricNamePrefix specifies the prefix, which is prepended to the
+	// names of all metrics processed by the metrics pipeline.
+	MetricNamePrefix string
+
+	// StartupProbe specifies the settings for the startup probe of the
+	// collector.
+	StartupProbe StartupProbeConfig
+
+	// SecurityContext specifies the settings of the security context of
+	// the collector pods.
+	SecurityContext SecurityContextConfig
+
+	// UpdateStrategy specifies the settings of the rolling update of the
+	// collector pods.
+	UpdateStrategy UpdateStrategyConfig
+
+	// ShutdownTimeout only affects the termination grace period of the
+	// collector pods, which is set to the larger of the default and the
+	// delay of the pre-stop hook plus this timeout. It is not passed to the
+	// collector.
+	ShutdownTimeout time.Duration
+
+	// RolloutOnSecretRotation specifies whether the collector pods are
+	// rolled out, when the data of the referenced secrets changes.
+	RolloutOnSecretRotation *bool
+
 	// Logs specifies the settings for the collector logs.
 	Logs CollectorLogsConfig
 
 	// Metrics specifies the settings for the internal collector metrics.
 	Metrics CollectorMetricsConfig
+
+	// DNS specifies the DNS settings of the collector and Target
+	// Allocator pods.
+	DNS DNSConfig
+
+	// Gateway specifies the settings for the OTLP gateway.
+	Gateway GatewayConfig
+
+	// ConfigRef references a key of a ConfigMap with a collector
+	// configuration, which is merged into the configuration generated by
+	// the extension.
+	ConfigRef *ResourceReference
+
+	// Ports specifies the additional ports, which are exposed by the
+	// collector.
+	Ports []PortConfig
+
+	// Volumes specifies the additional volumes, which are mounted into
+	// the collector container.
+	Volumes []VolumeConfig
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -334,6 +1743,16 @@ type CollectorConfig struct {
 	Spec CollectorConfigSpec
 }
 
+// TLSVersion specifies a version of the TLS protocol.
+type TLSVersion string
+
+const (
+	// TLSVersion12 specifies TLS 1.2.
+	TLSVersion12 TLSVersion = "1.2"
+	// TLSVersion13 specifies TLS 1.3.
+	TLSVersion13 TLSVersion = "1.3"
+)
+
 // TLSConfig provides the TLS settings used by exporters.
 type TLSConfig struct {
 	// InsecureSkipVerify specifies whether to skip verifying the
@@ -344,6 +1763,9 @@ type TLSConfig struct {
 	// For a server this verifies client certificates.
 	// If empty uses system root CA.
 	CA *ResourceReference
+	// IncludeSystemCAs specifies whether the system root CAs are trusted in
+	// addition to the CA or not.
+	IncludeSystemCAs *bool
 	// Cert references the client certificate to use for TLS required connections.
 	Cert *ResourceReference
 	// Key references the client key to use for TLS required connections.
@@ -351,6 +1773,12 @@ type TLSConfig struct {
 	// ReloadInterval specifies the duration after which the certificate will be reloaded.
 	// If not set, it will never be reloaded
 	ReloadInterval time.Duration
+	// MinVersion specifies the minimum TLS version of the connections.
+	MinVersion TLSVersion
+	// MaxVersion specifies the maximum TLS version of the connections.
+	MaxVersion TLSVersion
+	// CipherSuites restricts the cipher suites of TLS 1.2 connections.
+	CipherSuites []string
 }
 
 // ResourceReference references data from a Secret.
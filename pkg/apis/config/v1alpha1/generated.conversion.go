@@ -10,8 +10,11 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -21,6 +24,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BatchProcessorConfig)(nil), (*config.BatchProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BatchProcessorConfig_To_config_BatchProcessorConfig(a.(*BatchProcessorConfig), b.(*config.BatchProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BatchProcessorConfig)(nil), (*BatchProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BatchProcessorConfig_To_v1alpha1_BatchProcessorConfig(a.(*config.BatchProcessorConfig), b.(*BatchProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CollectorConfig)(nil), (*config.CollectorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CollectorConfig_To_config_CollectorConfig(a.(*CollectorConfig), b.(*config.CollectorConfig), scope)
 	}); err != nil {
@@ -41,6 +54,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CollectorConnectorsConfig)(nil), (*config.CollectorConnectorsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorConnectorsConfig_To_config_CollectorConnectorsConfig(a.(*CollectorConnectorsConfig), b.(*config.CollectorConnectorsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorConnectorsConfig)(nil), (*CollectorConnectorsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorConnectorsConfig_To_v1alpha1_CollectorConnectorsConfig(a.(*config.CollectorConnectorsConfig), b.(*CollectorConnectorsConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CollectorExportersConfig)(nil), (*config.CollectorExportersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(a.(*CollectorExportersConfig), b.(*config.CollectorExportersConfig), scope)
 	}); err != nil {
@@ -71,6 +94,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CollectorPipelinesConfig)(nil), (*config.CollectorPipelinesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(a.(*CollectorPipelinesConfig), b.(*config.CollectorPipelinesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorPipelinesConfig)(nil), (*CollectorPipelinesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(a.(*config.CollectorPipelinesConfig), b.(*CollectorPipelinesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CollectorProcessorsConfig)(nil), (*config.CollectorProcessorsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorProcessorsConfig_To_config_CollectorProcessorsConfig(a.(*CollectorProcessorsConfig), b.(*config.CollectorProcessorsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorProcessorsConfig)(nil), (*CollectorProcessorsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorProcessorsConfig_To_v1alpha1_CollectorProcessorsConfig(a.(*config.CollectorProcessorsConfig), b.(*CollectorProcessorsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CollectorReceiversConfig)(nil), (*config.CollectorReceiversConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CollectorReceiversConfig_To_config_CollectorReceiversConfig(a.(*CollectorReceiversConfig), b.(*config.CollectorReceiversConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CollectorReceiversConfig)(nil), (*CollectorReceiversConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CollectorReceiversConfig_To_v1alpha1_CollectorReceiversConfig(a.(*config.CollectorReceiversConfig), b.(*CollectorReceiversConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CookiesConfig)(nil), (*config.CookiesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CookiesConfig_To_config_CookiesConfig(a.(*CookiesConfig), b.(*config.CookiesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CookiesConfig)(nil), (*CookiesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CookiesConfig_To_v1alpha1_CookiesConfig(a.(*config.CookiesConfig), b.(*CookiesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSConfig)(nil), (*config.DNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSConfig_To_config_DNSConfig(a.(*DNSConfig), b.(*config.DNSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNSConfig)(nil), (*DNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNSConfig_To_v1alpha1_DNSConfig(a.(*config.DNSConfig), b.(*DNSConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DebugExporterConfig)(nil), (*config.DebugExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(a.(*DebugExporterConfig), b.(*config.DebugExporterConfig), scope)
 	}); err != nil {
@@ -81,6 +154,166 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FileExporterConfig)(nil), (*config.FileExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FileExporterConfig_To_config_FileExporterConfig(a.(*FileExporterConfig), b.(*config.FileExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FileExporterConfig)(nil), (*FileExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FileExporterConfig_To_v1alpha1_FileExporterConfig(a.(*config.FileExporterConfig), b.(*FileExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FileExporterRotationConfig)(nil), (*config.FileExporterRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FileExporterRotationConfig_To_config_FileExporterRotationConfig(a.(*FileExporterRotationConfig), b.(*config.FileExporterRotationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FileExporterRotationConfig)(nil), (*FileExporterRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FileExporterRotationConfig_To_v1alpha1_FileExporterRotationConfig(a.(*config.FileExporterRotationConfig), b.(*FileExporterRotationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ForwardPipelineConfig)(nil), (*config.ForwardPipelineConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ForwardPipelineConfig_To_config_ForwardPipelineConfig(a.(*ForwardPipelineConfig), b.(*config.ForwardPipelineConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ForwardPipelineConfig)(nil), (*ForwardPipelineConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ForwardPipelineConfig_To_v1alpha1_ForwardPipelineConfig(a.(*config.ForwardPipelineConfig), b.(*ForwardPipelineConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GatewayConfig)(nil), (*config.GatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GatewayConfig_To_config_GatewayConfig(a.(*GatewayConfig), b.(*config.GatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GatewayConfig)(nil), (*GatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GatewayConfig_To_v1alpha1_GatewayConfig(a.(*config.GatewayConfig), b.(*GatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GoogleCloudExporterConfig)(nil), (*config.GoogleCloudExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GoogleCloudExporterConfig_To_config_GoogleCloudExporterConfig(a.(*GoogleCloudExporterConfig), b.(*config.GoogleCloudExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GoogleCloudExporterConfig)(nil), (*GoogleCloudExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GoogleCloudExporterConfig_To_v1alpha1_GoogleCloudExporterConfig(a.(*config.GoogleCloudExporterConfig), b.(*GoogleCloudExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HostMetricsReceiverConfig)(nil), (*config.HostMetricsReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostMetricsReceiverConfig_To_config_HostMetricsReceiverConfig(a.(*HostMetricsReceiverConfig), b.(*config.HostMetricsReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HostMetricsReceiverConfig)(nil), (*HostMetricsReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HostMetricsReceiverConfig_To_v1alpha1_HostMetricsReceiverConfig(a.(*config.HostMetricsReceiverConfig), b.(*HostMetricsReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsPrometheusConfig)(nil), (*config.MetricsPrometheusConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricsPrometheusConfig_To_config_MetricsPrometheusConfig(a.(*MetricsPrometheusConfig), b.(*config.MetricsPrometheusConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MetricsPrometheusConfig)(nil), (*MetricsPrometheusConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MetricsPrometheusConfig_To_v1alpha1_MetricsPrometheusConfig(a.(*config.MetricsPrometheusConfig), b.(*MetricsPrometheusConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsPushConfig)(nil), (*config.MetricsPushConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricsPushConfig_To_config_MetricsPushConfig(a.(*MetricsPushConfig), b.(*config.MetricsPushConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MetricsPushConfig)(nil), (*MetricsPushConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MetricsPushConfig_To_v1alpha1_MetricsPushConfig(a.(*config.MetricsPushConfig), b.(*MetricsPushConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsTransformConfig)(nil), (*config.MetricsTransformConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricsTransformConfig_To_config_MetricsTransformConfig(a.(*MetricsTransformConfig), b.(*config.MetricsTransformConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MetricsTransformConfig)(nil), (*MetricsTransformConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MetricsTransformConfig_To_v1alpha1_MetricsTransformConfig(a.(*config.MetricsTransformConfig), b.(*MetricsTransformConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsTransformOperationConfig)(nil), (*config.MetricsTransformOperationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricsTransformOperationConfig_To_config_MetricsTransformOperationConfig(a.(*MetricsTransformOperationConfig), b.(*config.MetricsTransformOperationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MetricsTransformOperationConfig)(nil), (*MetricsTransformOperationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MetricsTransformOperationConfig_To_v1alpha1_MetricsTransformOperationConfig(a.(*config.MetricsTransformOperationConfig), b.(*MetricsTransformOperationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricsTransformProcessorConfig)(nil), (*config.MetricsTransformProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricsTransformProcessorConfig_To_config_MetricsTransformProcessorConfig(a.(*MetricsTransformProcessorConfig), b.(*config.MetricsTransformProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MetricsTransformProcessorConfig)(nil), (*MetricsTransformProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MetricsTransformProcessorConfig_To_v1alpha1_MetricsTransformProcessorConfig(a.(*config.MetricsTransformProcessorConfig), b.(*MetricsTransformProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedBatchProcessorConfig)(nil), (*config.NamedBatchProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedBatchProcessorConfig_To_config_NamedBatchProcessorConfig(a.(*NamedBatchProcessorConfig), b.(*config.NamedBatchProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamedBatchProcessorConfig)(nil), (*NamedBatchProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamedBatchProcessorConfig_To_v1alpha1_NamedBatchProcessorConfig(a.(*config.NamedBatchProcessorConfig), b.(*NamedBatchProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedExporterConfig)(nil), (*config.NamedExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedExporterConfig_To_config_NamedExporterConfig(a.(*NamedExporterConfig), b.(*config.NamedExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamedExporterConfig)(nil), (*NamedExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamedExporterConfig_To_v1alpha1_NamedExporterConfig(a.(*config.NamedExporterConfig), b.(*NamedExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedMemoryLimiterProcessorConfig)(nil), (*config.NamedMemoryLimiterProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedMemoryLimiterProcessorConfig_To_config_NamedMemoryLimiterProcessorConfig(a.(*NamedMemoryLimiterProcessorConfig), b.(*config.NamedMemoryLimiterProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamedMemoryLimiterProcessorConfig)(nil), (*NamedMemoryLimiterProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamedMemoryLimiterProcessorConfig_To_v1alpha1_NamedMemoryLimiterProcessorConfig(a.(*config.NamedMemoryLimiterProcessorConfig), b.(*NamedMemoryLimiterProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedProcessorConfig)(nil), (*config.NamedProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedProcessorConfig_To_config_NamedProcessorConfig(a.(*NamedProcessorConfig), b.(*config.NamedProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamedProcessorConfig)(nil), (*NamedProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamedProcessorConfig_To_v1alpha1_NamedProcessorConfig(a.(*config.NamedProcessorConfig), b.(*NamedProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamedTransformProcessorConfig)(nil), (*config.NamedTransformProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamedTransformProcessorConfig_To_config_NamedTransformProcessorConfig(a.(*NamedTransformProcessorConfig), b.(*config.NamedTransformProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamedTransformProcessorConfig)(nil), (*NamedTransformProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamedTransformProcessorConfig_To_v1alpha1_NamedTransformProcessorConfig(a.(*config.NamedTransformProcessorConfig), b.(*NamedTransformProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OTLPGRPCExporterConfig)(nil), (*config.OTLPGRPCExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(a.(*OTLPGRPCExporterConfig), b.(*config.OTLPGRPCExporterConfig), scope)
 	}); err != nil {
@@ -101,6 +334,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OTLPReceiverAuthConfig)(nil), (*config.OTLPReceiverAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OTLPReceiverAuthConfig_To_config_OTLPReceiverAuthConfig(a.(*OTLPReceiverAuthConfig), b.(*config.OTLPReceiverAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OTLPReceiverAuthConfig)(nil), (*OTLPReceiverAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OTLPReceiverAuthConfig_To_v1alpha1_OTLPReceiverAuthConfig(a.(*config.OTLPReceiverAuthConfig), b.(*OTLPReceiverAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OTLPReceiverConfig)(nil), (*config.OTLPReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OTLPReceiverConfig_To_config_OTLPReceiverConfig(a.(*OTLPReceiverConfig), b.(*config.OTLPReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OTLPReceiverConfig)(nil), (*OTLPReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OTLPReceiverConfig_To_v1alpha1_OTLPReceiverConfig(a.(*config.OTLPReceiverConfig), b.(*OTLPReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PipelineDebugExporterConfig)(nil), (*config.PipelineDebugExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PipelineDebugExporterConfig_To_config_PipelineDebugExporterConfig(a.(*PipelineDebugExporterConfig), b.(*config.PipelineDebugExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PipelineDebugExporterConfig)(nil), (*PipelineDebugExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PipelineDebugExporterConfig_To_v1alpha1_PipelineDebugExporterConfig(a.(*config.PipelineDebugExporterConfig), b.(*PipelineDebugExporterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PortConfig)(nil), (*config.PortConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PortConfig_To_config_PortConfig(a.(*PortConfig), b.(*config.PortConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PortConfig)(nil), (*PortConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PortConfig_To_v1alpha1_PortConfig(a.(*config.PortConfig), b.(*PortConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusReceiverConfig)(nil), (*config.PrometheusReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrometheusReceiverConfig_To_config_PrometheusReceiverConfig(a.(*PrometheusReceiverConfig), b.(*config.PrometheusReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PrometheusReceiverConfig)(nil), (*PrometheusReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PrometheusReceiverConfig_To_v1alpha1_PrometheusReceiverConfig(a.(*config.PrometheusReceiverConfig), b.(*PrometheusReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceReference)(nil), (*config.ResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceReference_To_config_ResourceReference(a.(*ResourceReference), b.(*config.ResourceReference), scope)
 	}); err != nil {
@@ -131,6 +414,126 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScrapeAuthorizationConfig)(nil), (*config.ScrapeAuthorizationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScrapeAuthorizationConfig_To_config_ScrapeAuthorizationConfig(a.(*ScrapeAuthorizationConfig), b.(*config.ScrapeAuthorizationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScrapeAuthorizationConfig)(nil), (*ScrapeAuthorizationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScrapeAuthorizationConfig_To_v1alpha1_ScrapeAuthorizationConfig(a.(*config.ScrapeAuthorizationConfig), b.(*ScrapeAuthorizationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScrapeBasicAuthConfig)(nil), (*config.ScrapeBasicAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScrapeBasicAuthConfig_To_config_ScrapeBasicAuthConfig(a.(*ScrapeBasicAuthConfig), b.(*config.ScrapeBasicAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScrapeBasicAuthConfig)(nil), (*ScrapeBasicAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScrapeBasicAuthConfig_To_v1alpha1_ScrapeBasicAuthConfig(a.(*config.ScrapeBasicAuthConfig), b.(*ScrapeBasicAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScrapeConfig)(nil), (*config.ScrapeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScrapeConfig_To_config_ScrapeConfig(a.(*ScrapeConfig), b.(*config.ScrapeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScrapeConfig)(nil), (*ScrapeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScrapeConfig_To_v1alpha1_ScrapeConfig(a.(*config.ScrapeConfig), b.(*ScrapeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScrapeLimitsConfig)(nil), (*config.ScrapeLimitsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScrapeLimitsConfig_To_config_ScrapeLimitsConfig(a.(*ScrapeLimitsConfig), b.(*config.ScrapeLimitsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScrapeLimitsConfig)(nil), (*ScrapeLimitsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScrapeLimitsConfig_To_v1alpha1_ScrapeLimitsConfig(a.(*config.ScrapeLimitsConfig), b.(*ScrapeLimitsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityContextConfig)(nil), (*config.SecurityContextConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityContextConfig_To_config_SecurityContextConfig(a.(*SecurityContextConfig), b.(*config.SecurityContextConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SecurityContextConfig)(nil), (*SecurityContextConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SecurityContextConfig_To_v1alpha1_SecurityContextConfig(a.(*config.SecurityContextConfig), b.(*SecurityContextConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SendingQueueBatchConfig)(nil), (*config.SendingQueueBatchConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SendingQueueBatchConfig_To_config_SendingQueueBatchConfig(a.(*SendingQueueBatchConfig), b.(*config.SendingQueueBatchConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SendingQueueBatchConfig)(nil), (*SendingQueueBatchConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SendingQueueBatchConfig_To_v1alpha1_SendingQueueBatchConfig(a.(*config.SendingQueueBatchConfig), b.(*SendingQueueBatchConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SendingQueueConfig)(nil), (*config.SendingQueueConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(a.(*SendingQueueConfig), b.(*config.SendingQueueConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SendingQueueConfig)(nil), (*SendingQueueConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(a.(*config.SendingQueueConfig), b.(*SendingQueueConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceGraphConnectorConfig)(nil), (*config.ServiceGraphConnectorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceGraphConnectorConfig_To_config_ServiceGraphConnectorConfig(a.(*ServiceGraphConnectorConfig), b.(*config.ServiceGraphConnectorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ServiceGraphConnectorConfig)(nil), (*ServiceGraphConnectorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ServiceGraphConnectorConfig_To_v1alpha1_ServiceGraphConnectorConfig(a.(*config.ServiceGraphConnectorConfig), b.(*ServiceGraphConnectorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SpanMetricsConnectorConfig)(nil), (*config.SpanMetricsConnectorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SpanMetricsConnectorConfig_To_config_SpanMetricsConnectorConfig(a.(*SpanMetricsConnectorConfig), b.(*config.SpanMetricsConnectorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SpanMetricsConnectorConfig)(nil), (*SpanMetricsConnectorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SpanMetricsConnectorConfig_To_v1alpha1_SpanMetricsConnectorConfig(a.(*config.SpanMetricsConnectorConfig), b.(*SpanMetricsConnectorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SpanMetricsExemplarsConfig)(nil), (*config.SpanMetricsExemplarsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SpanMetricsExemplarsConfig_To_config_SpanMetricsExemplarsConfig(a.(*SpanMetricsExemplarsConfig), b.(*config.SpanMetricsExemplarsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SpanMetricsExemplarsConfig)(nil), (*SpanMetricsExemplarsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SpanMetricsExemplarsConfig_To_v1alpha1_SpanMetricsExemplarsConfig(a.(*config.SpanMetricsExemplarsConfig), b.(*SpanMetricsExemplarsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StartupProbeConfig)(nil), (*config.StartupProbeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StartupProbeConfig_To_config_StartupProbeConfig(a.(*StartupProbeConfig), b.(*config.StartupProbeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StartupProbeConfig)(nil), (*StartupProbeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StartupProbeConfig_To_v1alpha1_StartupProbeConfig(a.(*config.StartupProbeConfig), b.(*StartupProbeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StatsDReceiverConfig)(nil), (*config.StatsDReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StatsDReceiverConfig_To_config_StatsDReceiverConfig(a.(*StatsDReceiverConfig), b.(*config.StatsDReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StatsDReceiverConfig)(nil), (*StatsDReceiverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StatsDReceiverConfig_To_v1alpha1_StatsDReceiverConfig(a.(*config.StatsDReceiverConfig), b.(*StatsDReceiverConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TLSConfig)(nil), (*config.TLSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TLSConfig_To_config_TLSConfig(a.(*TLSConfig), b.(*config.TLSConfig), scope)
 	}); err != nil {
@@ -141,339 +544,1565 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	return nil
+	if err := s.AddGeneratedConversionFunc((*TailSamplingProcessorConfig)(nil), (*config.TailSamplingProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TailSamplingProcessorConfig_To_config_TailSamplingProcessorConfig(a.(*TailSamplingProcessorConfig), b.(*config.TailSamplingProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TailSamplingProcessorConfig)(nil), (*TailSamplingProcessorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TailSamplingProcessorConfig_To_v1alpha1_TailSamplingProcessorConfig(a.(*config.TailSamplingProcessorConfig), b.(*TailSamplingProcessorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetAllocatorConfig)(nil), (*config.TargetAllocatorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetAllocatorConfig_To_config_TargetAllocatorConfig(a.(*TargetAllocatorConfig), b.(*config.TargetAllocatorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetAllocatorConfig)(nil), (*TargetAllocatorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetAllocatorConfig_To_v1alpha1_TargetAllocatorConfig(a.(*config.TargetAllocatorConfig), b.(*TargetAllocatorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UpdateStrategyConfig)(nil), (*config.UpdateStrategyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UpdateStrategyConfig_To_config_UpdateStrategyConfig(a.(*UpdateStrategyConfig), b.(*config.UpdateStrategyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.UpdateStrategyConfig)(nil), (*UpdateStrategyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_UpdateStrategyConfig_To_v1alpha1_UpdateStrategyConfig(a.(*config.UpdateStrategyConfig), b.(*UpdateStrategyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeConfig)(nil), (*config.VolumeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeConfig_To_config_VolumeConfig(a.(*VolumeConfig), b.(*config.VolumeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.VolumeConfig)(nil), (*VolumeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_VolumeConfig_To_v1alpha1_VolumeConfig(a.(*config.VolumeConfig), b.(*VolumeConfig), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_BatchProcessorConfig_To_config_BatchProcessorConfig(in *BatchProcessorConfig, out *config.BatchProcessorConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.SendBatchMaxSize = in.SendBatchMaxSize
+	return nil
+}
+
+// Convert_v1alpha1_BatchProcessorConfig_To_config_BatchProcessorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BatchProcessorConfig_To_config_BatchProcessorConfig(in *BatchProcessorConfig, out *config.BatchProcessorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BatchProcessorConfig_To_config_BatchProcessorConfig(in, out, s)
+}
+
+func autoConvert_config_BatchProcessorConfig_To_v1alpha1_BatchProcessorConfig(in *config.BatchProcessorConfig, out *BatchProcessorConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.SendBatchMaxSize = in.SendBatchMaxSize
+	return nil
+}
+
+// Convert_config_BatchProcessorConfig_To_v1alpha1_BatchProcessorConfig is an autogenerated conversion function.
+func Convert_config_BatchProcessorConfig_To_v1alpha1_BatchProcessorConfig(in *config.BatchProcessorConfig, out *BatchProcessorConfig, s conversion.Scope) error {
+	return autoConvert_config_BatchProcessorConfig_To_v1alpha1_BatchProcessorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorConfig_To_config_CollectorConfig(in *CollectorConfig, out *config.CollectorConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CollectorConfig_To_config_CollectorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorConfig_To_config_CollectorConfig(in *CollectorConfig, out *config.CollectorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorConfig_To_config_CollectorConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorConfig_To_v1alpha1_CollectorConfig(in *config.CollectorConfig, out *CollectorConfig, s conversion.Scope) error {
+	if err := Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CollectorConfig_To_v1alpha1_CollectorConfig is an autogenerated conversion function.
+func Convert_config_CollectorConfig_To_v1alpha1_CollectorConfig(in *config.CollectorConfig, out *CollectorConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorConfig_To_v1alpha1_CollectorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(in *CollectorConfigSpec, out *config.CollectorConfigSpec, s conversion.Scope) error {
+	out.Mode = config.CollectorMode(in.Mode)
+	out.Distribution = config.CollectorDistribution(in.Distribution)
+	if err := Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(&in.Exporters, &out.Exporters, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CollectorReceiversConfig_To_config_CollectorReceiversConfig(&in.Receivers, &out.Receivers, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(&in.Pipelines, &out.Pipelines, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CollectorProcessorsConfig_To_config_CollectorProcessorsConfig(&in.Processors, &out.Processors, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CollectorConnectorsConfig_To_config_CollectorConnectorsConfig(&in.Connectors, &out.Connectors, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TargetAllocatorConfig_To_config_TargetAllocatorConfig(&in.TargetAllocator, &out.TargetAllocator, s); err != nil {
+		return err
+	}
+	out.PodAnnotations = *(*map[string]string)(unsafe.Pointer(&in.PodAnnotations))
+	out.ServiceAccountAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAccountAnnotations))
+	out.MetricNamePrefix = in.MetricNamePrefix
+	if err := Convert_v1alpha1_StartupProbeConfig_To_config_StartupProbeConfig(&in.StartupProbe, &out.StartupProbe, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecurityContextConfig_To_config_SecurityContextConfig(&in.SecurityContext, &out.SecurityContext, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_UpdateStrategyConfig_To_config_UpdateStrategyConfig(&in.UpdateStrategy, &out.UpdateStrategy, s); err != nil {
+		return err
+	}
+	out.ShutdownTimeout = time.Duration(in.ShutdownTimeout)
+	out.RolloutOnSecretRotation = (*bool)(unsafe.Pointer(in.RolloutOnSecretRotation))
+	if err := Convert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(&in.Metrics, &out.Metrics, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_DNSConfig_To_config_DNSConfig(&in.DNS, &out.DNS, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_GatewayConfig_To_config_GatewayConfig(&in.Gateway, &out.Gateway, s); err != nil {
+		return err
+	}
+	out.ConfigRef = (*config.ResourceReference)(unsafe.Pointer(in.ConfigRef))
+	out.Ports = *(*[]config.PortConfig)(unsafe.Pointer(&in.Ports))
+	out.Volumes = *(*[]config.VolumeConfig)(unsafe.Pointer(&in.Volumes))
+	return nil
+}
+
+// Convert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(in *CollectorConfigSpec, out *config.CollectorConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(in, out, s)
+}
+
+func autoConvert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in *config.CollectorConfigSpec, out *CollectorConfigSpec, s conversion.Scope) error {
+	out.Mode = CollectorMode(in.Mode)
+	out.Distribution = CollectorDistribution(in.Distribution)
+	if err := Convert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(&in.Exporters, &out.Exporters, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorReceiversConfig_To_v1alpha1_CollectorReceiversConfig(&in.Receivers, &out.Receivers, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(&in.Pipelines, &out.Pipelines, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorProcessorsConfig_To_v1alpha1_CollectorProcessorsConfig(&in.Processors, &out.Processors, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorConnectorsConfig_To_v1alpha1_CollectorConnectorsConfig(&in.Connectors, &out.Connectors, s); err != nil {
+		return err
+	}
+	if err := Convert_config_TargetAllocatorConfig_To_v1alpha1_TargetAllocatorConfig(&in.TargetAllocator, &out.TargetAllocator, s); err != nil {
+		return err
+	}
+	out.PodAnnotations = *(*map[string]string)(unsafe.Pointer(&in.PodAnnotations))
+	out.ServiceAccountAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAccountAnnotations))
+	out.MetricNamePrefix = in.MetricNamePrefix
+	if err := Convert_config_StartupProbeConfig_To_v1alpha1_StartupProbeConfig(&in.StartupProbe, &out.StartupProbe, s); err != nil {
+		return err
+	}
+	if err := Convert_config_SecurityContextConfig_To_v1alpha1_SecurityContextConfig(&in.SecurityContext, &out.SecurityContext, s); err != nil {
+		return err
+	}
+	if err := Convert_config_UpdateStrategyConfig_To_v1alpha1_UpdateStrategyConfig(&in.UpdateStrategy, &out.UpdateStrategy, s); err != nil {
+		return err
+	}
+	out.ShutdownTimeout = time.Duration(in.ShutdownTimeout)
+	out.RolloutOnSecretRotation = (*bool)(unsafe.Pointer(in.RolloutOnSecretRotation))
+	if err := Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(&in.Metrics, &out.Metrics, s); err != nil {
+		return err
+	}
+	if err := Convert_config_DNSConfig_To_v1alpha1_DNSConfig(&in.DNS, &out.DNS, s); err != nil {
+		return err
+	}
+	if err := Convert_config_GatewayConfig_To_v1alpha1_GatewayConfig(&in.Gateway, &out.Gateway, s); err != nil {
+		return err
+	}
+	out.ConfigRef = (*ResourceReference)(unsafe.Pointer(in.ConfigRef))
+	out.Ports = *(*[]PortConfig)(unsafe.Pointer(&in.Ports))
+	out.Volumes = *(*[]VolumeConfig)(unsafe.Pointer(&in.Volumes))
+	return nil
+}
+
+// Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec is an autogenerated conversion function.
+func Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in *config.CollectorConfigSpec, out *CollectorConfigSpec, s conversion.Scope) error {
+	return autoConvert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorConnectorsConfig_To_config_CollectorConnectorsConfig(in *CollectorConnectorsConfig, out *config.CollectorConnectorsConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ServiceGraphConnectorConfig_To_config_ServiceGraphConnectorConfig(&in.ServiceGraphConnector, &out.ServiceGraphConnector, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SpanMetricsConnectorConfig_To_config_SpanMetricsConnectorConfig(&in.SpanMetricsConnector, &out.SpanMetricsConnector, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CollectorConnectorsConfig_To_config_CollectorConnectorsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorConnectorsConfig_To_config_CollectorConnectorsConfig(in *CollectorConnectorsConfig, out *config.CollectorConnectorsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorConnectorsConfig_To_config_CollectorConnectorsConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorConnectorsConfig_To_v1alpha1_CollectorConnectorsConfig(in *config.CollectorConnectorsConfig, out *CollectorConnectorsConfig, s conversion.Scope) error {
+	if err := Convert_config_ServiceGraphConnectorConfig_To_v1alpha1_ServiceGraphConnectorConfig(&in.ServiceGraphConnector, &out.ServiceGraphConnector, s); err != nil {
+		return err
+	}
+	if err := Convert_config_SpanMetricsConnectorConfig_To_v1alpha1_SpanMetricsConnectorConfig(&in.SpanMetricsConnector, &out.SpanMetricsConnector, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CollectorConnectorsConfig_To_v1alpha1_CollectorConnectorsConfig is an autogenerated conversion function.
+func Convert_config_CollectorConnectorsConfig_To_v1alpha1_CollectorConnectorsConfig(in *config.CollectorConnectorsConfig, out *CollectorConnectorsConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorConnectorsConfig_To_v1alpha1_CollectorConnectorsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(in *CollectorExportersConfig, out *config.CollectorExportersConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_FileExporterConfig_To_config_FileExporterConfig(&in.FileExporter, &out.FileExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(&in.OTLPGRPCExporter, &out.OTLPGRPCExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(&in.OTLPHTTPExporter, &out.OTLPHTTPExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(&in.DebugExporter, &out.DebugExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_GoogleCloudExporterConfig_To_config_GoogleCloudExporterConfig(&in.GoogleCloudExporter, &out.GoogleCloudExporter, s); err != nil {
+		return err
+	}
+	out.Named = *(*[]config.NamedExporterConfig)(unsafe.Pointer(&in.Named))
+	return nil
+}
+
+// Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(in *CollectorExportersConfig, out *config.CollectorExportersConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(in *config.CollectorExportersConfig, out *CollectorExportersConfig, s conversion.Scope) error {
+	if err := Convert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(&in.OTLPGRPCExporter, &out.OTLPGRPCExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(&in.OTLPHTTPExporter, &out.OTLPHTTPExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(&in.DebugExporter, &out.DebugExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_config_FileExporterConfig_To_v1alpha1_FileExporterConfig(&in.FileExporter, &out.FileExporter, s); err != nil {
+		return err
+	}
+	if err := Convert_config_GoogleCloudExporterConfig_To_v1alpha1_GoogleCloudExporterConfig(&in.GoogleCloudExporter, &out.GoogleCloudExporter, s); err != nil {
+		return err
+	}
+	out.Named = *(*[]NamedExporterConfig)(unsafe.Pointer(&in.Named))
+	return nil
+}
+
+// Convert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig is an autogenerated conversion function.
+func Convert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(in *config.CollectorExportersConfig, out *CollectorExportersConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(in *CollectorLogsConfig, out *config.CollectorLogsConfig, s conversion.Scope) error {
+	out.Level = config.LogLevel(in.Level)
+	out.Encoding = config.LogEncoding(in.Encoding)
+	return nil
+}
+
+// Convert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(in *CollectorLogsConfig, out *config.CollectorLogsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in *config.CollectorLogsConfig, out *CollectorLogsConfig, s conversion.Scope) error {
+	out.Level = LogLevel(in.Level)
+	out.Encoding = LogEncoding(in.Encoding)
+	return nil
+}
+
+// Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig is an autogenerated conversion function.
+func Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in *config.CollectorLogsConfig, out *CollectorLogsConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
+	out.Level = config.MetricsVerbosityLevel(in.Level)
+	out.ResourceAttributes = (*bool)(unsafe.Pointer(in.ResourceAttributes))
+	if err := Convert_v1alpha1_MetricsPushConfig_To_config_MetricsPushConfig(&in.Push, &out.Push, s); err != nil {
+		return err
+	}
+	out.Naming = config.MetricsNaming(in.Naming)
+	if err := Convert_v1alpha1_MetricsPrometheusConfig_To_config_MetricsPrometheusConfig(&in.Prometheus, &out.Prometheus, s); err != nil {
+		return err
+	}
+	out.DisabledMetrics = *(*[]string)(unsafe.Pointer(&in.DisabledMetrics))
+	return nil
+}
+
+// Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
+	out.Level = MetricsVerbosityLevel(in.Level)
+	out.ResourceAttributes = (*bool)(unsafe.Pointer(in.ResourceAttributes))
+	if err := Convert_config_MetricsPushConfig_To_v1alpha1_MetricsPushConfig(&in.Push, &out.Push, s); err != nil {
+		return err
+	}
+	out.Naming = MetricsNaming(in.Naming)
+	if err := Convert_config_MetricsPrometheusConfig_To_v1alpha1_MetricsPrometheusConfig(&in.Prometheus, &out.Prometheus, s); err != nil {
+		return err
+	}
+	out.DisabledMetrics = *(*[]string)(unsafe.Pointer(&in.DisabledMetrics))
+	return nil
+}
+
+// Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig is an autogenerated conversion function.
+func Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(in *CollectorPipelinesConfig, out *config.CollectorPipelinesConfig, s conversion.Scope) error {
+	out.Forward = *(*[]config.ForwardPipelineConfig)(unsafe.Pointer(&in.Forward))
+	return nil
+}
+
+// Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(in *CollectorPipelinesConfig, out *config.CollectorPipelinesConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorPipelinesConfig_To_config_CollectorPipelinesConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(in *config.CollectorPipelinesConfig, out *CollectorPipelinesConfig, s conversion.Scope) error {
+	out.Forward = *(*[]ForwardPipelineConfig)(unsafe.Pointer(&in.Forward))
+	return nil
+}
+
+// Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig is an autogenerated conversion function.
+func Convert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(in *config.CollectorPipelinesConfig, out *CollectorPipelinesConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorPipelinesConfig_To_v1alpha1_CollectorPipelinesConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorProcessorsConfig_To_config_CollectorProcessorsConfig(in *CollectorProcessorsConfig, out *config.CollectorProcessorsConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_MetricsTransformProcessorConfig_To_config_MetricsTransformProcessorConfig(&in.MetricsTransform, &out.MetricsTransform, s); err != nil {
+		return err
+	}
+	out.ErrorMode = config.ErrorMode(in.ErrorMode)
+	if err := Convert_v1alpha1_BatchProcessorConfig_To_config_BatchProcessorConfig(&in.Batch, &out.Batch, s); err != nil {
+		return err
+	}
+	out.Named = *(*[]config.NamedProcessorConfig)(unsafe.Pointer(&in.Named))
+	if err := Convert_v1alpha1_TailSamplingProcessorConfig_To_config_TailSamplingProcessorConfig(&in.TailSampling, &out.TailSampling, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CollectorProcessorsConfig_To_config_CollectorProcessorsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorProcessorsConfig_To_config_CollectorProcessorsConfig(in *CollectorProcessorsConfig, out *config.CollectorProcessorsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorProcessorsConfig_To_config_CollectorProcessorsConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorProcessorsConfig_To_v1alpha1_CollectorProcessorsConfig(in *config.CollectorProcessorsConfig, out *CollectorProcessorsConfig, s conversion.Scope) error {
+	out.ErrorMode = ErrorMode(in.ErrorMode)
+	if err := Convert_config_MetricsTransformProcessorConfig_To_v1alpha1_MetricsTransformProcessorConfig(&in.MetricsTransform, &out.MetricsTransform, s); err != nil {
+		return err
+	}
+	if err := Convert_config_BatchProcessorConfig_To_v1alpha1_BatchProcessorConfig(&in.Batch, &out.Batch, s); err != nil {
+		return err
+	}
+	out.Named = *(*[]NamedProcessorConfig)(unsafe.Pointer(&in.Named))
+	if err := Convert_config_TailSamplingProcessorConfig_To_v1alpha1_TailSamplingProcessorConfig(&in.TailSampling, &out.TailSampling, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CollectorProcessorsConfig_To_v1alpha1_CollectorProcessorsConfig is an autogenerated conversion function.
+func Convert_config_CollectorProcessorsConfig_To_v1alpha1_CollectorProcessorsConfig(in *config.CollectorProcessorsConfig, out *CollectorProcessorsConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorProcessorsConfig_To_v1alpha1_CollectorProcessorsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CollectorReceiversConfig_To_config_CollectorReceiversConfig(in *CollectorReceiversConfig, out *config.CollectorReceiversConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_OTLPReceiverConfig_To_config_OTLPReceiverConfig(&in.OTLPReceiver, &out.OTLPReceiver, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PrometheusReceiverConfig_To_config_PrometheusReceiverConfig(&in.PrometheusReceiver, &out.PrometheusReceiver, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_StatsDReceiverConfig_To_config_StatsDReceiverConfig(&in.StatsDReceiver, &out.StatsDReceiver, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_HostMetricsReceiverConfig_To_config_HostMetricsReceiverConfig(&in.HostMetricsReceiver, &out.HostMetricsReceiver, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CollectorReceiversConfig_To_config_CollectorReceiversConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CollectorReceiversConfig_To_config_CollectorReceiversConfig(in *CollectorReceiversConfig, out *config.CollectorReceiversConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CollectorReceiversConfig_To_config_CollectorReceiversConfig(in, out, s)
+}
+
+func autoConvert_config_CollectorReceiversConfig_To_v1alpha1_CollectorReceiversConfig(in *config.CollectorReceiversConfig, out *CollectorReceiversConfig, s conversion.Scope) error {
+	if err := Convert_config_OTLPReceiverConfig_To_v1alpha1_OTLPReceiverConfig(&in.OTLPReceiver, &out.OTLPReceiver, s); err != nil {
+		return err
+	}
+	if err := Convert_config_PrometheusReceiverConfig_To_v1alpha1_PrometheusReceiverConfig(&in.PrometheusReceiver, &out.PrometheusReceiver, s); err != nil {
+		return err
+	}
+	if err := Convert_config_StatsDReceiverConfig_To_v1alpha1_StatsDReceiverConfig(&in.StatsDReceiver, &out.StatsDReceiver, s); err != nil {
+		return err
+	}
+	if err := Convert_config_HostMetricsReceiverConfig_To_v1alpha1_HostMetricsReceiverConfig(&in.HostMetricsReceiver, &out.HostMetricsReceiver, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CollectorReceiversConfig_To_v1alpha1_CollectorReceiversConfig is an autogenerated conversion function.
+func Convert_config_CollectorReceiversConfig_To_v1alpha1_CollectorReceiversConfig(in *config.CollectorReceiversConfig, out *CollectorReceiversConfig, s conversion.Scope) error {
+	return autoConvert_config_CollectorReceiversConfig_To_v1alpha1_CollectorReceiversConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CookiesConfig_To_config_CookiesConfig(in *CookiesConfig, out *config.CookiesConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1alpha1_CookiesConfig_To_config_CookiesConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CookiesConfig_To_config_CookiesConfig(in *CookiesConfig, out *config.CookiesConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CookiesConfig_To_config_CookiesConfig(in, out, s)
+}
+
+func autoConvert_config_CookiesConfig_To_v1alpha1_CookiesConfig(in *config.CookiesConfig, out *CookiesConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_config_CookiesConfig_To_v1alpha1_CookiesConfig is an autogenerated conversion function.
+func Convert_config_CookiesConfig_To_v1alpha1_CookiesConfig(in *config.CookiesConfig, out *CookiesConfig, s conversion.Scope) error {
+	return autoConvert_config_CookiesConfig_To_v1alpha1_CookiesConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSConfig_To_config_DNSConfig(in *DNSConfig, out *config.DNSConfig, s conversion.Scope) error {
+	out.Policy = v1.DNSPolicy(in.Policy)
+	out.Config = (*v1.PodDNSConfig)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_v1alpha1_DNSConfig_To_config_DNSConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSConfig_To_config_DNSConfig(in *DNSConfig, out *config.DNSConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSConfig_To_config_DNSConfig(in, out, s)
+}
+
+func autoConvert_config_DNSConfig_To_v1alpha1_DNSConfig(in *config.DNSConfig, out *DNSConfig, s conversion.Scope) error {
+	out.Policy = v1.DNSPolicy(in.Policy)
+	out.Config = (*v1.PodDNSConfig)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_config_DNSConfig_To_v1alpha1_DNSConfig is an autogenerated conversion function.
+func Convert_config_DNSConfig_To_v1alpha1_DNSConfig(in *config.DNSConfig, out *DNSConfig, s conversion.Scope) error {
+	return autoConvert_config_DNSConfig_To_v1alpha1_DNSConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Verbosity = config.DebugExporterVerbosity(in.Verbosity)
+	out.Pipelines = *(*[]config.PipelineDebugExporterConfig)(unsafe.Pointer(&in.Pipelines))
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in, out, s)
+}
+
+func autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Verbosity = DebugExporterVerbosity(in.Verbosity)
+	out.Pipelines = *(*[]PipelineDebugExporterConfig)(unsafe.Pointer(&in.Pipelines))
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig is an autogenerated conversion function.
+func Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FileExporterConfig_To_config_FileExporterConfig(in *FileExporterConfig, out *config.FileExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Path = in.Path
+	out.Format = config.FileExporterFormat(in.Format)
+	if err := Convert_v1alpha1_FileExporterRotationConfig_To_config_FileExporterRotationConfig(&in.Rotation, &out.Rotation, s); err != nil {
+		return err
+	}
+	out.Fallback = (*bool)(unsafe.Pointer(in.Fallback))
+	return nil
+}
+
+// Convert_v1alpha1_FileExporterConfig_To_config_FileExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FileExporterConfig_To_config_FileExporterConfig(in *FileExporterConfig, out *config.FileExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FileExporterConfig_To_config_FileExporterConfig(in, out, s)
+}
+
+func autoConvert_config_FileExporterConfig_To_v1alpha1_FileExporterConfig(in *config.FileExporterConfig, out *FileExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Path = in.Path
+	out.Format = FileExporterFormat(in.Format)
+	if err := Convert_config_FileExporterRotationConfig_To_v1alpha1_FileExporterRotationConfig(&in.Rotation, &out.Rotation, s); err != nil {
+		return err
+	}
+	out.Fallback = (*bool)(unsafe.Pointer(in.Fallback))
+	return nil
+}
+
+// Convert_config_FileExporterConfig_To_v1alpha1_FileExporterConfig is an autogenerated conversion function.
+func Convert_config_FileExporterConfig_To_v1alpha1_FileExporterConfig(in *config.FileExporterConfig, out *FileExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_FileExporterConfig_To_v1alpha1_FileExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FileExporterRotationConfig_To_config_FileExporterRotationConfig(in *FileExporterRotationConfig, out *config.FileExporterRotationConfig, s conversion.Scope) error {
+	out.MaxMegabytes = in.MaxMegabytes
+	out.MaxDays = in.MaxDays
+	out.MaxBackups = in.MaxBackups
+	out.LocalTime = (*bool)(unsafe.Pointer(in.LocalTime))
+	return nil
+}
+
+// Convert_v1alpha1_FileExporterRotationConfig_To_config_FileExporterRotationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FileExporterRotationConfig_To_config_FileExporterRotationConfig(in *FileExporterRotationConfig, out *config.FileExporterRotationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FileExporterRotationConfig_To_config_FileExporterRotationConfig(in, out, s)
+}
+
+func autoConvert_config_FileExporterRotationConfig_To_v1alpha1_FileExporterRotationConfig(in *config.FileExporterRotationConfig, out *FileExporterRotationConfig, s conversion.Scope) error {
+	out.MaxMegabytes = in.MaxMegabytes
+	out.MaxDays = in.MaxDays
+	out.MaxBackups = in.MaxBackups
+	out.LocalTime = (*bool)(unsafe.Pointer(in.LocalTime))
+	return nil
+}
+
+// Convert_config_FileExporterRotationConfig_To_v1alpha1_FileExporterRotationConfig is an autogenerated conversion function.
+func Convert_config_FileExporterRotationConfig_To_v1alpha1_FileExporterRotationConfig(in *config.FileExporterRotationConfig, out *FileExporterRotationConfig, s conversion.Scope) error {
+	return autoConvert_config_FileExporterRotationConfig_To_v1alpha1_FileExporterRotationConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ForwardPipelineConfig_To_config_ForwardPipelineConfig(in *ForwardPipelineConfig, out *config.ForwardPipelineConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.From = *(*[]string)(unsafe.Pointer(&in.From))
+	out.Processors = *(*[]string)(unsafe.Pointer(&in.Processors))
+	out.Exporters = *(*[]string)(unsafe.Pointer(&in.Exporters))
+	return nil
+}
+
+// Convert_v1alpha1_ForwardPipelineConfig_To_config_ForwardPipelineConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ForwardPipelineConfig_To_config_ForwardPipelineConfig(in *ForwardPipelineConfig, out *config.ForwardPipelineConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ForwardPipelineConfig_To_config_ForwardPipelineConfig(in, out, s)
+}
+
+func autoConvert_config_ForwardPipelineConfig_To_v1alpha1_ForwardPipelineConfig(in *config.ForwardPipelineConfig, out *ForwardPipelineConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.From = *(*[]string)(unsafe.Pointer(&in.From))
+	out.Processors = *(*[]string)(unsafe.Pointer(&in.Processors))
+	out.Exporters = *(*[]string)(unsafe.Pointer(&in.Exporters))
+	return nil
+}
+
+// Convert_config_ForwardPipelineConfig_To_v1alpha1_ForwardPipelineConfig is an autogenerated conversion function.
+func Convert_config_ForwardPipelineConfig_To_v1alpha1_ForwardPipelineConfig(in *config.ForwardPipelineConfig, out *ForwardPipelineConfig, s conversion.Scope) error {
+	return autoConvert_config_ForwardPipelineConfig_To_v1alpha1_ForwardPipelineConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_GatewayConfig_To_config_GatewayConfig(in *GatewayConfig, out *config.GatewayConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_v1alpha1_GatewayConfig_To_config_GatewayConfig is an autogenerated conversion function.
+func Convert_v1alpha1_GatewayConfig_To_config_GatewayConfig(in *GatewayConfig, out *config.GatewayConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GatewayConfig_To_config_GatewayConfig(in, out, s)
+}
+
+func autoConvert_config_GatewayConfig_To_v1alpha1_GatewayConfig(in *config.GatewayConfig, out *GatewayConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_config_GatewayConfig_To_v1alpha1_GatewayConfig is an autogenerated conversion function.
+func Convert_config_GatewayConfig_To_v1alpha1_GatewayConfig(in *config.GatewayConfig, out *GatewayConfig, s conversion.Scope) error {
+	return autoConvert_config_GatewayConfig_To_v1alpha1_GatewayConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_GoogleCloudExporterConfig_To_config_GoogleCloudExporterConfig(in *GoogleCloudExporterConfig, out *config.GoogleCloudExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Project = in.Project
+	out.ServiceAccount = in.ServiceAccount
+	return nil
+}
+
+// Convert_v1alpha1_GoogleCloudExporterConfig_To_config_GoogleCloudExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_GoogleCloudExporterConfig_To_config_GoogleCloudExporterConfig(in *GoogleCloudExporterConfig, out *config.GoogleCloudExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GoogleCloudExporterConfig_To_config_GoogleCloudExporterConfig(in, out, s)
+}
+
+func autoConvert_config_GoogleCloudExporterConfig_To_v1alpha1_GoogleCloudExporterConfig(in *config.GoogleCloudExporterConfig, out *GoogleCloudExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Project = in.Project
+	out.ServiceAccount = in.ServiceAccount
+	return nil
+}
+
+// Convert_config_GoogleCloudExporterConfig_To_v1alpha1_GoogleCloudExporterConfig is an autogenerated conversion function.
+func Convert_config_GoogleCloudExporterConfig_To_v1alpha1_GoogleCloudExporterConfig(in *config.GoogleCloudExporterConfig, out *GoogleCloudExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_GoogleCloudExporterConfig_To_v1alpha1_GoogleCloudExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_HostMetricsReceiverConfig_To_config_HostMetricsReceiverConfig(in *HostMetricsReceiverConfig, out *config.HostMetricsReceiverConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Scrapers = *(*[]string)(unsafe.Pointer(&in.Scrapers))
+	out.CollectionInterval = time.Duration(in.CollectionInterval)
+	return nil
+}
+
+// Convert_v1alpha1_HostMetricsReceiverConfig_To_config_HostMetricsReceiverConfig is an autogenerated conversion function.
+func Convert_v1alpha1_HostMetricsReceiverConfig_To_config_HostMetricsReceiverConfig(in *HostMetricsReceiverConfig, out *config.HostMetricsReceiverConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostMetricsReceiverConfig_To_config_HostMetricsReceiverConfig(in, out, s)
+}
+
+func autoConvert_config_HostMetricsReceiverConfig_To_v1alpha1_HostMetricsReceiverConfig(in *config.HostMetricsReceiverConfig, out *HostMetricsReceiverConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Scrapers = *(*[]string)(unsafe.Pointer(&in.Scrapers))
+	out.CollectionInterval = time.Duration(in.CollectionInterval)
+	return nil
+}
+
+// Convert_config_HostMetricsReceiverConfig_To_v1alpha1_HostMetricsReceiverConfig is an autogenerated conversion function.
+func Convert_config_HostMetricsReceiverConfig_To_v1alpha1_HostMetricsReceiverConfig(in *config.HostMetricsReceiverConfig, out *HostMetricsReceiverConfig, s conversion.Scope) error {
+	return autoConvert_config_HostMetricsReceiverConfig_To_v1alpha1_HostMetricsReceiverConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricsPrometheusConfig_To_config_MetricsPrometheusConfig(in *MetricsPrometheusConfig, out *config.MetricsPrometheusConfig, s conversion.Scope) error {
+	out.WithoutUnits = (*bool)(unsafe.Pointer(in.WithoutUnits))
+	out.WithoutTypeSuffix = (*bool)(unsafe.Pointer(in.WithoutTypeSuffix))
+	return nil
+}
+
+// Convert_v1alpha1_MetricsPrometheusConfig_To_config_MetricsPrometheusConfig is an autogenerated conversion function.
+func Convert_v1alpha1_MetricsPrometheusConfig_To_config_MetricsPrometheusConfig(in *MetricsPrometheusConfig, out *config.MetricsPrometheusConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricsPrometheusConfig_To_config_MetricsPrometheusConfig(in, out, s)
+}
+
+func autoConvert_config_MetricsPrometheusConfig_To_v1alpha1_MetricsPrometheusConfig(in *config.MetricsPrometheusConfig, out *MetricsPrometheusConfig, s conversion.Scope) error {
+	out.WithoutUnits = (*bool)(unsafe.Pointer(in.WithoutUnits))
+	out.WithoutTypeSuffix = (*bool)(unsafe.Pointer(in.WithoutTypeSuffix))
+	return nil
+}
+
+// Convert_config_MetricsPrometheusConfig_To_v1alpha1_MetricsPrometheusConfig is an autogenerated conversion function.
+func Convert_config_MetricsPrometheusConfig_To_v1alpha1_MetricsPrometheusConfig(in *config.MetricsPrometheusConfig, out *MetricsPrometheusConfig, s conversion.Scope) error {
+	return autoConvert_config_MetricsPrometheusConfig_To_v1alpha1_MetricsPrometheusConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricsPushConfig_To_config_MetricsPushConfig(in *MetricsPushConfig, out *config.MetricsPushConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.Protocol = config.MetricsPushProtocol(in.Protocol)
+	out.Interval = time.Duration(in.Interval)
+	return nil
+}
+
+// Convert_v1alpha1_MetricsPushConfig_To_config_MetricsPushConfig is an autogenerated conversion function.
+func Convert_v1alpha1_MetricsPushConfig_To_config_MetricsPushConfig(in *MetricsPushConfig, out *config.MetricsPushConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricsPushConfig_To_config_MetricsPushConfig(in, out, s)
+}
+
+func autoConvert_config_MetricsPushConfig_To_v1alpha1_MetricsPushConfig(in *config.MetricsPushConfig, out *MetricsPushConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.Protocol = MetricsPushProtocol(in.Protocol)
+	out.Interval = time.Duration(in.Interval)
+	return nil
+}
+
+// Convert_config_MetricsPushConfig_To_v1alpha1_MetricsPushConfig is an autogenerated conversion function.
+func Convert_config_MetricsPushConfig_To_v1alpha1_MetricsPushConfig(in *config.MetricsPushConfig, out *MetricsPushConfig, s conversion.Scope) error {
+	return autoConvert_config_MetricsPushConfig_To_v1alpha1_MetricsPushConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricsTransformConfig_To_config_MetricsTransformConfig(in *MetricsTransformConfig, out *config.MetricsTransformConfig, s conversion.Scope) error {
+	out.Include = in.Include
+	out.MatchType = in.MatchType
+	out.Action = in.Action
+	out.NewName = in.NewName
+	out.Operations = *(*[]config.MetricsTransformOperationConfig)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_v1alpha1_MetricsTransformConfig_To_config_MetricsTransformConfig is an autogenerated conversion function.
+func Convert_v1alpha1_MetricsTransformConfig_To_config_MetricsTransformConfig(in *MetricsTransformConfig, out *config.MetricsTransformConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricsTransformConfig_To_config_MetricsTransformConfig(in, out, s)
+}
+
+func autoConvert_config_MetricsTransformConfig_To_v1alpha1_MetricsTransformConfig(in *config.MetricsTransformConfig, out *MetricsTransformConfig, s conversion.Scope) error {
+	out.Include = in.Include
+	out.MatchType = in.MatchType
+	out.Action = in.Action
+	out.NewName = in.NewName
+	out.Operations = *(*[]MetricsTransformOperationConfig)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_config_MetricsTransformConfig_To_v1alpha1_MetricsTransformConfig is an autogenerated conversion function.
+func Convert_config_MetricsTransformConfig_To_v1alpha1_MetricsTransformConfig(in *config.MetricsTransformConfig, out *MetricsTransformConfig, s conversion.Scope) error {
+	return autoConvert_config_MetricsTransformConfig_To_v1alpha1_MetricsTransformConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricsTransformOperationConfig_To_config_MetricsTransformOperationConfig(in *MetricsTransformOperationConfig, out *config.MetricsTransformOperationConfig, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Label = in.Label
+	out.NewLabel = in.NewLabel
+	out.LabelValue = in.LabelValue
+	out.NewValue = in.NewValue
+	out.LabelSet = *(*[]string)(unsafe.Pointer(&in.LabelSet))
+	out.AggregatedValues = *(*[]string)(unsafe.Pointer(&in.AggregatedValues))
+	out.AggregationType = in.AggregationType
+	return nil
+}
+
+// Convert_v1alpha1_MetricsTransformOperationConfig_To_config_MetricsTransformOperationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_MetricsTransformOperationConfig_To_config_MetricsTransformOperationConfig(in *MetricsTransformOperationConfig, out *config.MetricsTransformOperationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricsTransformOperationConfig_To_config_MetricsTransformOperationConfig(in, out, s)
+}
+
+func autoConvert_config_MetricsTransformOperationConfig_To_v1alpha1_MetricsTransformOperationConfig(in *config.MetricsTransformOperationConfig, out *MetricsTransformOperationConfig, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Label = in.Label
+	out.NewLabel = in.NewLabel
+	out.LabelValue = in.LabelValue
+	out.NewValue = in.NewValue
+	out.LabelSet = *(*[]string)(unsafe.Pointer(&in.LabelSet))
+	out.AggregatedValues = *(*[]string)(unsafe.Pointer(&in.AggregatedValues))
+	out.AggregationType = in.AggregationType
+	return nil
+}
+
+// Convert_config_MetricsTransformOperationConfig_To_v1alpha1_MetricsTransformOperationConfig is an autogenerated conversion function.
+func Convert_config_MetricsTransformOperationConfig_To_v1alpha1_MetricsTransformOperationConfig(in *config.MetricsTransformOperationConfig, out *MetricsTransformOperationConfig, s conversion.Scope) error {
+	return autoConvert_config_MetricsTransformOperationConfig_To_v1alpha1_MetricsTransformOperationConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricsTransformProcessorConfig_To_config_MetricsTransformProcessorConfig(in *MetricsTransformProcessorConfig, out *config.MetricsTransformProcessorConfig, s conversion.Scope) error {
+	out.Transforms = *(*[]config.MetricsTransformConfig)(unsafe.Pointer(&in.Transforms))
+	return nil
+}
+
+// Convert_v1alpha1_MetricsTransformProcessorConfig_To_config_MetricsTransformProcessorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_MetricsTransformProcessorConfig_To_config_MetricsTransformProcessorConfig(in *MetricsTransformProcessorConfig, out *config.MetricsTransformProcessorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricsTransformProcessorConfig_To_config_MetricsTransformProcessorConfig(in, out, s)
+}
+
+func autoConvert_config_MetricsTransformProcessorConfig_To_v1alpha1_MetricsTransformProcessorConfig(in *config.MetricsTransformProcessorConfig, out *MetricsTransformProcessorConfig, s conversion.Scope) error {
+	out.Transforms = *(*[]MetricsTransformConfig)(unsafe.Pointer(&in.Transforms))
+	return nil
+}
+
+// Convert_config_MetricsTransformProcessorConfig_To_v1alpha1_MetricsTransformProcessorConfig is an autogenerated conversion function.
+func Convert_config_MetricsTransformProcessorConfig_To_v1alpha1_MetricsTransformProcessorConfig(in *config.MetricsTransformProcessorConfig, out *MetricsTransformProcessorConfig, s conversion.Scope) error {
+	return autoConvert_config_MetricsTransformProcessorConfig_To_v1alpha1_MetricsTransformProcessorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedBatchProcessorConfig_To_config_NamedBatchProcessorConfig(in *NamedBatchProcessorConfig, out *config.NamedBatchProcessorConfig, s conversion.Scope) error {
+	out.Timeout = time.Duration(in.Timeout)
+	out.SendBatchSize = in.SendBatchSize
+	out.SendBatchMaxSize = in.SendBatchMaxSize
+	return nil
+}
+
+// Convert_v1alpha1_NamedBatchProcessorConfig_To_config_NamedBatchProcessorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamedBatchProcessorConfig_To_config_NamedBatchProcessorConfig(in *NamedBatchProcessorConfig, out *config.NamedBatchProcessorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedBatchProcessorConfig_To_config_NamedBatchProcessorConfig(in, out, s)
+}
+
+func autoConvert_config_NamedBatchProcessorConfig_To_v1alpha1_NamedBatchProcessorConfig(in *config.NamedBatchProcessorConfig, out *NamedBatchProcessorConfig, s conversion.Scope) error {
+	out.Timeout = time.Duration(in.Timeout)
+	out.SendBatchSize = in.SendBatchSize
+	out.SendBatchMaxSize = in.SendBatchMaxSize
+	return nil
+}
+
+// Convert_config_NamedBatchProcessorConfig_To_v1alpha1_NamedBatchProcessorConfig is an autogenerated conversion function.
+func Convert_config_NamedBatchProcessorConfig_To_v1alpha1_NamedBatchProcessorConfig(in *config.NamedBatchProcessorConfig, out *NamedBatchProcessorConfig, s conversion.Scope) error {
+	return autoConvert_config_NamedBatchProcessorConfig_To_v1alpha1_NamedBatchProcessorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedExporterConfig_To_config_NamedExporterConfig(in *NamedExporterConfig, out *config.NamedExporterConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.OTLPGRPCExporter = (*config.OTLPGRPCExporterConfig)(unsafe.Pointer(in.OTLPGRPCExporter))
+	out.OTLPHTTPExporter = (*config.OTLPHTTPExporterConfig)(unsafe.Pointer(in.OTLPHTTPExporter))
+	out.DebugExporter = (*config.DebugExporterConfig)(unsafe.Pointer(in.DebugExporter))
+	return nil
+}
+
+// Convert_v1alpha1_NamedExporterConfig_To_config_NamedExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamedExporterConfig_To_config_NamedExporterConfig(in *NamedExporterConfig, out *config.NamedExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedExporterConfig_To_config_NamedExporterConfig(in, out, s)
+}
+
+func autoConvert_config_NamedExporterConfig_To_v1alpha1_NamedExporterConfig(in *config.NamedExporterConfig, out *NamedExporterConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.OTLPGRPCExporter = (*OTLPGRPCExporterConfig)(unsafe.Pointer(in.OTLPGRPCExporter))
+	out.OTLPHTTPExporter = (*OTLPHTTPExporterConfig)(unsafe.Pointer(in.OTLPHTTPExporter))
+	out.DebugExporter = (*DebugExporterConfig)(unsafe.Pointer(in.DebugExporter))
+	return nil
+}
+
+// Convert_config_NamedExporterConfig_To_v1alpha1_NamedExporterConfig is an autogenerated conversion function.
+func Convert_config_NamedExporterConfig_To_v1alpha1_NamedExporterConfig(in *config.NamedExporterConfig, out *NamedExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_NamedExporterConfig_To_v1alpha1_NamedExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedMemoryLimiterProcessorConfig_To_config_NamedMemoryLimiterProcessorConfig(in *NamedMemoryLimiterProcessorConfig, out *config.NamedMemoryLimiterProcessorConfig, s conversion.Scope) error {
+	out.CheckInterval = time.Duration(in.CheckInterval)
+	out.LimitPercentage = in.LimitPercentage
+	out.SpikeLimitPercentage = in.SpikeLimitPercentage
+	return nil
+}
+
+// Convert_v1alpha1_NamedMemoryLimiterProcessorConfig_To_config_NamedMemoryLimiterProcessorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamedMemoryLimiterProcessorConfig_To_config_NamedMemoryLimiterProcessorConfig(in *NamedMemoryLimiterProcessorConfig, out *config.NamedMemoryLimiterProcessorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedMemoryLimiterProcessorConfig_To_config_NamedMemoryLimiterProcessorConfig(in, out, s)
+}
+
+func autoConvert_config_NamedMemoryLimiterProcessorConfig_To_v1alpha1_NamedMemoryLimiterProcessorConfig(in *config.NamedMemoryLimiterProcessorConfig, out *NamedMemoryLimiterProcessorConfig, s conversion.Scope) error {
+	out.CheckInterval = time.Duration(in.CheckInterval)
+	out.LimitPercentage = in.LimitPercentage
+	out.SpikeLimitPercentage = in.SpikeLimitPercentage
+	return nil
+}
+
+// Convert_config_NamedMemoryLimiterProcessorConfig_To_v1alpha1_NamedMemoryLimiterProcessorConfig is an autogenerated conversion function.
+func Convert_config_NamedMemoryLimiterProcessorConfig_To_v1alpha1_NamedMemoryLimiterProcessorConfig(in *config.NamedMemoryLimiterProcessorConfig, out *NamedMemoryLimiterProcessorConfig, s conversion.Scope) error {
+	return autoConvert_config_NamedMemoryLimiterProcessorConfig_To_v1alpha1_NamedMemoryLimiterProcessorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedProcessorConfig_To_config_NamedProcessorConfig(in *NamedProcessorConfig, out *config.NamedProcessorConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Batch = (*config.NamedBatchProcessorConfig)(unsafe.Pointer(in.Batch))
+	out.MemoryLimiter = (*config.NamedMemoryLimiterProcessorConfig)(unsafe.Pointer(in.MemoryLimiter))
+	out.Transform = (*config.NamedTransformProcessorConfig)(unsafe.Pointer(in.Transform))
+	return nil
+}
+
+// Convert_v1alpha1_NamedProcessorConfig_To_config_NamedProcessorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamedProcessorConfig_To_config_NamedProcessorConfig(in *NamedProcessorConfig, out *config.NamedProcessorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedProcessorConfig_To_config_NamedProcessorConfig(in, out, s)
+}
+
+func autoConvert_config_NamedProcessorConfig_To_v1alpha1_NamedProcessorConfig(in *config.NamedProcessorConfig, out *NamedProcessorConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Batch = (*NamedBatchProcessorConfig)(unsafe.Pointer(in.Batch))
+	out.MemoryLimiter = (*NamedMemoryLimiterProcessorConfig)(unsafe.Pointer(in.MemoryLimiter))
+	out.Transform = (*NamedTransformProcessorConfig)(unsafe.Pointer(in.Transform))
+	return nil
+}
+
+// Convert_config_NamedProcessorConfig_To_v1alpha1_NamedProcessorConfig is an autogenerated conversion function.
+func Convert_config_NamedProcessorConfig_To_v1alpha1_NamedProcessorConfig(in *config.NamedProcessorConfig, out *NamedProcessorConfig, s conversion.Scope) error {
+	return autoConvert_config_NamedProcessorConfig_To_v1alpha1_NamedProcessorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamedTransformProcessorConfig_To_config_NamedTransformProcessorConfig(in *NamedTransformProcessorConfig, out *config.NamedTransformProcessorConfig, s conversion.Scope) error {
+	out.MetricStatements = *(*[]string)(unsafe.Pointer(&in.MetricStatements))
+	out.LogStatements = *(*[]string)(unsafe.Pointer(&in.LogStatements))
+	return nil
+}
+
+// Convert_v1alpha1_NamedTransformProcessorConfig_To_config_NamedTransformProcessorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamedTransformProcessorConfig_To_config_NamedTransformProcessorConfig(in *NamedTransformProcessorConfig, out *config.NamedTransformProcessorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamedTransformProcessorConfig_To_config_NamedTransformProcessorConfig(in, out, s)
+}
+
+func autoConvert_config_NamedTransformProcessorConfig_To_v1alpha1_NamedTransformProcessorConfig(in *config.NamedTransformProcessorConfig, out *NamedTransformProcessorConfig, s conversion.Scope) error {
+	out.MetricStatements = *(*[]string)(unsafe.Pointer(&in.MetricStatements))
+	out.LogStatements = *(*[]string)(unsafe.Pointer(&in.LogStatements))
+	return nil
+}
+
+// Convert_config_NamedTransformProcessorConfig_To_v1alpha1_NamedTransformProcessorConfig is an autogenerated conversion function.
+func Convert_config_NamedTransformProcessorConfig_To_v1alpha1_NamedTransformProcessorConfig(in *config.NamedTransformProcessorConfig, out *NamedTransformProcessorConfig, s conversion.Scope) error {
+	return autoConvert_config_NamedTransformProcessorConfig_To_v1alpha1_NamedTransformProcessorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(in *OTLPGRPCExporterConfig, out *config.OTLPGRPCExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.TLS = (*config.TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Token = (*config.ResourceReference)(unsafe.Pointer(in.Token))
+	out.Timeout = time.Duration(in.Timeout)
+	out.ReadBufferSize = in.ReadBufferSize
+	out.WriteBufferSize = in.WriteBufferSize
+	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
+	out.Compression = config.Compression(in.Compression)
+	return nil
+}
+
+// Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(in *OTLPGRPCExporterConfig, out *config.OTLPGRPCExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(in, out, s)
+}
+
+func autoConvert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(in *config.OTLPGRPCExporterConfig, out *OTLPGRPCExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.TLS = (*TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Token = (*ResourceReference)(unsafe.Pointer(in.Token))
+	out.Timeout = time.Duration(in.Timeout)
+	out.ReadBufferSize = in.ReadBufferSize
+	out.WriteBufferSize = in.WriteBufferSize
+	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	if err := Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
+	out.Compression = Compression(in.Compression)
+	return nil
+}
+
+// Convert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig is an autogenerated conversion function.
+func Convert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(in *config.OTLPGRPCExporterConfig, out *OTLPGRPCExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(in *OTLPHTTPExporterConfig, out *config.OTLPHTTPExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.TracesEndpoint = in.TracesEndpoint
+	out.MetricsEndpoint = in.MetricsEndpoint
+	out.LogsEndpoint = in.LogsEndpoint
+	out.ProfilesEndpoint = in.ProfilesEndpoint
+	out.TLS = (*config.TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Token = (*config.ResourceReference)(unsafe.Pointer(in.Token))
+	out.Timeout = time.Duration(in.Timeout)
+	out.ReadBufferSize = in.ReadBufferSize
+	out.WriteBufferSize = in.WriteBufferSize
+	out.Encoding = config.MessageEncoding(in.Encoding)
+	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
+	out.Compression = config.Compression(in.Compression)
+	if err := Convert_v1alpha1_CookiesConfig_To_config_CookiesConfig(&in.Cookies, &out.Cookies, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(in *OTLPHTTPExporterConfig, out *config.OTLPHTTPExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(in, out, s)
+}
+
+func autoConvert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in *config.OTLPHTTPExporterConfig, out *OTLPHTTPExporterConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Endpoint = in.Endpoint
+	out.TracesEndpoint = in.TracesEndpoint
+	out.MetricsEndpoint = in.MetricsEndpoint
+	out.LogsEndpoint = in.LogsEndpoint
+	out.ProfilesEndpoint = in.ProfilesEndpoint
+	out.TLS = (*TLSConfig)(unsafe.Pointer(in.TLS))
+	out.Token = (*ResourceReference)(unsafe.Pointer(in.Token))
+	out.Timeout = time.Duration(in.Timeout)
+	out.ReadBufferSize = in.ReadBufferSize
+	out.WriteBufferSize = in.WriteBufferSize
+	out.Encoding = MessageEncoding(in.Encoding)
+	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+		return err
+	}
+	if err := Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(&in.SendingQueue, &out.SendingQueue, s); err != nil {
+		return err
+	}
+	out.Compression = Compression(in.Compression)
+	if err := Convert_config_CookiesConfig_To_v1alpha1_CookiesConfig(&in.Cookies, &out.Cookies, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig is an autogenerated conversion function.
+func Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in *config.OTLPHTTPExporterConfig, out *OTLPHTTPExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OTLPReceiverAuthConfig_To_config_OTLPReceiverAuthConfig(in *OTLPReceiverAuthConfig, out *config.OTLPReceiverAuthConfig, s conversion.Scope) error {
+	out.Token = (*config.ResourceReference)(unsafe.Pointer(in.Token))
+	out.Htpasswd = (*config.ResourceReference)(unsafe.Pointer(in.Htpasswd))
+	return nil
+}
+
+// Convert_v1alpha1_OTLPReceiverAuthConfig_To_config_OTLPReceiverAuthConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OTLPReceiverAuthConfig_To_config_OTLPReceiverAuthConfig(in *OTLPReceiverAuthConfig, out *config.OTLPReceiverAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OTLPReceiverAuthConfig_To_config_OTLPReceiverAuthConfig(in, out, s)
+}
+
+func autoConvert_config_OTLPReceiverAuthConfig_To_v1alpha1_OTLPReceiverAuthConfig(in *config.OTLPReceiverAuthConfig, out *OTLPReceiverAuthConfig, s conversion.Scope) error {
+	out.Token = (*ResourceReference)(unsafe.Pointer(in.Token))
+	out.Htpasswd = (*ResourceReference)(unsafe.Pointer(in.Htpasswd))
+	return nil
+}
+
+// Convert_config_OTLPReceiverAuthConfig_To_v1alpha1_OTLPReceiverAuthConfig is an autogenerated conversion function.
+func Convert_config_OTLPReceiverAuthConfig_To_v1alpha1_OTLPReceiverAuthConfig(in *config.OTLPReceiverAuthConfig, out *OTLPReceiverAuthConfig, s conversion.Scope) error {
+	return autoConvert_config_OTLPReceiverAuthConfig_To_v1alpha1_OTLPReceiverAuthConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OTLPReceiverConfig_To_config_OTLPReceiverConfig(in *OTLPReceiverConfig, out *config.OTLPReceiverConfig, s conversion.Scope) error {
+	out.IncludeMetadata = (*bool)(unsafe.Pointer(in.IncludeMetadata))
+	if err := Convert_v1alpha1_OTLPReceiverAuthConfig_To_config_OTLPReceiverAuthConfig(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	out.AllowedClients = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.AllowedClients))
+	return nil
+}
+
+// Convert_v1alpha1_OTLPReceiverConfig_To_config_OTLPReceiverConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OTLPReceiverConfig_To_config_OTLPReceiverConfig(in *OTLPReceiverConfig, out *config.OTLPReceiverConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OTLPReceiverConfig_To_config_OTLPReceiverConfig(in, out, s)
+}
+
+func autoConvert_config_OTLPReceiverConfig_To_v1alpha1_OTLPReceiverConfig(in *config.OTLPReceiverConfig, out *OTLPReceiverConfig, s conversion.Scope) error {
+	out.IncludeMetadata = (*bool)(unsafe.Pointer(in.IncludeMetadata))
+	if err := Convert_config_OTLPReceiverAuthConfig_To_v1alpha1_OTLPReceiverAuthConfig(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	out.AllowedClients = *(*[]metav1.LabelSelector)(unsafe.Pointer(&in.AllowedClients))
+	return nil
+}
+
+// Convert_config_OTLPReceiverConfig_To_v1alpha1_OTLPReceiverConfig is an autogenerated conversion function.
+func Convert_config_OTLPReceiverConfig_To_v1alpha1_OTLPReceiverConfig(in *config.OTLPReceiverConfig, out *OTLPReceiverConfig, s conversion.Scope) error {
+	return autoConvert_config_OTLPReceiverConfig_To_v1alpha1_OTLPReceiverConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PipelineDebugExporterConfig_To_config_PipelineDebugExporterConfig(in *PipelineDebugExporterConfig, out *config.PipelineDebugExporterConfig, s conversion.Scope) error {
+	out.Pipeline = in.Pipeline
+	out.Verbosity = config.DebugExporterVerbosity(in.Verbosity)
+	return nil
+}
+
+// Convert_v1alpha1_PipelineDebugExporterConfig_To_config_PipelineDebugExporterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PipelineDebugExporterConfig_To_config_PipelineDebugExporterConfig(in *PipelineDebugExporterConfig, out *config.PipelineDebugExporterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PipelineDebugExporterConfig_To_config_PipelineDebugExporterConfig(in, out, s)
+}
+
+func autoConvert_config_PipelineDebugExporterConfig_To_v1alpha1_PipelineDebugExporterConfig(in *config.PipelineDebugExporterConfig, out *PipelineDebugExporterConfig, s conversion.Scope) error {
+	out.Pipeline = in.Pipeline
+	out.Verbosity = DebugExporterVerbosity(in.Verbosity)
+	return nil
+}
+
+// Convert_config_PipelineDebugExporterConfig_To_v1alpha1_PipelineDebugExporterConfig is an autogenerated conversion function.
+func Convert_config_PipelineDebugExporterConfig_To_v1alpha1_PipelineDebugExporterConfig(in *config.PipelineDebugExporterConfig, out *PipelineDebugExporterConfig, s conversion.Scope) error {
+	return autoConvert_config_PipelineDebugExporterConfig_To_v1alpha1_PipelineDebugExporterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PortConfig_To_config_PortConfig(in *PortConfig, out *config.PortConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Port = in.Port
+	out.Protocol = v1.Protocol(in.Protocol)
+	return nil
+}
+
+// Convert_v1alpha1_PortConfig_To_config_PortConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PortConfig_To_config_PortConfig(in *PortConfig, out *config.PortConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PortConfig_To_config_PortConfig(in, out, s)
+}
+
+func autoConvert_config_PortConfig_To_v1alpha1_PortConfig(in *config.PortConfig, out *PortConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Port = in.Port
+	out.Protocol = v1.Protocol(in.Protocol)
+	return nil
+}
+
+// Convert_config_PortConfig_To_v1alpha1_PortConfig is an autogenerated conversion function.
+func Convert_config_PortConfig_To_v1alpha1_PortConfig(in *config.PortConfig, out *PortConfig, s conversion.Scope) error {
+	return autoConvert_config_PortConfig_To_v1alpha1_PortConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrometheusReceiverConfig_To_config_PrometheusReceiverConfig(in *PrometheusReceiverConfig, out *config.PrometheusReceiverConfig, s conversion.Scope) error {
+	out.ScrapeConfigs = *(*[]config.ScrapeConfig)(unsafe.Pointer(&in.ScrapeConfigs))
+	out.NativeHistograms = (*bool)(unsafe.Pointer(in.NativeHistograms))
+	if err := Convert_v1alpha1_ScrapeLimitsConfig_To_config_ScrapeLimitsConfig(&in.Limits, &out.Limits, s); err != nil {
+		return err
+	}
+	out.UseStartTimeMetric = (*bool)(unsafe.Pointer(in.UseStartTimeMetric))
+	out.StartTimeMetricRegex = in.StartTimeMetricRegex
+	return nil
+}
+
+// Convert_v1alpha1_PrometheusReceiverConfig_To_config_PrometheusReceiverConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PrometheusReceiverConfig_To_config_PrometheusReceiverConfig(in *PrometheusReceiverConfig, out *config.PrometheusReceiverConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrometheusReceiverConfig_To_config_PrometheusReceiverConfig(in, out, s)
+}
+
+func autoConvert_config_PrometheusReceiverConfig_To_v1alpha1_PrometheusReceiverConfig(in *config.PrometheusReceiverConfig, out *PrometheusReceiverConfig, s conversion.Scope) error {
+	out.ScrapeConfigs = *(*[]ScrapeConfig)(unsafe.Pointer(&in.ScrapeConfigs))
+	out.NativeHistograms = (*bool)(unsafe.Pointer(in.NativeHistograms))
+	if err := Convert_config_ScrapeLimitsConfig_To_v1alpha1_ScrapeLimitsConfig(&in.Limits, &out.Limits, s); err != nil {
+		return err
+	}
+	out.UseStartTimeMetric = (*bool)(unsafe.Pointer(in.UseStartTimeMetric))
+	out.StartTimeMetricRegex = in.StartTimeMetricRegex
+	return nil
+}
+
+// Convert_config_PrometheusReceiverConfig_To_v1alpha1_PrometheusReceiverConfig is an autogenerated conversion function.
+func Convert_config_PrometheusReceiverConfig_To_v1alpha1_PrometheusReceiverConfig(in *config.PrometheusReceiverConfig, out *PrometheusReceiverConfig, s conversion.Scope) error {
+	return autoConvert_config_PrometheusReceiverConfig_To_v1alpha1_PrometheusReceiverConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceReference_To_config_ResourceReference(in *ResourceReference, out *config.ResourceReference, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(&in.ResourceRef, &out.ResourceRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ResourceReference_To_config_ResourceReference is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceReference_To_config_ResourceReference(in *ResourceReference, out *config.ResourceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceReference_To_config_ResourceReference(in, out, s)
+}
+
+func autoConvert_config_ResourceReference_To_v1alpha1_ResourceReference(in *config.ResourceReference, out *ResourceReference, s conversion.Scope) error {
+	if err := Convert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(&in.ResourceRef, &out.ResourceRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ResourceReference_To_v1alpha1_ResourceReference is an autogenerated conversion function.
+func Convert_config_ResourceReference_To_v1alpha1_ResourceReference(in *config.ResourceReference, out *ResourceReference, s conversion.Scope) error {
+	return autoConvert_config_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(in *ResourceReferenceDetails, out *config.ResourceReferenceDetails, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DataKey = in.DataKey
+	return nil
+}
+
+// Convert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(in *ResourceReferenceDetails, out *config.ResourceReferenceDetails, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(in, out, s)
+}
+
+func autoConvert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(in *config.ResourceReferenceDetails, out *ResourceReferenceDetails, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DataKey = in.DataKey
+	return nil
+}
+
+// Convert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails is an autogenerated conversion function.
+func Convert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(in *config.ResourceReferenceDetails, out *ResourceReferenceDetails, s conversion.Scope) error {
+	return autoConvert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(in, out, s)
+}
+
+func autoConvert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(in *RetryOnFailureConfig, out *config.RetryOnFailureConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.InitialInterval = time.Duration(in.InitialInterval)
+	out.MaxInterval = time.Duration(in.MaxInterval)
+	out.MaxElapsedTime = (*time.Duration)(unsafe.Pointer(in.MaxElapsedTime))
+	out.Multiplier = in.Multiplier
+	return nil
+}
+
+// Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(in *RetryOnFailureConfig, out *config.RetryOnFailureConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(in, out, s)
+}
+
+func autoConvert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in *config.RetryOnFailureConfig, out *RetryOnFailureConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.InitialInterval = time.Duration(in.InitialInterval)
+	out.MaxInterval = time.Duration(in.MaxInterval)
+	out.MaxElapsedTime = (*time.Duration)(unsafe.Pointer(in.MaxElapsedTime))
+	out.Multiplier = in.Multiplier
+	return nil
+}
+
+// Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig is an autogenerated conversion function.
+func Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in *config.RetryOnFailureConfig, out *RetryOnFailureConfig, s conversion.Scope) error {
+	return autoConvert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CollectorConfig_To_config_CollectorConfig(in *CollectorConfig, out *config.CollectorConfig, s conversion.Scope) error {
-	if err := Convert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
+func autoConvert_v1alpha1_ScrapeAuthorizationConfig_To_config_ScrapeAuthorizationConfig(in *ScrapeAuthorizationConfig, out *config.ScrapeAuthorizationConfig, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Credentials = (*config.ResourceReference)(unsafe.Pointer(in.Credentials))
 	return nil
 }
 
-// Convert_v1alpha1_CollectorConfig_To_config_CollectorConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CollectorConfig_To_config_CollectorConfig(in *CollectorConfig, out *config.CollectorConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CollectorConfig_To_config_CollectorConfig(in, out, s)
+// Convert_v1alpha1_ScrapeAuthorizationConfig_To_config_ScrapeAuthorizationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ScrapeAuthorizationConfig_To_config_ScrapeAuthorizationConfig(in *ScrapeAuthorizationConfig, out *config.ScrapeAuthorizationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScrapeAuthorizationConfig_To_config_ScrapeAuthorizationConfig(in, out, s)
 }
 
-func autoConvert_config_CollectorConfig_To_v1alpha1_CollectorConfig(in *config.CollectorConfig, out *CollectorConfig, s conversion.Scope) error {
-	if err := Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
+func autoConvert_config_ScrapeAuthorizationConfig_To_v1alpha1_ScrapeAuthorizationConfig(in *config.ScrapeAuthorizationConfig, out *ScrapeAuthorizationConfig, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Credentials = (*ResourceReference)(unsafe.Pointer(in.Credentials))
 	return nil
 }
 
-// Convert_config_CollectorConfig_To_v1alpha1_CollectorConfig is an autogenerated conversion function.
-func Convert_config_CollectorConfig_To_v1alpha1_CollectorConfig(in *config.CollectorConfig, out *CollectorConfig, s conversion.Scope) error {
-	return autoConvert_config_CollectorConfig_To_v1alpha1_CollectorConfig(in, out, s)
+// Convert_config_ScrapeAuthorizationConfig_To_v1alpha1_ScrapeAuthorizationConfig is an autogenerated conversion function.
+func Convert_config_ScrapeAuthorizationConfig_To_v1alpha1_ScrapeAuthorizationConfig(in *config.ScrapeAuthorizationConfig, out *ScrapeAuthorizationConfig, s conversion.Scope) error {
+	return autoConvert_config_ScrapeAuthorizationConfig_To_v1alpha1_ScrapeAuthorizationConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(in *CollectorConfigSpec, out *config.CollectorConfigSpec, s conversion.Scope) error {
-	if err := Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(&in.Exporters, &out.Exporters, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(&in.Logs, &out.Logs, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(&in.Metrics, &out.Metrics, s); err != nil {
-		return err
-	}
+func autoConvert_v1alpha1_ScrapeBasicAuthConfig_To_config_ScrapeBasicAuthConfig(in *ScrapeBasicAuthConfig, out *config.ScrapeBasicAuthConfig, s conversion.Scope) error {
+	out.Username = in.Username
+	out.Password = (*config.ResourceReference)(unsafe.Pointer(in.Password))
 	return nil
 }
 
-// Convert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec is an autogenerated conversion function.
-func Convert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(in *CollectorConfigSpec, out *config.CollectorConfigSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CollectorConfigSpec_To_config_CollectorConfigSpec(in, out, s)
+// Convert_v1alpha1_ScrapeBasicAuthConfig_To_config_ScrapeBasicAuthConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ScrapeBasicAuthConfig_To_config_ScrapeBasicAuthConfig(in *ScrapeBasicAuthConfig, out *config.ScrapeBasicAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScrapeBasicAuthConfig_To_config_ScrapeBasicAuthConfig(in, out, s)
 }
 
-func autoConvert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in *config.CollectorConfigSpec, out *CollectorConfigSpec, s conversion.Scope) error {
-	if err := Convert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(&in.Exporters, &out.Exporters, s); err != nil {
-		return err
-	}
-	if err := Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(&in.Logs, &out.Logs, s); err != nil {
-		return err
-	}
-	if err := Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(&in.Metrics, &out.Metrics, s); err != nil {
-		return err
-	}
+func autoConvert_config_ScrapeBasicAuthConfig_To_v1alpha1_ScrapeBasicAuthConfig(in *config.ScrapeBasicAuthConfig, out *ScrapeBasicAuthConfig, s conversion.Scope) error {
+	out.Username = in.Username
+	out.Password = (*ResourceReference)(unsafe.Pointer(in.Password))
 	return nil
 }
 
-// Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec is an autogenerated conversion function.
-func Convert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in *config.CollectorConfigSpec, out *CollectorConfigSpec, s conversion.Scope) error {
-	return autoConvert_config_CollectorConfigSpec_To_v1alpha1_CollectorConfigSpec(in, out, s)
+// Convert_config_ScrapeBasicAuthConfig_To_v1alpha1_ScrapeBasicAuthConfig is an autogenerated conversion function.
+func Convert_config_ScrapeBasicAuthConfig_To_v1alpha1_ScrapeBasicAuthConfig(in *config.ScrapeBasicAuthConfig, out *ScrapeBasicAuthConfig, s conversion.Scope) error {
+	return autoConvert_config_ScrapeBasicAuthConfig_To_v1alpha1_ScrapeBasicAuthConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(in *CollectorExportersConfig, out *config.CollectorExportersConfig, s conversion.Scope) error {
-	if err := Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(&in.OTLPGRPCExporter, &out.OTLPGRPCExporter, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(&in.OTLPHTTPExporter, &out.OTLPHTTPExporter, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(&in.DebugExporter, &out.DebugExporter, s); err != nil {
+func autoConvert_v1alpha1_ScrapeConfig_To_config_ScrapeConfig(in *ScrapeConfig, out *config.ScrapeConfig, s conversion.Scope) error {
+	out.JobName = in.JobName
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.MetricsPath = in.MetricsPath
+	out.Scheme = in.Scheme
+	out.ScrapeInterval = time.Duration(in.ScrapeInterval)
+	out.Authorization = (*config.ScrapeAuthorizationConfig)(unsafe.Pointer(in.Authorization))
+	out.BasicAuth = (*config.ScrapeBasicAuthConfig)(unsafe.Pointer(in.BasicAuth))
+	out.EnableHTTP2 = (*bool)(unsafe.Pointer(in.EnableHTTP2))
+	out.FollowRedirects = (*bool)(unsafe.Pointer(in.FollowRedirects))
+	out.ProxyURL = in.ProxyURL
+	out.BodySizeLimit = in.BodySizeLimit
+	out.HonorTimestamps = (*bool)(unsafe.Pointer(in.HonorTimestamps))
+	out.TrackTimestampsStaleness = (*bool)(unsafe.Pointer(in.TrackTimestampsStaleness))
+	if err := Convert_v1alpha1_ScrapeLimitsConfig_To_config_ScrapeLimitsConfig(&in.Limits, &out.Limits, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(in *CollectorExportersConfig, out *config.CollectorExportersConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CollectorExportersConfig_To_config_CollectorExportersConfig(in, out, s)
+// Convert_v1alpha1_ScrapeConfig_To_config_ScrapeConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ScrapeConfig_To_config_ScrapeConfig(in *ScrapeConfig, out *config.ScrapeConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScrapeConfig_To_config_ScrapeConfig(in, out, s)
 }
 
-func autoConvert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(in *config.CollectorExportersConfig, out *CollectorExportersConfig, s conversion.Scope) error {
-	if err := Convert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(&in.OTLPGRPCExporter, &out.OTLPGRPCExporter, s); err != nil {
-		return err
-	}
-	if err := Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(&in.OTLPHTTPExporter, &out.OTLPHTTPExporter, s); err != nil {
-		return err
-	}
-	if err := Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(&in.DebugExporter, &out.DebugExporter, s); err != nil {
+func autoConvert_config_ScrapeConfig_To_v1alpha1_ScrapeConfig(in *config.ScrapeConfig, out *ScrapeConfig, s conversion.Scope) error {
+	out.JobName = in.JobName
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.MetricsPath = in.MetricsPath
+	out.Scheme = in.Scheme
+	out.ScrapeInterval = time.Duration(in.ScrapeInterval)
+	out.Authorization = (*ScrapeAuthorizationConfig)(unsafe.Pointer(in.Authorization))
+	out.BasicAuth = (*ScrapeBasicAuthConfig)(unsafe.Pointer(in.BasicAuth))
+	out.EnableHTTP2 = (*bool)(unsafe.Pointer(in.EnableHTTP2))
+	out.FollowRedirects = (*bool)(unsafe.Pointer(in.FollowRedirects))
+	out.ProxyURL = in.ProxyURL
+	out.BodySizeLimit = in.BodySizeLimit
+	out.HonorTimestamps = (*bool)(unsafe.Pointer(in.HonorTimestamps))
+	out.TrackTimestampsStaleness = (*bool)(unsafe.Pointer(in.TrackTimestampsStaleness))
+	if err := Convert_config_ScrapeLimitsConfig_To_v1alpha1_ScrapeLimitsConfig(&in.Limits, &out.Limits, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig is an autogenerated conversion function.
-func Convert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(in *config.CollectorExportersConfig, out *CollectorExportersConfig, s conversion.Scope) error {
-	return autoConvert_config_CollectorExportersConfig_To_v1alpha1_CollectorExportersConfig(in, out, s)
+// Convert_config_ScrapeConfig_To_v1alpha1_ScrapeConfig is an autogenerated conversion function.
+func Convert_config_ScrapeConfig_To_v1alpha1_ScrapeConfig(in *config.ScrapeConfig, out *ScrapeConfig, s conversion.Scope) error {
+	return autoConvert_config_ScrapeConfig_To_v1alpha1_ScrapeConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(in *CollectorLogsConfig, out *config.CollectorLogsConfig, s conversion.Scope) error {
-	out.Level = config.LogLevel(in.Level)
-	out.Encoding = config.LogEncoding(in.Encoding)
+func autoConvert_v1alpha1_ScrapeLimitsConfig_To_config_ScrapeLimitsConfig(in *ScrapeLimitsConfig, out *config.ScrapeLimitsConfig, s conversion.Scope) error {
+	out.SampleLimit = in.SampleLimit
+	out.LabelLimit = in.LabelLimit
+	out.LabelNameLengthLimit = in.LabelNameLengthLimit
+	out.LabelValueLengthLimit = in.LabelValueLengthLimit
 	return nil
 }
 
-// Convert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(in *CollectorLogsConfig, out *config.CollectorLogsConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CollectorLogsConfig_To_config_CollectorLogsConfig(in, out, s)
+// Convert_v1alpha1_ScrapeLimitsConfig_To_config_ScrapeLimitsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ScrapeLimitsConfig_To_config_ScrapeLimitsConfig(in *ScrapeLimitsConfig, out *config.ScrapeLimitsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScrapeLimitsConfig_To_config_ScrapeLimitsConfig(in, out, s)
 }
 
-func autoConvert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in *config.CollectorLogsConfig, out *CollectorLogsConfig, s conversion.Scope) error {
-	out.Level = LogLevel(in.Level)
-	out.Encoding = LogEncoding(in.Encoding)
+func autoConvert_config_ScrapeLimitsConfig_To_v1alpha1_ScrapeLimitsConfig(in *config.ScrapeLimitsConfig, out *ScrapeLimitsConfig, s conversion.Scope) error {
+	out.SampleLimit = in.SampleLimit
+	out.LabelLimit = in.LabelLimit
+	out.LabelNameLengthLimit = in.LabelNameLengthLimit
+	out.LabelValueLengthLimit = in.LabelValueLengthLimit
 	return nil
 }
 
-// Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig is an autogenerated conversion function.
-func Convert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in *config.CollectorLogsConfig, out *CollectorLogsConfig, s conversion.Scope) error {
-	return autoConvert_config_CollectorLogsConfig_To_v1alpha1_CollectorLogsConfig(in, out, s)
+// Convert_config_ScrapeLimitsConfig_To_v1alpha1_ScrapeLimitsConfig is an autogenerated conversion function.
+func Convert_config_ScrapeLimitsConfig_To_v1alpha1_ScrapeLimitsConfig(in *config.ScrapeLimitsConfig, out *ScrapeLimitsConfig, s conversion.Scope) error {
+	return autoConvert_config_ScrapeLimitsConfig_To_v1alpha1_ScrapeLimitsConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
-	out.Level = config.MetricsVerbosityLevel(in.Level)
+func autoConvert_v1alpha1_SecurityContextConfig_To_config_SecurityContextConfig(in *SecurityContextConfig, out *config.SecurityContextConfig, s conversion.Scope) error {
+	out.RunAsUser = in.RunAsUser
+	out.RunAsGroup = in.RunAsGroup
 	return nil
 }
 
-// Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in *CollectorMetricsConfig, out *config.CollectorMetricsConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CollectorMetricsConfig_To_config_CollectorMetricsConfig(in, out, s)
+// Convert_v1alpha1_SecurityContextConfig_To_config_SecurityContextConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityContextConfig_To_config_SecurityContextConfig(in *SecurityContextConfig, out *config.SecurityContextConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityContextConfig_To_config_SecurityContextConfig(in, out, s)
 }
 
-func autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
-	out.Level = MetricsVerbosityLevel(in.Level)
+func autoConvert_config_SecurityContextConfig_To_v1alpha1_SecurityContextConfig(in *config.SecurityContextConfig, out *SecurityContextConfig, s conversion.Scope) error {
+	out.RunAsUser = in.RunAsUser
+	out.RunAsGroup = in.RunAsGroup
 	return nil
 }
 
-// Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig is an autogenerated conversion function.
-func Convert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in *config.CollectorMetricsConfig, out *CollectorMetricsConfig, s conversion.Scope) error {
-	return autoConvert_config_CollectorMetricsConfig_To_v1alpha1_CollectorMetricsConfig(in, out, s)
+// Convert_config_SecurityContextConfig_To_v1alpha1_SecurityContextConfig is an autogenerated conversion function.
+func Convert_config_SecurityContextConfig_To_v1alpha1_SecurityContextConfig(in *config.SecurityContextConfig, out *SecurityContextConfig, s conversion.Scope) error {
+	return autoConvert_config_SecurityContextConfig_To_v1alpha1_SecurityContextConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
-	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Verbosity = config.DebugExporterVerbosity(in.Verbosity)
+func autoConvert_v1alpha1_SendingQueueBatchConfig_To_config_SendingQueueBatchConfig(in *SendingQueueBatchConfig, out *config.SendingQueueBatchConfig, s conversion.Scope) error {
+	out.Sizer = config.RequestSizer(in.Sizer)
+	out.MaxSize = in.MaxSize
 	return nil
 }
 
-// Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig is an autogenerated conversion function.
-func Convert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in *DebugExporterConfig, out *config.DebugExporterConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DebugExporterConfig_To_config_DebugExporterConfig(in, out, s)
+// Convert_v1alpha1_SendingQueueBatchConfig_To_config_SendingQueueBatchConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SendingQueueBatchConfig_To_config_SendingQueueBatchConfig(in *SendingQueueBatchConfig, out *config.SendingQueueBatchConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SendingQueueBatchConfig_To_config_SendingQueueBatchConfig(in, out, s)
 }
 
-func autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
-	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Verbosity = DebugExporterVerbosity(in.Verbosity)
+func autoConvert_config_SendingQueueBatchConfig_To_v1alpha1_SendingQueueBatchConfig(in *config.SendingQueueBatchConfig, out *SendingQueueBatchConfig, s conversion.Scope) error {
+	out.Sizer = RequestSizer(in.Sizer)
+	out.MaxSize = in.MaxSize
 	return nil
 }
 
-// Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig is an autogenerated conversion function.
-func Convert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in *config.DebugExporterConfig, out *DebugExporterConfig, s conversion.Scope) error {
-	return autoConvert_config_DebugExporterConfig_To_v1alpha1_DebugExporterConfig(in, out, s)
+// Convert_config_SendingQueueBatchConfig_To_v1alpha1_SendingQueueBatchConfig is an autogenerated conversion function.
+func Convert_config_SendingQueueBatchConfig_To_v1alpha1_SendingQueueBatchConfig(in *config.SendingQueueBatchConfig, out *SendingQueueBatchConfig, s conversion.Scope) error {
+	return autoConvert_config_SendingQueueBatchConfig_To_v1alpha1_SendingQueueBatchConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(in *OTLPGRPCExporterConfig, out *config.OTLPGRPCExporterConfig, s conversion.Scope) error {
+func autoConvert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(in *SendingQueueConfig, out *config.SendingQueueConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Endpoint = in.Endpoint
-	out.TLS = (*config.TLSConfig)(unsafe.Pointer(in.TLS))
-	out.Token = (*config.ResourceReference)(unsafe.Pointer(in.Token))
-	out.Timeout = time.Duration(in.Timeout)
-	out.ReadBufferSize = in.ReadBufferSize
-	out.WriteBufferSize = in.WriteBufferSize
-	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+	out.NumConsumers = in.NumConsumers
+	out.QueueSize = in.QueueSize
+	out.BlockOnOverflow = (*bool)(unsafe.Pointer(in.BlockOnOverflow))
+	if err := Convert_v1alpha1_SendingQueueBatchConfig_To_config_SendingQueueBatchConfig(&in.Batch, &out.Batch, s); err != nil {
 		return err
 	}
-	out.Compression = config.Compression(in.Compression)
 	return nil
 }
 
-// Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig is an autogenerated conversion function.
-func Convert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(in *OTLPGRPCExporterConfig, out *config.OTLPGRPCExporterConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_OTLPGRPCExporterConfig_To_config_OTLPGRPCExporterConfig(in, out, s)
+// Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(in *SendingQueueConfig, out *config.SendingQueueConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SendingQueueConfig_To_config_SendingQueueConfig(in, out, s)
 }
 
-func autoConvert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(in *config.OTLPGRPCExporterConfig, out *OTLPGRPCExporterConfig, s conversion.Scope) error {
+func autoConvert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(in *config.SendingQueueConfig, out *SendingQueueConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Endpoint = in.Endpoint
-	out.TLS = (*TLSConfig)(unsafe.Pointer(in.TLS))
-	out.Token = (*ResourceReference)(unsafe.Pointer(in.Token))
-	out.Timeout = time.Duration(in.Timeout)
-	out.ReadBufferSize = in.ReadBufferSize
-	out.WriteBufferSize = in.WriteBufferSize
-	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
+	out.NumConsumers = in.NumConsumers
+	out.QueueSize = in.QueueSize
+	out.BlockOnOverflow = (*bool)(unsafe.Pointer(in.BlockOnOverflow))
+	if err := Convert_config_SendingQueueBatchConfig_To_v1alpha1_SendingQueueBatchConfig(&in.Batch, &out.Batch, s); err != nil {
 		return err
 	}
-	out.Compression = Compression(in.Compression)
 	return nil
 }
 
-// Convert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig is an autogenerated conversion function.
-func Convert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(in *config.OTLPGRPCExporterConfig, out *OTLPGRPCExporterConfig, s conversion.Scope) error {
-	return autoConvert_config_OTLPGRPCExporterConfig_To_v1alpha1_OTLPGRPCExporterConfig(in, out, s)
+// Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig is an autogenerated conversion function.
+func Convert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(in *config.SendingQueueConfig, out *SendingQueueConfig, s conversion.Scope) error {
+	return autoConvert_config_SendingQueueConfig_To_v1alpha1_SendingQueueConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(in *OTLPHTTPExporterConfig, out *config.OTLPHTTPExporterConfig, s conversion.Scope) error {
+func autoConvert_v1alpha1_ServiceGraphConnectorConfig_To_config_ServiceGraphConnectorConfig(in *ServiceGraphConnectorConfig, out *config.ServiceGraphConnectorConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Endpoint = in.Endpoint
-	out.TracesEndpoint = in.TracesEndpoint
-	out.MetricsEndpoint = in.MetricsEndpoint
-	out.LogsEndpoint = in.LogsEndpoint
-	out.ProfilesEndpoint = in.ProfilesEndpoint
-	out.TLS = (*config.TLSConfig)(unsafe.Pointer(in.TLS))
-	out.Token = (*config.ResourceReference)(unsafe.Pointer(in.Token))
-	out.Timeout = time.Duration(in.Timeout)
-	out.ReadBufferSize = in.ReadBufferSize
-	out.WriteBufferSize = in.WriteBufferSize
-	out.Encoding = config.MessageEncoding(in.Encoding)
-	if err := Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
-		return err
-	}
-	out.Compression = config.Compression(in.Compression)
+	out.LatencyHistogramBuckets = *(*[]time.Duration)(unsafe.Pointer(&in.LatencyHistogramBuckets))
+	out.Dimensions = *(*[]string)(unsafe.Pointer(&in.Dimensions))
 	return nil
 }
 
-// Convert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig is an autogenerated conversion function.
-func Convert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(in *OTLPHTTPExporterConfig, out *config.OTLPHTTPExporterConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_OTLPHTTPExporterConfig_To_config_OTLPHTTPExporterConfig(in, out, s)
+// Convert_v1alpha1_ServiceGraphConnectorConfig_To_config_ServiceGraphConnectorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ServiceGraphConnectorConfig_To_config_ServiceGraphConnectorConfig(in *ServiceGraphConnectorConfig, out *config.ServiceGraphConnectorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServiceGraphConnectorConfig_To_config_ServiceGraphConnectorConfig(in, out, s)
 }
 
-func autoConvert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in *config.OTLPHTTPExporterConfig, out *OTLPHTTPExporterConfig, s conversion.Scope) error {
+func autoConvert_config_ServiceGraphConnectorConfig_To_v1alpha1_ServiceGraphConnectorConfig(in *config.ServiceGraphConnectorConfig, out *ServiceGraphConnectorConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.Endpoint = in.Endpoint
-	out.TracesEndpoint = in.TracesEndpoint
-	out.MetricsEndpoint = in.MetricsEndpoint
-	out.LogsEndpoint = in.LogsEndpoint
-	out.ProfilesEndpoint = in.ProfilesEndpoint
-	out.TLS = (*TLSConfig)(unsafe.Pointer(in.TLS))
-	out.Token = (*ResourceReference)(unsafe.Pointer(in.Token))
-	out.Timeout = time.Duration(in.Timeout)
-	out.ReadBufferSize = in.ReadBufferSize
-	out.WriteBufferSize = in.WriteBufferSize
-	out.Encoding = MessageEncoding(in.Encoding)
-	if err := Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(&in.RetryOnFailure, &out.RetryOnFailure, s); err != nil {
-		return err
-	}
-	out.Compression = Compression(in.Compression)
+	out.LatencyHistogramBuckets = *(*[]time.Duration)(unsafe.Pointer(&in.LatencyHistogramBuckets))
+	out.Dimensions = *(*[]string)(unsafe.Pointer(&in.Dimensions))
 	return nil
 }
 
-// Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig is an autogenerated conversion function.
-func Convert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in *config.OTLPHTTPExporterConfig, out *OTLPHTTPExporterConfig, s conversion.Scope) error {
-	return autoConvert_config_OTLPHTTPExporterConfig_To_v1alpha1_OTLPHTTPExporterConfig(in, out, s)
+// Convert_config_ServiceGraphConnectorConfig_To_v1alpha1_ServiceGraphConnectorConfig is an autogenerated conversion function.
+func Convert_config_ServiceGraphConnectorConfig_To_v1alpha1_ServiceGraphConnectorConfig(in *config.ServiceGraphConnectorConfig, out *ServiceGraphConnectorConfig, s conversion.Scope) error {
+	return autoConvert_config_ServiceGraphConnectorConfig_To_v1alpha1_ServiceGraphConnectorConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_ResourceReference_To_config_ResourceReference(in *ResourceReference, out *config.ResourceReference, s conversion.Scope) error {
-	if err := Convert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(&in.ResourceRef, &out.ResourceRef, s); err != nil {
+func autoConvert_v1alpha1_SpanMetricsConnectorConfig_To_config_SpanMetricsConnectorConfig(in *SpanMetricsConnectorConfig, out *config.SpanMetricsConnectorConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Dimensions = *(*[]string)(unsafe.Pointer(&in.Dimensions))
+	if err := Convert_v1alpha1_SpanMetricsExemplarsConfig_To_config_SpanMetricsExemplarsConfig(&in.Exemplars, &out.Exemplars, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_ResourceReference_To_config_ResourceReference is an autogenerated conversion function.
-func Convert_v1alpha1_ResourceReference_To_config_ResourceReference(in *ResourceReference, out *config.ResourceReference, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ResourceReference_To_config_ResourceReference(in, out, s)
+// Convert_v1alpha1_SpanMetricsConnectorConfig_To_config_SpanMetricsConnectorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SpanMetricsConnectorConfig_To_config_SpanMetricsConnectorConfig(in *SpanMetricsConnectorConfig, out *config.SpanMetricsConnectorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SpanMetricsConnectorConfig_To_config_SpanMetricsConnectorConfig(in, out, s)
 }
 
-func autoConvert_config_ResourceReference_To_v1alpha1_ResourceReference(in *config.ResourceReference, out *ResourceReference, s conversion.Scope) error {
-	if err := Convert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(&in.ResourceRef, &out.ResourceRef, s); err != nil {
+func autoConvert_config_SpanMetricsConnectorConfig_To_v1alpha1_SpanMetricsConnectorConfig(in *config.SpanMetricsConnectorConfig, out *SpanMetricsConnectorConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Dimensions = *(*[]string)(unsafe.Pointer(&in.Dimensions))
+	if err := Convert_config_SpanMetricsExemplarsConfig_To_v1alpha1_SpanMetricsExemplarsConfig(&in.Exemplars, &out.Exemplars, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_config_ResourceReference_To_v1alpha1_ResourceReference is an autogenerated conversion function.
-func Convert_config_ResourceReference_To_v1alpha1_ResourceReference(in *config.ResourceReference, out *ResourceReference, s conversion.Scope) error {
-	return autoConvert_config_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
+// Convert_config_SpanMetricsConnectorConfig_To_v1alpha1_SpanMetricsConnectorConfig is an autogenerated conversion function.
+func Convert_config_SpanMetricsConnectorConfig_To_v1alpha1_SpanMetricsConnectorConfig(in *config.SpanMetricsConnectorConfig, out *SpanMetricsConnectorConfig, s conversion.Scope) error {
+	return autoConvert_config_SpanMetricsConnectorConfig_To_v1alpha1_SpanMetricsConnectorConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(in *ResourceReferenceDetails, out *config.ResourceReferenceDetails, s conversion.Scope) error {
-	out.Name = in.Name
-	out.DataKey = in.DataKey
+func autoConvert_v1alpha1_SpanMetricsExemplarsConfig_To_config_SpanMetricsExemplarsConfig(in *SpanMetricsExemplarsConfig, out *config.SpanMetricsExemplarsConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.MaxPerDataPoint = (*int32)(unsafe.Pointer(in.MaxPerDataPoint))
 	return nil
 }
 
-// Convert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails is an autogenerated conversion function.
-func Convert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(in *ResourceReferenceDetails, out *config.ResourceReferenceDetails, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ResourceReferenceDetails_To_config_ResourceReferenceDetails(in, out, s)
+// Convert_v1alpha1_SpanMetricsExemplarsConfig_To_config_SpanMetricsExemplarsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SpanMetricsExemplarsConfig_To_config_SpanMetricsExemplarsConfig(in *SpanMetricsExemplarsConfig, out *config.SpanMetricsExemplarsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SpanMetricsExemplarsConfig_To_config_SpanMetricsExemplarsConfig(in, out, s)
 }
 
-func autoConvert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(in *config.ResourceReferenceDetails, out *ResourceReferenceDetails, s conversion.Scope) error {
-	out.Name = in.Name
-	out.DataKey = in.DataKey
+func autoConvert_config_SpanMetricsExemplarsConfig_To_v1alpha1_SpanMetricsExemplarsConfig(in *config.SpanMetricsExemplarsConfig, out *SpanMetricsExemplarsConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.MaxPerDataPoint = (*int32)(unsafe.Pointer(in.MaxPerDataPoint))
 	return nil
 }
 
-// Convert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails is an autogenerated conversion function.
-func Convert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(in *config.ResourceReferenceDetails, out *ResourceReferenceDetails, s conversion.Scope) error {
-	return autoConvert_config_ResourceReferenceDetails_To_v1alpha1_ResourceReferenceDetails(in, out, s)
+// Convert_config_SpanMetricsExemplarsConfig_To_v1alpha1_SpanMetricsExemplarsConfig is an autogenerated conversion function.
+func Convert_config_SpanMetricsExemplarsConfig_To_v1alpha1_SpanMetricsExemplarsConfig(in *config.SpanMetricsExemplarsConfig, out *SpanMetricsExemplarsConfig, s conversion.Scope) error {
+	return autoConvert_config_SpanMetricsExemplarsConfig_To_v1alpha1_SpanMetricsExemplarsConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(in *RetryOnFailureConfig, out *config.RetryOnFailureConfig, s conversion.Scope) error {
+func autoConvert_v1alpha1_StartupProbeConfig_To_config_StartupProbeConfig(in *StartupProbeConfig, out *config.StartupProbeConfig, s conversion.Scope) error {
+	out.FailureThreshold = in.FailureThreshold
+	out.PeriodSeconds = in.PeriodSeconds
+	return nil
+}
+
+// Convert_v1alpha1_StartupProbeConfig_To_config_StartupProbeConfig is an autogenerated conversion function.
+func Convert_v1alpha1_StartupProbeConfig_To_config_StartupProbeConfig(in *StartupProbeConfig, out *config.StartupProbeConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StartupProbeConfig_To_config_StartupProbeConfig(in, out, s)
+}
+
+func autoConvert_config_StartupProbeConfig_To_v1alpha1_StartupProbeConfig(in *config.StartupProbeConfig, out *StartupProbeConfig, s conversion.Scope) error {
+	out.FailureThreshold = in.FailureThreshold
+	out.PeriodSeconds = in.PeriodSeconds
+	return nil
+}
+
+// Convert_config_StartupProbeConfig_To_v1alpha1_StartupProbeConfig is an autogenerated conversion function.
+func Convert_config_StartupProbeConfig_To_v1alpha1_StartupProbeConfig(in *config.StartupProbeConfig, out *StartupProbeConfig, s conversion.Scope) error {
+	return autoConvert_config_StartupProbeConfig_To_v1alpha1_StartupProbeConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_StatsDReceiverConfig_To_config_StatsDReceiverConfig(in *StatsDReceiverConfig, out *config.StatsDReceiverConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.InitialInterval = time.Duration(in.InitialInterval)
-	out.MaxInterval = time.Duration(in.MaxInterval)
-	out.MaxElapsedTime = time.Duration(in.MaxElapsedTime)
-	out.Multiplier = in.Multiplier
+	out.Endpoint = in.Endpoint
+	out.AggregationInterval = time.Duration(in.AggregationInterval)
+	out.EnableMetricType = (*bool)(unsafe.Pointer(in.EnableMetricType))
 	return nil
 }
 
-// Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig is an autogenerated conversion function.
-func Convert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(in *RetryOnFailureConfig, out *config.RetryOnFailureConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_RetryOnFailureConfig_To_config_RetryOnFailureConfig(in, out, s)
+// Convert_v1alpha1_StatsDReceiverConfig_To_config_StatsDReceiverConfig is an autogenerated conversion function.
+func Convert_v1alpha1_StatsDReceiverConfig_To_config_StatsDReceiverConfig(in *StatsDReceiverConfig, out *config.StatsDReceiverConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StatsDReceiverConfig_To_config_StatsDReceiverConfig(in, out, s)
 }
 
-func autoConvert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in *config.RetryOnFailureConfig, out *RetryOnFailureConfig, s conversion.Scope) error {
+func autoConvert_config_StatsDReceiverConfig_To_v1alpha1_StatsDReceiverConfig(in *config.StatsDReceiverConfig, out *StatsDReceiverConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
-	out.InitialInterval = time.Duration(in.InitialInterval)
-	out.MaxInterval = time.Duration(in.MaxInterval)
-	out.MaxElapsedTime = time.Duration(in.MaxElapsedTime)
-	out.Multiplier = in.Multiplier
+	out.Endpoint = in.Endpoint
+	out.AggregationInterval = time.Duration(in.AggregationInterval)
+	out.EnableMetricType = (*bool)(unsafe.Pointer(in.EnableMetricType))
 	return nil
 }
 
-// Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig is an autogenerated conversion function.
-func Convert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in *config.RetryOnFailureConfig, out *RetryOnFailureConfig, s conversion.Scope) error {
-	return autoConvert_config_RetryOnFailureConfig_To_v1alpha1_RetryOnFailureConfig(in, out, s)
+// Convert_config_StatsDReceiverConfig_To_v1alpha1_StatsDReceiverConfig is an autogenerated conversion function.
+func Convert_config_StatsDReceiverConfig_To_v1alpha1_StatsDReceiverConfig(in *config.StatsDReceiverConfig, out *StatsDReceiverConfig, s conversion.Scope) error {
+	return autoConvert_config_StatsDReceiverConfig_To_v1alpha1_StatsDReceiverConfig(in, out, s)
 }
 
 func autoConvert_v1alpha1_TLSConfig_To_config_TLSConfig(in *TLSConfig, out *config.TLSConfig, s conversion.Scope) error {
 	out.InsecureSkipVerify = (*bool)(unsafe.Pointer(in.InsecureSkipVerify))
 	out.CA = (*config.ResourceReference)(unsafe.Pointer(in.CA))
+	out.IncludeSystemCAs = (*bool)(unsafe.Pointer(in.IncludeSystemCAs))
 	out.Cert = (*config.ResourceReference)(unsafe.Pointer(in.Cert))
 	out.Key = (*config.ResourceReference)(unsafe.Pointer(in.Key))
 	out.ReloadInterval = time.Duration(in.ReloadInterval)
+	out.MinVersion = config.TLSVersion(in.MinVersion)
+	out.MaxVersion = config.TLSVersion(in.MaxVersion)
+	out.CipherSuites = *(*[]string)(unsafe.Pointer(&in.CipherSuites))
 	return nil
 }
 
@@ -485,9 +2114,13 @@ func Convert_v1alpha1_TLSConfig_To_config_TLSConfig(in *TLSConfig, out *config.T
 func autoConvert_config_TLSConfig_To_v1alpha1_TLSConfig(in *config.TLSConfig, out *TLSConfig, s conversion.Scope) error {
 	out.InsecureSkipVerify = (*bool)(unsafe.Pointer(in.InsecureSkipVerify))
 	out.CA = (*ResourceReference)(unsafe.Pointer(in.CA))
+	out.IncludeSystemCAs = (*bool)(unsafe.Pointer(in.IncludeSystemCAs))
 	out.Cert = (*ResourceReference)(unsafe.Pointer(in.Cert))
 	out.Key = (*ResourceReference)(unsafe.Pointer(in.Key))
 	out.ReloadInterval = time.Duration(in.ReloadInterval)
+	out.MinVersion = TLSVersion(in.MinVersion)
+	out.MaxVersion = TLSVersion(in.MaxVersion)
+	out.CipherSuites = *(*[]string)(unsafe.Pointer(&in.CipherSuites))
 	return nil
 }
 
@@ -495,3 +2128,101 @@ func autoConvert_config_TLSConfig_To_v1alpha1_TLSConfig(in *config.TLSConfig, ou
 func Convert_config_TLSConfig_To_v1alpha1_TLSConfig(in *config.TLSConfig, out *TLSConfig, s conversion.Scope) error {
 	return autoConvert_config_TLSConfig_To_v1alpha1_TLSConfig(in, out, s)
 }
+
+func autoConvert_v1alpha1_TailSamplingProcessorConfig_To_config_TailSamplingProcessorConfig(in *TailSamplingProcessorConfig, out *config.TailSamplingProcessorConfig, s conversion.Scope) error {
+	out.DecisionWait = time.Duration(in.DecisionWait)
+	out.NumTraces = in.NumTraces
+	out.ExpectedNewTracesPerSec = in.ExpectedNewTracesPerSec
+	return nil
+}
+
+// Convert_v1alpha1_TailSamplingProcessorConfig_To_config_TailSamplingProcessorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TailSamplingProcessorConfig_To_config_TailSamplingProcessorConfig(in *TailSamplingProcessorConfig, out *config.TailSamplingProcessorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TailSamplingProcessorConfig_To_config_TailSamplingProcessorConfig(in, out, s)
+}
+
+func autoConvert_config_TailSamplingProcessorConfig_To_v1alpha1_TailSamplingProcessorConfig(in *config.TailSamplingProcessorConfig, out *TailSamplingProcessorConfig, s conversion.Scope) error {
+	out.DecisionWait = time.Duration(in.DecisionWait)
+	out.NumTraces = in.NumTraces
+	out.ExpectedNewTracesPerSec = in.ExpectedNewTracesPerSec
+	return nil
+}
+
+// Convert_config_TailSamplingProcessorConfig_To_v1alpha1_TailSamplingProcessorConfig is an autogenerated conversion function.
+func Convert_config_TailSamplingProcessorConfig_To_v1alpha1_TailSamplingProcessorConfig(in *config.TailSamplingProcessorConfig, out *TailSamplingProcessorConfig, s conversion.Scope) error {
+	return autoConvert_config_TailSamplingProcessorConfig_To_v1alpha1_TailSamplingProcessorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetAllocatorConfig_To_config_TargetAllocatorConfig(in *TargetAllocatorConfig, out *config.TargetAllocatorConfig, s conversion.Scope) error {
+	out.ServiceDiscoveryRole = config.ServiceDiscoveryRole(in.ServiceDiscoveryRole)
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.LogLevel = config.LogLevel(in.LogLevel)
+	out.ScrapeInterval = time.Duration(in.ScrapeInterval)
+	return nil
+}
+
+// Convert_v1alpha1_TargetAllocatorConfig_To_config_TargetAllocatorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TargetAllocatorConfig_To_config_TargetAllocatorConfig(in *TargetAllocatorConfig, out *config.TargetAllocatorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetAllocatorConfig_To_config_TargetAllocatorConfig(in, out, s)
+}
+
+func autoConvert_config_TargetAllocatorConfig_To_v1alpha1_TargetAllocatorConfig(in *config.TargetAllocatorConfig, out *TargetAllocatorConfig, s conversion.Scope) error {
+	out.ServiceDiscoveryRole = ServiceDiscoveryRole(in.ServiceDiscoveryRole)
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.LogLevel = LogLevel(in.LogLevel)
+	out.ScrapeInterval = time.Duration(in.ScrapeInterval)
+	return nil
+}
+
+// Convert_config_TargetAllocatorConfig_To_v1alpha1_TargetAllocatorConfig is an autogenerated conversion function.
+func Convert_config_TargetAllocatorConfig_To_v1alpha1_TargetAllocatorConfig(in *config.TargetAllocatorConfig, out *TargetAllocatorConfig, s conversion.Scope) error {
+	return autoConvert_config_TargetAllocatorConfig_To_v1alpha1_TargetAllocatorConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_UpdateStrategyConfig_To_config_UpdateStrategyConfig(in *UpdateStrategyConfig, out *config.UpdateStrategyConfig, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_v1alpha1_UpdateStrategyConfig_To_config_UpdateStrategyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_UpdateStrategyConfig_To_config_UpdateStrategyConfig(in *UpdateStrategyConfig, out *config.UpdateStrategyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UpdateStrategyConfig_To_config_UpdateStrategyConfig(in, out, s)
+}
+
+func autoConvert_config_UpdateStrategyConfig_To_v1alpha1_UpdateStrategyConfig(in *config.UpdateStrategyConfig, out *UpdateStrategyConfig, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_config_UpdateStrategyConfig_To_v1alpha1_UpdateStrategyConfig is an autogenerated conversion function.
+func Convert_config_UpdateStrategyConfig_To_v1alpha1_UpdateStrategyConfig(in *config.UpdateStrategyConfig, out *UpdateStrategyConfig, s conversion.Scope) error {
+	return autoConvert_config_UpdateStrategyConfig_To_v1alpha1_UpdateStrategyConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeConfig_To_config_VolumeConfig(in *VolumeConfig, out *config.VolumeConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ResourceRef = (*config.ResourceReferenceDetails)(unsafe.Pointer(in.ResourceRef))
+	out.EmptyDir = (*v1.EmptyDirVolumeSource)(unsafe.Pointer(in.EmptyDir))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeConfig_To_config_VolumeConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeConfig_To_config_VolumeConfig(in *VolumeConfig, out *config.VolumeConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeConfig_To_config_VolumeConfig(in, out, s)
+}
+
+func autoConvert_config_VolumeConfig_To_v1alpha1_VolumeConfig(in *config.VolumeConfig, out *VolumeConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ResourceRef = (*ResourceReferenceDetails)(unsafe.Pointer(in.ResourceRef))
+	out.EmptyDir = (*v1.EmptyDirVolumeSource)(unsafe.Pointer(in.EmptyDir))
+	return nil
+}
+
+// Convert_config_VolumeConfig_To_v1alpha1_VolumeConfig is an autogenerated conversion function.
+func Convert_config_VolumeConfig_To_v1alpha1_VolumeConfig(in *config.VolumeConfig, out *VolumeConfig, s conversion.Scope) error {
+	return autoConvert_config_VolumeConfig_To_v1alpha1_VolumeConfig(in, out, s)
+}
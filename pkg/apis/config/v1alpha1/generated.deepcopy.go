@@ -6,9 +6,35 @@
 package v1alpha1
 
 import (
+	time "time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchProcessorConfig) DeepCopyInto(out *BatchProcessorConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchProcessorConfig.
+func (in *BatchProcessorConfig) DeepCopy() *BatchProcessorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchProcessorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorConfig) DeepCopyInto(out *CollectorConfig) {
 	*out = *in
@@ -39,8 +65,54 @@ func (in *CollectorConfig) DeepCopyObject() runtime.Object {
 func (in *CollectorConfigSpec) DeepCopyInto(out *CollectorConfigSpec) {
 	*out = *in
 	in.Exporters.DeepCopyInto(&out.Exporters)
+	in.Receivers.DeepCopyInto(&out.Receivers)
+	in.Pipelines.DeepCopyInto(&out.Pipelines)
+	in.Processors.DeepCopyInto(&out.Processors)
+	in.Connectors.DeepCopyInto(&out.Connectors)
+	in.TargetAllocator.DeepCopyInto(&out.TargetAllocator)
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceAccountAnnotations != nil {
+		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.StartupProbe = in.StartupProbe
+	out.SecurityContext = in.SecurityContext
+	in.UpdateStrategy.DeepCopyInto(&out.UpdateStrategy)
+	if in.RolloutOnSecretRotation != nil {
+		in, out := &in.RolloutOnSecretRotation, &out.RolloutOnSecretRotation
+		*out = new(bool)
+		**out = **in
+	}
 	out.Logs = in.Logs
-	out.Metrics = in.Metrics
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	in.DNS.DeepCopyInto(&out.DNS)
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	if in.ConfigRef != nil {
+		in, out := &in.ConfigRef, &out.ConfigRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -54,12 +126,39 @@ func (in *CollectorConfigSpec) DeepCopy() *CollectorConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorConnectorsConfig) DeepCopyInto(out *CollectorConnectorsConfig) {
+	*out = *in
+	in.ServiceGraphConnector.DeepCopyInto(&out.ServiceGraphConnector)
+	in.SpanMetricsConnector.DeepCopyInto(&out.SpanMetricsConnector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorConnectorsConfig.
+func (in *CollectorConnectorsConfig) DeepCopy() *CollectorConnectorsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorConnectorsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorExportersConfig) DeepCopyInto(out *CollectorExportersConfig) {
 	*out = *in
+	in.FileExporter.DeepCopyInto(&out.FileExporter)
 	in.OTLPGRPCExporter.DeepCopyInto(&out.OTLPGRPCExporter)
 	in.OTLPHTTPExporter.DeepCopyInto(&out.OTLPHTTPExporter)
 	in.DebugExporter.DeepCopyInto(&out.DebugExporter)
+	in.GoogleCloudExporter.DeepCopyInto(&out.GoogleCloudExporter)
+	if in.Named != nil {
+		in, out := &in.Named, &out.Named
+		*out = make([]NamedExporterConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -92,6 +191,18 @@ func (in *CollectorLogsConfig) DeepCopy() *CollectorLogsConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorMetricsConfig) DeepCopyInto(out *CollectorMetricsConfig) {
 	*out = *in
+	if in.ResourceAttributes != nil {
+		in, out := &in.ResourceAttributes, &out.ResourceAttributes
+		*out = new(bool)
+		**out = **in
+	}
+	in.Push.DeepCopyInto(&out.Push)
+	in.Prometheus.DeepCopyInto(&out.Prometheus)
+	if in.DisabledMetrics != nil {
+		in, out := &in.DisabledMetrics, &out.DisabledMetrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -105,6 +216,117 @@ func (in *CollectorMetricsConfig) DeepCopy() *CollectorMetricsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorPipelinesConfig) DeepCopyInto(out *CollectorPipelinesConfig) {
+	*out = *in
+	if in.Forward != nil {
+		in, out := &in.Forward, &out.Forward
+		*out = make([]ForwardPipelineConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorPipelinesConfig.
+func (in *CollectorPipelinesConfig) DeepCopy() *CollectorPipelinesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorPipelinesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorProcessorsConfig) DeepCopyInto(out *CollectorProcessorsConfig) {
+	*out = *in
+	in.MetricsTransform.DeepCopyInto(&out.MetricsTransform)
+	in.Batch.DeepCopyInto(&out.Batch)
+	if in.Named != nil {
+		in, out := &in.Named, &out.Named
+		*out = make([]NamedProcessorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.TailSampling = in.TailSampling
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorProcessorsConfig.
+func (in *CollectorProcessorsConfig) DeepCopy() *CollectorProcessorsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorProcessorsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorReceiversConfig) DeepCopyInto(out *CollectorReceiversConfig) {
+	*out = *in
+	in.OTLPReceiver.DeepCopyInto(&out.OTLPReceiver)
+	in.PrometheusReceiver.DeepCopyInto(&out.PrometheusReceiver)
+	in.StatsDReceiver.DeepCopyInto(&out.StatsDReceiver)
+	in.HostMetricsReceiver.DeepCopyInto(&out.HostMetricsReceiver)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorReceiversConfig.
+func (in *CollectorReceiversConfig) DeepCopy() *CollectorReceiversConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorReceiversConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CookiesConfig) DeepCopyInto(out *CookiesConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookiesConfig.
+func (in *CookiesConfig) DeepCopy() *CookiesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CookiesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSConfig) DeepCopyInto(out *DNSConfig) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSConfig.
+func (in *DNSConfig) DeepCopy() *DNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DebugExporterConfig) DeepCopyInto(out *DebugExporterConfig) {
 	*out = *in
@@ -113,6 +335,11 @@ func (in *DebugExporterConfig) DeepCopyInto(out *DebugExporterConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Pipelines != nil {
+		in, out := &in.Pipelines, &out.Pipelines
+		*out = make([]PipelineDebugExporterConfig, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -127,155 +354,1064 @@ func (in *DebugExporterConfig) DeepCopy() *DebugExporterConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OTLPGRPCExporterConfig) DeepCopyInto(out *OTLPGRPCExporterConfig) {
+func (in *FileExporterConfig) DeepCopyInto(out *FileExporterConfig) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(TLSConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(ResourceReference)
+	in.Rotation.DeepCopyInto(&out.Rotation)
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(bool)
 		**out = **in
 	}
-	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPGRPCExporterConfig.
-func (in *OTLPGRPCExporterConfig) DeepCopy() *OTLPGRPCExporterConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileExporterConfig.
+func (in *FileExporterConfig) DeepCopy() *FileExporterConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OTLPGRPCExporterConfig)
+	out := new(FileExporterConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OTLPHTTPExporterConfig) DeepCopyInto(out *OTLPHTTPExporterConfig) {
+func (in *FileExporterRotationConfig) DeepCopyInto(out *FileExporterRotationConfig) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
+	if in.LocalTime != nil {
+		in, out := &in.LocalTime, &out.LocalTime
 		*out = new(bool)
 		**out = **in
 	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(TLSConfig)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileExporterRotationConfig.
+func (in *FileExporterRotationConfig) DeepCopy() *FileExporterRotationConfig {
+	if in == nil {
+		return nil
 	}
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(ResourceReference)
-		**out = **in
+	out := new(FileExporterRotationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardPipelineConfig) DeepCopyInto(out *ForwardPipelineConfig) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Processors != nil {
+		in, out := &in.Processors, &out.Processors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exporters != nil {
+		in, out := &in.Exporters, &out.Exporters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHTTPExporterConfig.
-func (in *OTLPHTTPExporterConfig) DeepCopy() *OTLPHTTPExporterConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardPipelineConfig.
+func (in *ForwardPipelineConfig) DeepCopy() *ForwardPipelineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OTLPHTTPExporterConfig)
+	out := new(ForwardPipelineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+func (in *GatewayConfig) DeepCopyInto(out *GatewayConfig) {
 	*out = *in
-	out.ResourceRef = in.ResourceRef
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
-func (in *ResourceReference) DeepCopy() *ResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfig.
+func (in *GatewayConfig) DeepCopy() *GatewayConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReference)
+	out := new(GatewayConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReferenceDetails) DeepCopyInto(out *ResourceReferenceDetails) {
+func (in *GoogleCloudExporterConfig) DeepCopyInto(out *GoogleCloudExporterConfig) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReferenceDetails.
-func (in *ResourceReferenceDetails) DeepCopy() *ResourceReferenceDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudExporterConfig.
+func (in *GoogleCloudExporterConfig) DeepCopy() *GoogleCloudExporterConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReferenceDetails)
+	out := new(GoogleCloudExporterConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetryOnFailureConfig) DeepCopyInto(out *RetryOnFailureConfig) {
+func (in *HostMetricsReceiverConfig) DeepCopyInto(out *HostMetricsReceiverConfig) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Scrapers != nil {
+		in, out := &in.Scrapers, &out.Scrapers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOnFailureConfig.
-func (in *RetryOnFailureConfig) DeepCopy() *RetryOnFailureConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostMetricsReceiverConfig.
+func (in *HostMetricsReceiverConfig) DeepCopy() *HostMetricsReceiverConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(RetryOnFailureConfig)
+	out := new(HostMetricsReceiverConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+func (in *MetricsPrometheusConfig) DeepCopyInto(out *MetricsPrometheusConfig) {
 	*out = *in
-	if in.InsecureSkipVerify != nil {
-		in, out := &in.InsecureSkipVerify, &out.InsecureSkipVerify
+	if in.WithoutUnits != nil {
+		in, out := &in.WithoutUnits, &out.WithoutUnits
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CA != nil {
-		in, out := &in.CA, &out.CA
-		*out = new(ResourceReference)
+	if in.WithoutTypeSuffix != nil {
+		in, out := &in.WithoutTypeSuffix, &out.WithoutTypeSuffix
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Cert != nil {
-		in, out := &in.Cert, &out.Cert
-		*out = new(ResourceReference)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsPrometheusConfig.
+func (in *MetricsPrometheusConfig) DeepCopy() *MetricsPrometheusConfig {
+	if in == nil {
+		return nil
 	}
-	if in.Key != nil {
-		in, out := &in.Key, &out.Key
-		*out = new(ResourceReference)
+	out := new(MetricsPrometheusConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsPushConfig) DeepCopyInto(out *MetricsPushConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
-func (in *TLSConfig) DeepCopy() *TLSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsPushConfig.
+func (in *MetricsPushConfig) DeepCopy() *MetricsPushConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(TLSConfig)
+	out := new(MetricsPushConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsTransformConfig) DeepCopyInto(out *MetricsTransformConfig) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]MetricsTransformOperationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsTransformConfig.
+func (in *MetricsTransformConfig) DeepCopy() *MetricsTransformConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsTransformConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsTransformOperationConfig) DeepCopyInto(out *MetricsTransformOperationConfig) {
+	*out = *in
+	if in.LabelSet != nil {
+		in, out := &in.LabelSet, &out.LabelSet
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AggregatedValues != nil {
+		in, out := &in.AggregatedValues, &out.AggregatedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsTransformOperationConfig.
+func (in *MetricsTransformOperationConfig) DeepCopy() *MetricsTransformOperationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsTransformOperationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsTransformProcessorConfig) DeepCopyInto(out *MetricsTransformProcessorConfig) {
+	*out = *in
+	if in.Transforms != nil {
+		in, out := &in.Transforms, &out.Transforms
+		*out = make([]MetricsTransformConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsTransformProcessorConfig.
+func (in *MetricsTransformProcessorConfig) DeepCopy() *MetricsTransformProcessorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsTransformProcessorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedBatchProcessorConfig) DeepCopyInto(out *NamedBatchProcessorConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedBatchProcessorConfig.
+func (in *NamedBatchProcessorConfig) DeepCopy() *NamedBatchProcessorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedBatchProcessorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedExporterConfig) DeepCopyInto(out *NamedExporterConfig) {
+	*out = *in
+	if in.OTLPGRPCExporter != nil {
+		in, out := &in.OTLPGRPCExporter, &out.OTLPGRPCExporter
+		*out = new(OTLPGRPCExporterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLPHTTPExporter != nil {
+		in, out := &in.OTLPHTTPExporter, &out.OTLPHTTPExporter
+		*out = new(OTLPHTTPExporterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DebugExporter != nil {
+		in, out := &in.DebugExporter, &out.DebugExporter
+		*out = new(DebugExporterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedExporterConfig.
+func (in *NamedExporterConfig) DeepCopy() *NamedExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedMemoryLimiterProcessorConfig) DeepCopyInto(out *NamedMemoryLimiterProcessorConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedMemoryLimiterProcessorConfig.
+func (in *NamedMemoryLimiterProcessorConfig) DeepCopy() *NamedMemoryLimiterProcessorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedMemoryLimiterProcessorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedProcessorConfig) DeepCopyInto(out *NamedProcessorConfig) {
+	*out = *in
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(NamedBatchProcessorConfig)
+		**out = **in
+	}
+	if in.MemoryLimiter != nil {
+		in, out := &in.MemoryLimiter, &out.MemoryLimiter
+		*out = new(NamedMemoryLimiterProcessorConfig)
+		**out = **in
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(NamedTransformProcessorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedProcessorConfig.
+func (in *NamedProcessorConfig) DeepCopy() *NamedProcessorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedProcessorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedTransformProcessorConfig) DeepCopyInto(out *NamedTransformProcessorConfig) {
+	*out = *in
+	if in.MetricStatements != nil {
+		in, out := &in.MetricStatements, &out.MetricStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogStatements != nil {
+		in, out := &in.LogStatements, &out.LogStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedTransformProcessorConfig.
+func (in *NamedTransformProcessorConfig) DeepCopy() *NamedTransformProcessorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedTransformProcessorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPGRPCExporterConfig) DeepCopyInto(out *OTLPGRPCExporterConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
+	in.SendingQueue.DeepCopyInto(&out.SendingQueue)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPGRPCExporterConfig.
+func (in *OTLPGRPCExporterConfig) DeepCopy() *OTLPGRPCExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPGRPCExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPHTTPExporterConfig) DeepCopyInto(out *OTLPHTTPExporterConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	in.RetryOnFailure.DeepCopyInto(&out.RetryOnFailure)
+	in.SendingQueue.DeepCopyInto(&out.SendingQueue)
+	in.Cookies.DeepCopyInto(&out.Cookies)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHTTPExporterConfig.
+func (in *OTLPHTTPExporterConfig) DeepCopy() *OTLPHTTPExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPHTTPExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiverAuthConfig) DeepCopyInto(out *OTLPReceiverAuthConfig) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Htpasswd != nil {
+		in, out := &in.Htpasswd, &out.Htpasswd
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiverAuthConfig.
+func (in *OTLPReceiverAuthConfig) DeepCopy() *OTLPReceiverAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiverAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiverConfig) DeepCopyInto(out *OTLPReceiverConfig) {
+	*out = *in
+	if in.IncludeMetadata != nil {
+		in, out := &in.IncludeMetadata, &out.IncludeMetadata
+		*out = new(bool)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AllowedClients != nil {
+		in, out := &in.AllowedClients, &out.AllowedClients
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiverConfig.
+func (in *OTLPReceiverConfig) DeepCopy() *OTLPReceiverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineDebugExporterConfig) DeepCopyInto(out *PipelineDebugExporterConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineDebugExporterConfig.
+func (in *PipelineDebugExporterConfig) DeepCopy() *PipelineDebugExporterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineDebugExporterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortConfig) DeepCopyInto(out *PortConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortConfig.
+func (in *PortConfig) DeepCopy() *PortConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PortConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusReceiverConfig) DeepCopyInto(out *PrometheusReceiverConfig) {
+	*out = *in
+	if in.ScrapeConfigs != nil {
+		in, out := &in.ScrapeConfigs, &out.ScrapeConfigs
+		*out = make([]ScrapeConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NativeHistograms != nil {
+		in, out := &in.NativeHistograms, &out.NativeHistograms
+		*out = new(bool)
+		**out = **in
+	}
+	out.Limits = in.Limits
+	if in.UseStartTimeMetric != nil {
+		in, out := &in.UseStartTimeMetric, &out.UseStartTimeMetric
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusReceiverConfig.
+func (in *PrometheusReceiverConfig) DeepCopy() *PrometheusReceiverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusReceiverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+	*out = *in
+	out.ResourceRef = in.ResourceRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReferenceDetails) DeepCopyInto(out *ResourceReferenceDetails) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReferenceDetails.
+func (in *ResourceReferenceDetails) DeepCopy() *ResourceReferenceDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReferenceDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryOnFailureConfig) DeepCopyInto(out *RetryOnFailureConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxElapsedTime != nil {
+		in, out := &in.MaxElapsedTime, &out.MaxElapsedTime
+		*out = new(time.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOnFailureConfig.
+func (in *RetryOnFailureConfig) DeepCopy() *RetryOnFailureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryOnFailureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeAuthorizationConfig) DeepCopyInto(out *ScrapeAuthorizationConfig) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeAuthorizationConfig.
+func (in *ScrapeAuthorizationConfig) DeepCopy() *ScrapeAuthorizationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeAuthorizationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeBasicAuthConfig) DeepCopyInto(out *ScrapeBasicAuthConfig) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeBasicAuthConfig.
+func (in *ScrapeBasicAuthConfig) DeepCopy() *ScrapeBasicAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeBasicAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeConfig) DeepCopyInto(out *ScrapeConfig) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(ScrapeAuthorizationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(ScrapeBasicAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HonorTimestamps != nil {
+		in, out := &in.HonorTimestamps, &out.HonorTimestamps
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrackTimestampsStaleness != nil {
+		in, out := &in.TrackTimestampsStaleness, &out.TrackTimestampsStaleness
+		*out = new(bool)
+		**out = **in
+	}
+	out.Limits = in.Limits
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeConfig.
+func (in *ScrapeConfig) DeepCopy() *ScrapeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeLimitsConfig) DeepCopyInto(out *ScrapeLimitsConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeLimitsConfig.
+func (in *ScrapeLimitsConfig) DeepCopy() *ScrapeLimitsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeLimitsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityContextConfig) DeepCopyInto(out *SecurityContextConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContextConfig.
+func (in *SecurityContextConfig) DeepCopy() *SecurityContextConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityContextConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendingQueueBatchConfig) DeepCopyInto(out *SendingQueueBatchConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendingQueueBatchConfig.
+func (in *SendingQueueBatchConfig) DeepCopy() *SendingQueueBatchConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SendingQueueBatchConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendingQueueConfig) DeepCopyInto(out *SendingQueueConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockOnOverflow != nil {
+		in, out := &in.BlockOnOverflow, &out.BlockOnOverflow
+		*out = new(bool)
+		**out = **in
+	}
+	out.Batch = in.Batch
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendingQueueConfig.
+func (in *SendingQueueConfig) DeepCopy() *SendingQueueConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SendingQueueConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceGraphConnectorConfig) DeepCopyInto(out *ServiceGraphConnectorConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LatencyHistogramBuckets != nil {
+		in, out := &in.LatencyHistogramBuckets, &out.LatencyHistogramBuckets
+		*out = make([]time.Duration, len(*in))
+		copy(*out, *in)
+	}
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceGraphConnectorConfig.
+func (in *ServiceGraphConnectorConfig) DeepCopy() *ServiceGraphConnectorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceGraphConnectorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanMetricsConnectorConfig) DeepCopyInto(out *SpanMetricsConnectorConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Exemplars.DeepCopyInto(&out.Exemplars)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanMetricsConnectorConfig.
+func (in *SpanMetricsConnectorConfig) DeepCopy() *SpanMetricsConnectorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanMetricsConnectorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanMetricsExemplarsConfig) DeepCopyInto(out *SpanMetricsExemplarsConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxPerDataPoint != nil {
+		in, out := &in.MaxPerDataPoint, &out.MaxPerDataPoint
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanMetricsExemplarsConfig.
+func (in *SpanMetricsExemplarsConfig) DeepCopy() *SpanMetricsExemplarsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanMetricsExemplarsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupProbeConfig) DeepCopyInto(out *StartupProbeConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupProbeConfig.
+func (in *StartupProbeConfig) DeepCopy() *StartupProbeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupProbeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatsDReceiverConfig) DeepCopyInto(out *StatsDReceiverConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableMetricType != nil {
+		in, out := &in.EnableMetricType, &out.EnableMetricType
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatsDReceiverConfig.
+func (in *StatsDReceiverConfig) DeepCopy() *StatsDReceiverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StatsDReceiverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
+	*out = *in
+	if in.InsecureSkipVerify != nil {
+		in, out := &in.InsecureSkipVerify, &out.InsecureSkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.IncludeSystemCAs != nil {
+		in, out := &in.IncludeSystemCAs, &out.IncludeSystemCAs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
+func (in *TLSConfig) DeepCopy() *TLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TailSamplingProcessorConfig) DeepCopyInto(out *TailSamplingProcessorConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TailSamplingProcessorConfig.
+func (in *TailSamplingProcessorConfig) DeepCopy() *TailSamplingProcessorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TailSamplingProcessorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetAllocatorConfig) DeepCopyInto(out *TargetAllocatorConfig) {
+	*out = *in
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetAllocatorConfig.
+func (in *TargetAllocatorConfig) DeepCopy() *TargetAllocatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetAllocatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateStrategyConfig) DeepCopyInto(out *UpdateStrategyConfig) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStrategyConfig.
+func (in *UpdateStrategyConfig) DeepCopy() *UpdateStrategyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateStrategyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeConfig) DeepCopyInto(out *VolumeConfig) {
+	*out = *in
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(ResourceReferenceDetails)
+		**out = **in
+	}
+	if in.EmptyDir != nil {
+		in, out := &in.EmptyDir, &out.EmptyDir
+		*out = new(v1.EmptyDirVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeConfig.
+func (in *VolumeConfig) DeepCopy() *VolumeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeConfig)
 	in.DeepCopyInto(out)
 	return out
 }
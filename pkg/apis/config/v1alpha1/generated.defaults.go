@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	json "encoding/json"
 	time "time"
 
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -20,6 +21,33 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 }
 
 func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
+	if in.Spec.Mode == "" {
+		in.Spec.Mode = CollectorMode(CollectorModeStatefulSet)
+	}
+	if in.Spec.Distribution == "" {
+		in.Spec.Distribution = CollectorDistribution(CollectorDistributionContrib)
+	}
+	if in.Spec.Exporters.FileExporter.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.FileExporter.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.FileExporter.Path == "" {
+		in.Spec.Exporters.FileExporter.Path = string(DefaultFileExporterPath)
+	}
+	if in.Spec.Exporters.FileExporter.Format == "" {
+		in.Spec.Exporters.FileExporter.Format = FileExporterFormat(FileExporterFormatJSON)
+	}
+	if in.Spec.Exporters.FileExporter.Rotation.MaxMegabytes == 0 {
+		in.Spec.Exporters.FileExporter.Rotation.MaxMegabytes = int(DefaultFileExporterRotationMaxMegabytes)
+	}
+	if in.Spec.Exporters.FileExporter.Rotation.LocalTime == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.FileExporter.Rotation.LocalTime = &ptrVar1
+	}
+	if in.Spec.Exporters.FileExporter.Fallback == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.FileExporter.Fallback = &ptrVar1
+	}
 	if in.Spec.Exporters.OTLPGRPCExporter.Enabled == nil {
 		var ptrVar1 bool = false
 		in.Spec.Exporters.OTLPGRPCExporter.Enabled = &ptrVar1
@@ -52,12 +80,30 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxInterval == 0 {
 		in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
 	}
-	if in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime == 0 {
-		in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime = time.Duration(DefaultRetryMaxElapsedTime)
+	if in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime == nil {
+		ptrVar1 := time.Duration(DefaultRetryMaxElapsedTime)
+		in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime = &ptrVar1
 	}
 	if in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.Multiplier == 0 {
 		in.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
 	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.NumConsumers == 0 {
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.QueueSize == 0 {
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.BlockOnOverflow == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.BlockOnOverflow = &ptrVar1
+	}
+	if in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Batch.Sizer == "" {
+		in.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Batch.Sizer = RequestSizer(RequestSizerItems)
+	}
 	if in.Spec.Exporters.OTLPGRPCExporter.Compression == "" {
 		in.Spec.Exporters.OTLPGRPCExporter.Compression = Compression(CompressionGzip)
 	}
@@ -96,15 +142,37 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.MaxInterval == 0 {
 		in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
 	}
-	if in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.MaxElapsedTime == 0 {
-		in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.MaxElapsedTime = time.Duration(DefaultRetryMaxElapsedTime)
+	if in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.MaxElapsedTime == nil {
+		ptrVar1 := time.Duration(DefaultRetryMaxElapsedTime)
+		in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.MaxElapsedTime = &ptrVar1
 	}
 	if in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.Multiplier == 0 {
 		in.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
 	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Enabled = &ptrVar1
+	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.NumConsumers == 0 {
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.QueueSize == 0 {
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.BlockOnOverflow == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.BlockOnOverflow = &ptrVar1
+	}
+	if in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Batch.Sizer == "" {
+		in.Spec.Exporters.OTLPHTTPExporter.SendingQueue.Batch.Sizer = RequestSizer(RequestSizerItems)
+	}
 	if in.Spec.Exporters.OTLPHTTPExporter.Compression == "" {
 		in.Spec.Exporters.OTLPHTTPExporter.Compression = Compression(CompressionGzip)
 	}
+	if in.Spec.Exporters.OTLPHTTPExporter.Cookies.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.OTLPHTTPExporter.Cookies.Enabled = &ptrVar1
+	}
 	if in.Spec.Exporters.DebugExporter.Enabled == nil {
 		var ptrVar1 bool = false
 		in.Spec.Exporters.DebugExporter.Enabled = &ptrVar1
@@ -112,6 +180,268 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Exporters.DebugExporter.Verbosity == "" {
 		in.Spec.Exporters.DebugExporter.Verbosity = DebugExporterVerbosity(DebugExporterVerbosityBasic)
 	}
+	for i := range in.Spec.Exporters.DebugExporter.Pipelines {
+		a := &in.Spec.Exporters.DebugExporter.Pipelines[i]
+		if a.Verbosity == "" {
+			a.Verbosity = DebugExporterVerbosity(DebugExporterVerbosityBasic)
+		}
+	}
+	if in.Spec.Exporters.GoogleCloudExporter.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Exporters.GoogleCloudExporter.Enabled = &ptrVar1
+	}
+	for i := range in.Spec.Exporters.Named {
+		a := &in.Spec.Exporters.Named[i]
+		if a.OTLPGRPCExporter != nil {
+			if a.OTLPGRPCExporter.Enabled == nil {
+				var ptrVar1 bool = false
+				a.OTLPGRPCExporter.Enabled = &ptrVar1
+			}
+			if a.OTLPGRPCExporter.TLS != nil {
+				if a.OTLPGRPCExporter.TLS.InsecureSkipVerify == nil {
+					var ptrVar1 bool = false
+					a.OTLPGRPCExporter.TLS.InsecureSkipVerify = &ptrVar1
+				}
+				if a.OTLPGRPCExporter.TLS.ReloadInterval == 0 {
+					a.OTLPGRPCExporter.TLS.ReloadInterval = time.Duration(DefaultTLSReloadInterval)
+				}
+			}
+			if a.OTLPGRPCExporter.Timeout == 0 {
+				a.OTLPGRPCExporter.Timeout = time.Duration(DefaultGRPCExporterClientTimeout)
+			}
+			if a.OTLPGRPCExporter.ReadBufferSize == 0 {
+				a.OTLPGRPCExporter.ReadBufferSize = int(DefaultGRPCExporterClientReadBufferSize)
+			}
+			if a.OTLPGRPCExporter.WriteBufferSize == 0 {
+				a.OTLPGRPCExporter.WriteBufferSize = int(DefaultGRPCExporterClientWriteBufferSize)
+			}
+			if a.OTLPGRPCExporter.RetryOnFailure.Enabled == nil {
+				var ptrVar1 bool = true
+				a.OTLPGRPCExporter.RetryOnFailure.Enabled = &ptrVar1
+			}
+			if a.OTLPGRPCExporter.RetryOnFailure.InitialInterval == 0 {
+				a.OTLPGRPCExporter.RetryOnFailure.InitialInterval = time.Duration(DefaultRetryInitialInterval)
+			}
+			if a.OTLPGRPCExporter.RetryOnFailure.MaxInterval == 0 {
+				a.OTLPGRPCExporter.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
+			}
+			if a.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime == nil {
+				ptrVar1 := time.Duration(DefaultRetryMaxElapsedTime)
+				a.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime = &ptrVar1
+			}
+			if a.OTLPGRPCExporter.RetryOnFailure.Multiplier == 0 {
+				a.OTLPGRPCExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
+			}
+			if a.OTLPGRPCExporter.SendingQueue.Enabled == nil {
+				var ptrVar1 bool = true
+				a.OTLPGRPCExporter.SendingQueue.Enabled = &ptrVar1
+			}
+			if a.OTLPGRPCExporter.SendingQueue.NumConsumers == 0 {
+				a.OTLPGRPCExporter.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+			}
+			if a.OTLPGRPCExporter.SendingQueue.QueueSize == 0 {
+				a.OTLPGRPCExporter.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+			}
+			if a.OTLPGRPCExporter.SendingQueue.BlockOnOverflow == nil {
+				var ptrVar1 bool = false
+				a.OTLPGRPCExporter.SendingQueue.BlockOnOverflow = &ptrVar1
+			}
+			if a.OTLPGRPCExporter.SendingQueue.Batch.Sizer == "" {
+				a.OTLPGRPCExporter.SendingQueue.Batch.Sizer = RequestSizer(RequestSizerItems)
+			}
+			if a.OTLPGRPCExporter.Compression == "" {
+				a.OTLPGRPCExporter.Compression = Compression(CompressionGzip)
+			}
+		}
+		if a.OTLPHTTPExporter != nil {
+			if a.OTLPHTTPExporter.Enabled == nil {
+				var ptrVar1 bool = false
+				a.OTLPHTTPExporter.Enabled = &ptrVar1
+			}
+			if a.OTLPHTTPExporter.TLS != nil {
+				if a.OTLPHTTPExporter.TLS.InsecureSkipVerify == nil {
+					var ptrVar1 bool = false
+					a.OTLPHTTPExporter.TLS.InsecureSkipVerify = &ptrVar1
+				}
+				if a.OTLPHTTPExporter.TLS.ReloadInterval == 0 {
+					a.OTLPHTTPExporter.TLS.ReloadInterval = time.Duration(DefaultTLSReloadInterval)
+				}
+			}
+			if a.OTLPHTTPExporter.Timeout == 0 {
+				a.OTLPHTTPExporter.Timeout = time.Duration(DefaultHTTPExporterClientTimeout)
+			}
+			if a.OTLPHTTPExporter.ReadBufferSize == 0 {
+				a.OTLPHTTPExporter.ReadBufferSize = int(DefaultHTTPExporterClientReadBufferSize)
+			}
+			if a.OTLPHTTPExporter.WriteBufferSize == 0 {
+				a.OTLPHTTPExporter.WriteBufferSize = int(DefaultHTTPExporterClientWriteBufferSize)
+			}
+			if a.OTLPHTTPExporter.Encoding == "" {
+				a.OTLPHTTPExporter.Encoding = MessageEncoding(MessageEncodingProto)
+			}
+			if a.OTLPHTTPExporter.RetryOnFailure.Enabled == nil {
+				var ptrVar1 bool = true
+				a.OTLPHTTPExporter.RetryOnFailure.Enabled = &ptrVar1
+			}
+			if a.OTLPHTTPExporter.RetryOnFailure.InitialInterval == 0 {
+				a.OTLPHTTPExporter.RetryOnFailure.InitialInterval = time.Duration(DefaultRetryInitialInterval)
+			}
+			if a.OTLPHTTPExporter.RetryOnFailure.MaxInterval == 0 {
+				a.OTLPHTTPExporter.RetryOnFailure.MaxInterval = time.Duration(DefaultRetryMaxInterval)
+			}
+			if a.OTLPHTTPExporter.RetryOnFailure.MaxElapsedTime == nil {
+				ptrVar1 := time.Duration(DefaultRetryMaxElapsedTime)
+				a.OTLPHTTPExporter.RetryOnFailure.MaxElapsedTime = &ptrVar1
+			}
+			if a.OTLPHTTPExporter.RetryOnFailure.Multiplier == 0 {
+				a.OTLPHTTPExporter.RetryOnFailure.Multiplier = float64(DefaultRetryMultiplier)
+			}
+			if a.OTLPHTTPExporter.SendingQueue.Enabled == nil {
+				var ptrVar1 bool = true
+				a.OTLPHTTPExporter.SendingQueue.Enabled = &ptrVar1
+			}
+			if a.OTLPHTTPExporter.SendingQueue.NumConsumers == 0 {
+				a.OTLPHTTPExporter.SendingQueue.NumConsumers = int(DefaultSendingQueueNumConsumers)
+			}
+			if a.OTLPHTTPExporter.SendingQueue.QueueSize == 0 {
+				a.OTLPHTTPExporter.SendingQueue.QueueSize = int(DefaultSendingQueueSize)
+			}
+			if a.OTLPHTTPExporter.SendingQueue.BlockOnOverflow == nil {
+				var ptrVar1 bool = false
+				a.OTLPHTTPExporter.SendingQueue.BlockOnOverflow = &ptrVar1
+			}
+			if a.OTLPHTTPExporter.SendingQueue.Batch.Sizer == "" {
+				a.OTLPHTTPExporter.SendingQueue.Batch.Sizer = RequestSizer(RequestSizerItems)
+			}
+			if a.OTLPHTTPExporter.Compression == "" {
+				a.OTLPHTTPExporter.Compression = Compression(CompressionGzip)
+			}
+			if a.OTLPHTTPExporter.Cookies.Enabled == nil {
+				var ptrVar1 bool = false
+				a.OTLPHTTPExporter.Cookies.Enabled = &ptrVar1
+			}
+		}
+		if a.DebugExporter != nil {
+			if a.DebugExporter.Enabled == nil {
+				var ptrVar1 bool = false
+				a.DebugExporter.Enabled = &ptrVar1
+			}
+			if a.DebugExporter.Verbosity == "" {
+				a.DebugExporter.Verbosity = DebugExporterVerbosity(DebugExporterVerbosityBasic)
+			}
+			for j := range a.DebugExporter.Pipelines {
+				b := &a.DebugExporter.Pipelines[j]
+				if b.Verbosity == "" {
+					b.Verbosity = DebugExporterVerbosity(DebugExporterVerbosityBasic)
+				}
+			}
+		}
+	}
+	if in.Spec.Receivers.OTLPReceiver.IncludeMetadata == nil {
+		var ptrVar1 bool = false
+		in.Spec.Receivers.OTLPReceiver.IncludeMetadata = &ptrVar1
+	}
+	for i := range in.Spec.Receivers.PrometheusReceiver.ScrapeConfigs {
+		a := &in.Spec.Receivers.PrometheusReceiver.ScrapeConfigs[i]
+		if a.Authorization != nil {
+			if a.Authorization.Type == "" {
+				a.Authorization.Type = "Bearer"
+			}
+		}
+	}
+	if in.Spec.Receivers.PrometheusReceiver.NativeHistograms == nil {
+		var ptrVar1 bool = false
+		in.Spec.Receivers.PrometheusReceiver.NativeHistograms = &ptrVar1
+	}
+	if in.Spec.Receivers.PrometheusReceiver.UseStartTimeMetric == nil {
+		var ptrVar1 bool = false
+		in.Spec.Receivers.PrometheusReceiver.UseStartTimeMetric = &ptrVar1
+	}
+	if in.Spec.Receivers.StatsDReceiver.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Receivers.StatsDReceiver.Enabled = &ptrVar1
+	}
+	if in.Spec.Receivers.StatsDReceiver.Endpoint == "" {
+		in.Spec.Receivers.StatsDReceiver.Endpoint = string(DefaultStatsDReceiverEndpoint)
+	}
+	if in.Spec.Receivers.StatsDReceiver.AggregationInterval == 0 {
+		in.Spec.Receivers.StatsDReceiver.AggregationInterval = time.Duration(DefaultStatsDReceiverAggregationInterval)
+	}
+	if in.Spec.Receivers.StatsDReceiver.EnableMetricType == nil {
+		var ptrVar1 bool = false
+		in.Spec.Receivers.StatsDReceiver.EnableMetricType = &ptrVar1
+	}
+	if in.Spec.Receivers.HostMetricsReceiver.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Receivers.HostMetricsReceiver.Enabled = &ptrVar1
+	}
+	if in.Spec.Receivers.HostMetricsReceiver.Scrapers == nil {
+		if err := json.Unmarshal([]byte(`["cpu","memory","disk","network","filesystem"]`), &in.Spec.Receivers.HostMetricsReceiver.Scrapers); err != nil {
+			panic(err)
+		}
+	}
+	if in.Spec.Receivers.HostMetricsReceiver.CollectionInterval == 0 {
+		in.Spec.Receivers.HostMetricsReceiver.CollectionInterval = time.Duration(DefaultHostMetricsReceiverCollectionInterval)
+	}
+	for i := range in.Spec.Pipelines.Forward {
+		a := &in.Spec.Pipelines.Forward[i]
+		if a.Processors == nil {
+			if err := json.Unmarshal([]byte(`["memory_limiter","batch"]`), &a.Processors); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for i := range in.Spec.Processors.MetricsTransform.Transforms {
+		a := &in.Spec.Processors.MetricsTransform.Transforms[i]
+		if a.MatchType == "" {
+			a.MatchType = "strict"
+		}
+	}
+	if in.Spec.Processors.ErrorMode == "" {
+		in.Spec.Processors.ErrorMode = ErrorMode(ErrorModePropagate)
+	}
+	if in.Spec.Processors.Batch.Enabled == nil {
+		var ptrVar1 bool = true
+		in.Spec.Processors.Batch.Enabled = &ptrVar1
+	}
+	if in.Spec.Connectors.ServiceGraphConnector.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Connectors.ServiceGraphConnector.Enabled = &ptrVar1
+	}
+	if in.Spec.Connectors.SpanMetricsConnector.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Connectors.SpanMetricsConnector.Enabled = &ptrVar1
+	}
+	if in.Spec.Connectors.SpanMetricsConnector.Exemplars.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Connectors.SpanMetricsConnector.Exemplars.Enabled = &ptrVar1
+	}
+	if in.Spec.TargetAllocator.ServiceDiscoveryRole == "" {
+		in.Spec.TargetAllocator.ServiceDiscoveryRole = ServiceDiscoveryRole(ServiceDiscoveryRoleEndpoints)
+	}
+	if in.Spec.TargetAllocator.RevisionHistoryLimit == nil {
+		ptrVar1 := int32(DefaultTargetAllocatorRevisionHistoryLimit)
+		in.Spec.TargetAllocator.RevisionHistoryLimit = &ptrVar1
+	}
+	if in.Spec.TargetAllocator.ScrapeInterval == 0 {
+		in.Spec.TargetAllocator.ScrapeInterval = time.Duration(DefaultTargetAllocatorScrapeInterval)
+	}
+	if in.Spec.StartupProbe.FailureThreshold == 0 {
+		in.Spec.StartupProbe.FailureThreshold = int32(DefaultStartupProbeFailureThreshold)
+	}
+	if in.Spec.StartupProbe.PeriodSeconds == 0 {
+		in.Spec.StartupProbe.PeriodSeconds = int32(DefaultStartupProbePeriodSeconds)
+	}
+	if in.Spec.SecurityContext.RunAsUser == 0 {
+		in.Spec.SecurityContext.RunAsUser = int64(DefaultRunAsUser)
+	}
+	if in.Spec.SecurityContext.RunAsGroup == 0 {
+		in.Spec.SecurityContext.RunAsGroup = int64(DefaultRunAsGroup)
+	}
+	if in.Spec.RolloutOnSecretRotation == nil {
+		var ptrVar1 bool = true
+		in.Spec.RolloutOnSecretRotation = &ptrVar1
+	}
 	if in.Spec.Logs.Level == "" {
 		in.Spec.Logs.Level = LogLevel(LogLevelInfo)
 	}
@@ -121,4 +451,34 @@ func SetObjectDefaults_CollectorConfig(in *CollectorConfig) {
 	if in.Spec.Metrics.Level == "" {
 		in.Spec.Metrics.Level = MetricsVerbosityLevel(MetricsVerbosityLevelNormal)
 	}
+	if in.Spec.Metrics.ResourceAttributes == nil {
+		var ptrVar1 bool = false
+		in.Spec.Metrics.ResourceAttributes = &ptrVar1
+	}
+	if in.Spec.Metrics.Push.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Metrics.Push.Enabled = &ptrVar1
+	}
+	if in.Spec.Metrics.Push.Protocol == "" {
+		in.Spec.Metrics.Push.Protocol = MetricsPushProtocol(MetricsPushProtocolHTTPProtobuf)
+	}
+	if in.Spec.Metrics.Push.Interval == 0 {
+		in.Spec.Metrics.Push.Interval = time.Duration(DefaultMetricsPushInterval)
+	}
+	if in.Spec.Metrics.Naming == "" {
+		in.Spec.Metrics.Naming = MetricsNaming(MetricsNamingPrometheus)
+	}
+	if in.Spec.Gateway.Enabled == nil {
+		var ptrVar1 bool = false
+		in.Spec.Gateway.Enabled = &ptrVar1
+	}
+	if in.Spec.Gateway.Replicas == 0 {
+		in.Spec.Gateway.Replicas = int32(DefaultGatewayReplicas)
+	}
+	for i := range in.Spec.Ports {
+		a := &in.Spec.Ports[i]
+		if a.Protocol == "" {
+			a.Protocol = "TCP"
+		}
+	}
 }
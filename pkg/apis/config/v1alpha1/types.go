@@ -7,7 +7,9 @@ package v1alpha1
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // MetricsVerbosityLevel specifies the verbosity of the internal collector
@@ -142,6 +144,133 @@ const (
 	// rotated, leading to handshake failures with an expired client cert
 	// until the pod is restarted.
 	DefaultTLSReloadInterval = 30 * time.Second
+
+	// DefaultStartupProbeFailureThreshold specifies the default number of
+	// consecutive failures of the collector startup probe.
+	DefaultStartupProbeFailureThreshold = 30
+	// DefaultStartupProbePeriodSeconds specifies the default period (in
+	// seconds) of the collector startup probe.
+	DefaultStartupProbePeriodSeconds = 10
+
+	// DefaultGatewayReplicas specifies the default number of replicas of
+	// the OTLP gateway.
+	DefaultGatewayReplicas = 2
+
+	// DefaultSendingQueueNumConsumers specifies the default number of
+	// consumers of the sending queue of an exporter.
+	DefaultSendingQueueNumConsumers = 10
+	// DefaultSendingQueueSize specifies the default maximum number of
+	// batches kept in the sending queue of an exporter.
+	DefaultSendingQueueSize = 1000
+
+	// DefaultStatsDReceiverEndpoint specifies the default endpoint of the
+	// StatsD receiver.
+	DefaultStatsDReceiverEndpoint = "0.0.0.0:8125"
+	// DefaultStatsDReceiverAggregationInterval specifies the default
+	// interval, at which the StatsD receiver aggregates the received
+	// metrics.
+	DefaultStatsDReceiverAggregationInterval = 60 * time.Second
+
+	// DefaultTargetAllocatorRevisionHistoryLimit specifies the default
+	// number of old ReplicaSets of the Target Allocator deployment, which
+	// are retained.
+	DefaultTargetAllocatorRevisionHistoryLimit = 2
+	// DefaultTargetAllocatorScrapeInterval specifies the default scrape
+	// interval of the endpoints discovered by the Target Allocator.
+	DefaultTargetAllocatorScrapeInterval = 30 * time.Second
+
+	// DefaultHostMetricsReceiverCollectionInterval specifies the default
+	// interval, at which the host metrics receiver collects the metrics.
+	DefaultHostMetricsReceiverCollectionInterval = 60 * time.Second
+
+	// DefaultFileExporterPath specifies the default path of the file, to
+	// which the file exporter writes the data.
+	DefaultFileExporterPath = "/var/lib/otelcol/file-exporter/data.json"
+	// DefaultFileExporterRotationMaxMegabytes specifies the default
+	// maximum size (in megabytes) of the file written by the file
+	// exporter, before it is rotated.
+	DefaultFileExporterRotationMaxMegabytes = 100
+
+	// DefaultMetricsPushInterval specifies the default interval, at which
+	// the internal metrics of the collector are pushed via OTLP.
+	DefaultMetricsPushInterval = 60 * time.Second
+
+	// DefaultRunAsUser specifies the default UID of the collector, which
+	// is the user of the collector images.
+	DefaultRunAsUser = 10001
+	// DefaultRunAsGroup specifies the default GID of the collector, which
+	// is the group of the collector images.
+	DefaultRunAsGroup = 10001
+)
+
+// CollectorMode specifies the deployment mode of the collector.
+//
+// +k8s:enum
+type CollectorMode string
+
+const (
+	// CollectorModeStatefulSet deploys the collector as a StatefulSet
+	// along with a Target Allocator, which distributes the scrape targets
+	// of the Prometheus receiver.
+	CollectorModeStatefulSet CollectorMode = "statefulset"
+	// CollectorModeDeployment deploys the collector as a stateless
+	// Deployment without a Target Allocator and Prometheus receiver. This
+	// mode is suitable for collectors, which receive data via OTLP only.
+	CollectorModeDeployment CollectorMode = "deployment"
+)
+
+// CollectorDistribution specifies the distribution of the collector, which
+// determines the image and the available components of the collector.
+//
+// +k8s:enum
+type CollectorDistribution string
+
+const (
+	// CollectorDistributionContrib specifies the opentelemetry-collector-contrib
+	// distribution.
+	CollectorDistributionContrib CollectorDistribution = "contrib"
+	// CollectorDistributionK8s specifies the opentelemetry-collector-k8s
+	// distribution, which is tailored to Kubernetes.
+	CollectorDistributionK8s CollectorDistribution = "k8s"
+)
+
+// ErrorMode specifies how the processors, which evaluate OTTL statements or
+// conditions, handle errors.
+//
+// See the link below for more details.
+//
+// https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl#error-mode
+//
+// +k8s:enum
+type ErrorMode string
+
+const (
+	// ErrorModeIgnore logs the errors and continues with the next
+	// statement.
+	ErrorModeIgnore ErrorMode = "ignore"
+	// ErrorModeSilent ignores the errors without logging them and
+	// continues with the next statement.
+	ErrorModeSilent ErrorMode = "silent"
+	// ErrorModePropagate returns the errors up the pipeline, which causes
+	// the payload to be dropped.
+	ErrorModePropagate ErrorMode = "propagate"
+)
+
+// ServiceDiscoveryRole specifies the Kubernetes resource, which is used by the
+// Target Allocator to discover the endpoints of the services selected by the
+// ServiceMonitors.
+//
+// +k8s:enum
+type ServiceDiscoveryRole string
+
+const (
+	// ServiceDiscoveryRoleEndpoints specifies that the endpoints are
+	// discovered via Endpoints.
+	ServiceDiscoveryRoleEndpoints ServiceDiscoveryRole = "Endpoints"
+	// ServiceDiscoveryRoleEndpointSlice specifies that the endpoints are
+	// discovered via EndpointSlices, which scale better for services with
+	// many endpoints.
+	ServiceDiscoveryRoleEndpointSlice ServiceDiscoveryRole = "EndpointSlice"
 )
 
 // RetryOnFailureConfig provides the retry policy for an exporter.
@@ -168,12 +297,12 @@ type RetryOnFailureConfig struct {
 	MaxInterval time.Duration `json:"max_interval,omitzero"`
 
 	// MaxElapsedTime specifies the maximum amount of time spent trying to
-	// send a batch. If set to 0, the retries are never stopped. The default
-	// value is [DefaultRetryMaxElapsedTime].
+	// send a batch. If explicitly set to 0, the retries are never stopped.
+	// The default value is [DefaultRetryMaxElapsedTime].
 	//
 	// +k8s:optional
 	// +default=ref(DefaultRetryMaxElapsedTime)
-	MaxElapsedTime time.Duration `json:"max_elapsed_time,omitzero"`
+	MaxElapsedTime *time.Duration `json:"max_elapsed_time,omitempty"`
 
 	// Multiplier specifies the factor by which the retry interval is
 	// multiplied on each attempt. The default value is
@@ -184,6 +313,80 @@ type RetryOnFailureConfig struct {
 	Multiplier float64 `json:"multiplier,omitzero"`
 }
 
+// SendingQueueConfig provides the sending queue settings for an exporter.
+type SendingQueueConfig struct {
+	// Enabled specifies whether the sending queue is enabled or not.
+	// Default is true.
+	//
+	// +k8s:optional
+	// +default=true
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// NumConsumers specifies the number of consumers, which dequeue
+	// batches from the queue. The default value is
+	// [DefaultSendingQueueNumConsumers].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultSendingQueueNumConsumers)
+	NumConsumers int `json:"num_consumers,omitzero"`
+
+	// QueueSize specifies the maximum number of batches kept in the
+	// queue. The default value is [DefaultSendingQueueSize].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultSendingQueueSize)
+	QueueSize int `json:"queue_size,omitzero"`
+
+	// BlockOnOverflow specifies whether the pipeline is blocked when the
+	// queue is full, which applies backpressure to the receivers, instead
+	// of dropping the data. Default is false. Cannot be enabled along
+	// with unlimited retries, i.e. a max_elapsed_time of 0.
+	//
+	// +k8s:optional
+	// +default=false
+	BlockOnOverflow *bool `json:"block_on_overflow,omitzero"`
+
+	// Batch specifies the sizing of the requests sent by the exporter,
+	// e.g. to not exceed the request body size limit of the backend.
+	//
+	// +k8s:optional
+	Batch SendingQueueBatchConfig `json:"batch,omitzero"`
+}
+
+// RequestSizer specifies how the size of the requests of an exporter is
+// measured.
+//
+// +k8s:enum
+type RequestSizer string
+
+const (
+	// RequestSizerItems measures the size of the requests in the number of
+	// spans, data points or log records.
+	RequestSizerItems RequestSizer = "items"
+	// RequestSizerBytes measures the size of the requests in the number of
+	// bytes of the serialized data.
+	RequestSizerBytes RequestSizer = "bytes"
+)
+
+// SendingQueueBatchConfig provides the settings for the sizing of the
+// requests sent by an exporter. Requests, which exceed the maximum size, are
+// split by the exporter before they are sent.
+type SendingQueueBatchConfig struct {
+	// Sizer specifies how the size of the requests is measured. Valid
+	// options are `items' and `bytes'. Default is `items'.
+	//
+	// +k8s:optional
+	// +default=ref(RequestSizerItems)
+	Sizer RequestSizer `json:"sizer,omitzero"`
+
+	// MaxSize specifies the maximum size of the requests measured by the
+	// sizer, above which the requests are split. The requests are not
+	// split, if not specified.
+	//
+	// +k8s:optional
+	MaxSize int `json:"max_size,omitzero"`
+}
+
 // OTLPHTTPExporterConfig provides the OTLP HTTP Exporter configuration settings.
 //
 // See [OTLP HTTP Exporter] for more details.
@@ -202,6 +405,9 @@ type OTLPHTTPExporterConfig struct {
 	// URL, i.e. for traces "/v1/traces" will appended, for metrics
 	// "/v1/metrics" will be appended, for logs "/v1/logs" will be appended.
 	//
+	// The endpoint may reference the variables of the cluster, e.g.
+	// https://metrics.{{.Region}}.example.com
+	//
 	// +k8s:optional
 	Endpoint string `json:"endpoint,omitzero"`
 
@@ -280,12 +486,35 @@ type OTLPHTTPExporterConfig struct {
 	// +k8s:optional
 	RetryOnFailure RetryOnFailureConfig `json:"retry_on_failure,omitzero"`
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	//
+	// +k8s:optional
+	SendingQueue SendingQueueConfig `json:"sending_queue,omitzero"`
+
 	// Compression specifies the compression to use. The default value is
-	// [CompressionGzip].
+	// [CompressionGzip]. Note that snappy compression cannot be used
+	// with json encoding.
 	//
 	// +k8s:optional
 	// +default=ref(CompressionGzip)
 	Compression Compression `json:"compression,omitzero"`
+
+	// Cookies specifies the cookie handling settings of the HTTP client,
+	// e.g. for cookie-based session affinity of an API gateway in front of
+	// the backend.
+	//
+	// +k8s:optional
+	Cookies CookiesConfig `json:"cookies,omitzero"`
+}
+
+// CookiesConfig provides the cookie handling settings of an HTTP client.
+type CookiesConfig struct {
+	// Enabled specifies whether the HTTP client stores the cookies of the
+	// server responses and sends them with the subsequent requests.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
 }
 
 // DebugExporterVerbosity specifies the verbosity level for the debug exporter.
@@ -315,6 +544,40 @@ type DebugExporterConfig struct {
 	// +k8s:optional
 	// +default=ref(DebugExporterVerbosityBasic)
 	Verbosity DebugExporterVerbosity `json:"verbosity,omitzero"`
+
+	// Pipelines specifies additional debug exporters, which are
+	// configured for a single pipeline only. These exporters are
+	// independent of the debug exporter above, so that a single pipeline
+	// can be debugged without flooding the other pipelines.
+	//
+	// +k8s:optional
+	Pipelines []PipelineDebugExporterConfig `json:"pipelines,omitempty"`
+
+	// Path specifies the absolute path of a file, to which the debug
+	// output is written as JSON instead of the standard output of the
+	// collector, e.g. in order to copy it from the pod via `kubectl cp'.
+	// The exporter is then referenced as `file/debug' by the forward
+	// pipelines, and the verbosity does not apply. The file is written
+	// to a dedicated volume and is rotated at a size of 100 megabytes.
+	//
+	// +k8s:optional
+	Path string `json:"path,omitzero"`
+}
+
+// PipelineDebugExporterConfig provides the settings for a debug exporter,
+// which is configured for a single pipeline only.
+type PipelineDebugExporterConfig struct {
+	// Pipeline specifies the name of the pipeline, e.g. `logs',
+	// `logs/events', `metrics' or the name of a forward pipeline.
+	//
+	// +k8s:required
+	Pipeline string `json:"pipeline"`
+
+	// Verbosity specifies the verbosity level for the debug exporter.
+	//
+	// +k8s:optional
+	// +default=ref(DebugExporterVerbosityBasic)
+	Verbosity DebugExporterVerbosity `json:"verbosity,omitzero"`
 }
 
 // OTLPGRPCExporterConfig provides the OTLP gRPC Exporter config settings.
@@ -335,6 +598,9 @@ type OTLPGRPCExporterConfig struct {
 	//
 	// https://github.com/grpc/grpc/blob/master/doc/naming.md
 	//
+	// The endpoint may reference the variables of the cluster, e.g.
+	// otlp.{{.Region}}.example.com:4317
+	//
 	// +k8s:required
 	Endpoint string `json:"endpoint,omitzero"`
 
@@ -372,6 +638,11 @@ type OTLPGRPCExporterConfig struct {
 	// +k8s:optional
 	RetryOnFailure RetryOnFailureConfig `json:"retry_on_failure,omitzero"`
 
+	// SendingQueue specifies the sending queue settings of the exporter.
+	//
+	// +k8s:optional
+	SendingQueue SendingQueueConfig `json:"sending_queue,omitzero"`
+
 	// Compression specifies the compression to use. The default value is
 	// [CompressionGzip].
 	//
@@ -380,8 +651,136 @@ type OTLPGRPCExporterConfig struct {
 	Compression Compression `json:"compression,omitzero"`
 }
 
+// FileExporterFormat specifies the format of the data written by the file
+// exporter.
+//
+// +k8s:enum
+type FileExporterFormat string
+
+const (
+	// FileExporterFormatJSON specifies that the data is written as JSON
+	// lines.
+	FileExporterFormatJSON FileExporterFormat = "json"
+	// FileExporterFormatProto specifies that the data is written as
+	// length-prefixed protobuf messages.
+	FileExporterFormatProto FileExporterFormat = "proto"
+)
+
+// FileExporterRotationConfig provides the rotation settings of the file
+// written by the file exporter.
+type FileExporterRotationConfig struct {
+	// MaxMegabytes specifies the maximum size (in megabytes) of the file,
+	// before it is rotated. The default value is
+	// [DefaultFileExporterRotationMaxMegabytes].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultFileExporterRotationMaxMegabytes)
+	MaxMegabytes int `json:"max_megabytes,omitzero"`
+
+	// MaxDays specifies the maximum number of days to retain the rotated
+	// files. If set to 0, the rotated files are not removed based on their
+	// age.
+	//
+	// +k8s:optional
+	MaxDays int `json:"max_days,omitzero"`
+
+	// MaxBackups specifies the maximum number of rotated files to retain.
+	// If set to 0, all rotated files are retained.
+	//
+	// +k8s:optional
+	MaxBackups int `json:"max_backups,omitzero"`
+
+	// LocalTime specifies whether the timestamps in the names of the
+	// rotated files use the local time instead of UTC.
+	//
+	// +k8s:optional
+	// +default=false
+	LocalTime *bool `json:"localtime,omitzero"`
+}
+
+// FileExporterConfig provides the File Exporter config settings. The file is
+// written to a writable volume of the collector pods, which is discarded
+// along with the pods.
+//
+// See [File Exporter] for more details.
+//
+// [File Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/fileexporter
+type FileExporterConfig struct {
+	// Enabled specifies whether the file exporter is enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Path specifies the absolute path of the file, to which the data is
+	// written. The default value is [DefaultFileExporterPath].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultFileExporterPath)
+	Path string `json:"path,omitzero"`
+
+	// Format specifies the format of the written data. The default value
+	// is [FileExporterFormatJSON].
+	//
+	// +k8s:optional
+	// +default=ref(FileExporterFormatJSON)
+	Format FileExporterFormat `json:"format,omitzero"`
+
+	// Rotation specifies the rotation settings of the file.
+	//
+	// +k8s:optional
+	Rotation FileExporterRotationConfig `json:"rotation,omitzero"`
+
+	// Fallback specifies whether the file exporter is used as a fallback
+	// of the other exporters only. If enabled, the data is written to the
+	// file only, when the other exporters refuse it, e.g. when their
+	// sending queues are full due to an unavailable backend.
+	//
+	// +k8s:optional
+	// +default=false
+	Fallback *bool `json:"fallback,omitzero"`
+}
+
+// GoogleCloudExporterConfig provides the settings for the Google Cloud
+// exporter, which exports the data to Cloud Monitoring, Cloud Trace and Cloud
+// Logging of a Google Cloud project.
+//
+// The exporter authenticates via Workload Identity, hence the collector must
+// run on a GKE seed with Workload Identity enabled. The service account of the
+// collector is annotated with the Google service account, which must be allowed
+// to write the data to the project.
+//
+// See [Google Cloud Exporter] for more details.
+//
+// [Google Cloud Exporter]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/exporter/googlecloudexporter
+type GoogleCloudExporterConfig struct {
+	// Enabled specifies whether the Google Cloud exporter is enabled or
+	// not. The exporter is available in the `contrib' distribution only.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Project specifies the ID of the Google Cloud project, to which the
+	// data is exported.
+	//
+	// +k8s:optional
+	Project string `json:"project,omitzero"`
+
+	// ServiceAccount specifies the email of the Google service account,
+	// which is impersonated by the collector via Workload Identity.
+	//
+	// +k8s:optional
+	ServiceAccount string `json:"service_account,omitzero"`
+}
+
 // CollectorExportersConfig provides the OTLP exporter settings.
 type CollectorExportersConfig struct {
+	// FileExporter provides the settings for the file exporter.
+	//
+	// +k8s:optional
+	FileExporter FileExporterConfig `json:"file,omitzero"`
+
 	// OTLPGRPCExporter provides the OTLP gRPC Exporter settings.
 	//
 	// +k8s:optional
@@ -396,97 +795,1430 @@ type CollectorExportersConfig struct {
 	//
 	// +k8s:optional
 	DebugExporter DebugExporterConfig `json:"debug,omitzero"`
+
+	// GoogleCloudExporter provides the settings for the Google Cloud
+	// exporter.
+	//
+	// +k8s:optional
+	GoogleCloudExporter GoogleCloudExporterConfig `json:"googlecloud,omitzero"`
+
+	// Named provides additional named instances of the exporters, e.g. a
+	// second OTLP HTTP exporter for another backend. The instances are
+	// configured as `<type>/<name>' in the collector configuration, e.g.
+	// `otlp_http/backup', and are enabled via their settings like the
+	// exporters above.
+	//
+	// +k8s:optional
+	Named []NamedExporterConfig `json:"named,omitempty"`
 }
 
-// CollectorLogsConfig provides the settings for the collector internal logs.
-//
-// See [Configure internal logs] for more details.
-//
-// [Configure internal logs]: https://opentelemetry.io/docs/collector/internal-telemetry/#configure-internal-logs
-type CollectorLogsConfig struct {
-	// Level specifies the log level of the collector.
+// NamedExporterConfig provides the settings for a named instance of an
+// exporter. Exactly one of the exporter types must be specified.
+type NamedExporterConfig struct {
+	// Name specifies the name of the instance, which must be a valid DNS
+	// label of at most 20 characters.
+	Name string `json:"name"`
+
+	// OTLPGRPCExporter provides the OTLP gRPC Exporter settings.
 	//
 	// +k8s:optional
-	// +default=ref(LogLevelInfo)
-	Level LogLevel `json:"level,omitzero"`
+	OTLPGRPCExporter *OTLPGRPCExporterConfig `json:"otlp_grpc,omitempty"`
 
-	// Encoding specifies the encoding for logs of the collector.
+	// OTLPHTTPExporter provides the OTLP HTTP Exporter settings.
 	//
 	// +k8s:optional
-	// +default=ref(LogEncodingConsole)
-	Encoding LogEncoding `json:"encoding,omitzero"`
+	OTLPHTTPExporter *OTLPHTTPExporterConfig `json:"otlp_http,omitempty"`
+
+	// DebugExporter provides the settings for the debug exporter.
+	//
+	// +k8s:optional
+	DebugExporter *DebugExporterConfig `json:"debug,omitempty"`
 }
 
-// CollectorMetricsConfig provides the settings for the collector internal
-// metrics.
+// OTLPReceiverConfig provides the OTLP Receiver configuration settings.
 //
-// See [Metrics verbosity] for more details.
+// See [OTLP Receiver] for more details.
 //
-// [Metrics verbosity]: https://opentelemetry.io/docs/collector/internal-telemetry/#metric-verbosity
-type CollectorMetricsConfig struct {
-	// Level specifies the collector internal metrics verbosity level.
+// [OTLP Receiver]: https://github.com/open-telemetry/opentelemetry-collector/tree/main/receiver/otlpreceiver
+type OTLPReceiverConfig struct {
+	// IncludeMetadata specifies whether the client metadata (e.g. the
+	// incoming request headers) is propagated to the pipeline context.
+	// This is required by components such as the `headers_setter'
+	// extension, which rely on the request context for tenant routing.
 	//
 	// +k8s:optional
-	// +default=ref(MetricsVerbosityLevelNormal)
-	Level MetricsVerbosityLevel `json:"level,omitzero"`
-}
+	// +default=false
+	IncludeMetadata *bool `json:"include_metadata,omitzero"`
 
-// CollectorConfigSpec specifies the desired state of [CollectorConfig]
-type CollectorConfigSpec struct {
-	// Exporters specifies the exporters configuration of the collector.
+	// Auth specifies the authentication settings for the clients of the
+	// receiver. The receiver of the OTLP gateway is configured instead,
+	// if the gateway is enabled.
 	//
-	// +k8s:required
-	Exporters CollectorExportersConfig `json:"exporters,omitzero"`
+	// +k8s:optional
+	Auth OTLPReceiverAuthConfig `json:"auth,omitzero"`
 
-	// Logs specifies the settings for the collector logs.
+	// AllowedClients selects the pods in the control plane namespace of
+	// the shoot, which are allowed to push to the receiver. If specified,
+	// the receiver is no longer reachable from all scrape targets, and
+	// NetworkPolicies allow the traffic from the selected pods only.
 	//
 	// +k8s:optional
-	Logs CollectorLogsConfig `json:"logs,omitzero"`
+	AllowedClients []metav1.LabelSelector `json:"allowed_clients,omitempty"`
+}
 
-	// Metrics specifies the settings for the internal collector metrics.
+// OTLPReceiverAuthConfig provides the authentication settings for the clients
+// of the OTLP receiver.
+type OTLPReceiverAuthConfig struct {
+	// Token references the bearer token, which the clients must provide.
+	// Cannot be specified along with Htpasswd.
 	//
 	// +k8s:optional
-	Metrics CollectorMetricsConfig `json:"metrics,omitzero"`
+	Token *ResourceReference `json:"token,omitempty"`
+
+	// Htpasswd references the htpasswd file with the credentials of the
+	// clients for basic authentication. Cannot be specified along with
+	// Token.
+	//
+	// +k8s:optional
+	Htpasswd *ResourceReference `json:"htpasswd,omitempty"`
 }
 
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// ScrapeAuthorizationConfig provides the settings for the Authorization
+// header of the scrape requests.
+type ScrapeAuthorizationConfig struct {
+	// Type specifies the authentication type of the header.
+	//
+	// +k8s:optional
+	// +default="Bearer"
+	Type string `json:"type,omitempty"`
 
-// CollectorConfig provides the OpenTelemetry Collector API configuration.
-type CollectorConfig struct {
-	metav1.TypeMeta `json:",inline"`
+	// Credentials references the credentials for the header.
+	//
+	// +k8s:required
+	Credentials *ResourceReference `json:"credentials,omitempty"`
+}
 
-	// Spec provides the extension configuration spec.
-	Spec CollectorConfigSpec `json:"spec,omitzero"`
+// ScrapeBasicAuthConfig provides the basic authentication settings of the
+// scrape requests.
+type ScrapeBasicAuthConfig struct {
+	// Username specifies the username for basic authentication.
+	//
+	// +k8s:required
+	Username string `json:"username"`
+
+	// Password references the password for basic authentication.
+	//
+	// +k8s:required
+	Password *ResourceReference `json:"password,omitempty"`
 }
 
-// TLSConfig provides the TLS settings used by exporters.
-type TLSConfig struct {
-	// InsecureSkipVerify specifies whether to skip verifying the
-	// certificate or not.
+// ScrapeConfig provides the settings for an additional scrape job of the
+// Prometheus receiver.
+type ScrapeConfig struct {
+	// JobName specifies the name of the scrape job.
+	//
+	// +k8s:required
+	JobName string `json:"job_name"`
+
+	// Targets specifies the static targets of the job in the form of
+	// `host:port'.
+	//
+	// +k8s:required
+	Targets []string `json:"targets"`
+
+	// MetricsPath specifies the HTTP resource path, from which metrics
+	// are fetched. Defaults to `/metrics', if not specified.
+	//
 	// +k8s:optional
-	// +default=false
-	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
-	// CA references the CA certificate to use for verifying the server certificate.
-	// For a client this verifies the server certificate.
-	// For a server this verifies client certificates.
-	// If empty uses system root CA.
+	MetricsPath string `json:"metrics_path,omitempty"`
+
+	// Scheme specifies the URL scheme used for the scrape requests. Valid
+	// options are `http' and `https'. Defaults to `http', if not
+	// specified.
 	//
 	// +k8s:optional
-	CA *ResourceReference `json:"ca,omitempty"`
-	// Cert references the client certificate to use for TLS required connections.
+	Scheme string `json:"scheme,omitempty"`
+
+	// ScrapeInterval specifies how frequently the targets are scraped.
 	//
 	// +k8s:optional
-	Cert *ResourceReference `json:"cert,omitempty"`
-	// Key references the client key to use for TLS required connections.
+	ScrapeInterval time.Duration `json:"scrape_interval,omitzero"`
+
+	// Authorization specifies the Authorization header of the scrape
+	// requests. Cannot be specified along with BasicAuth.
 	//
 	// +k8s:optional
-	Key *ResourceReference `json:"key,omitempty"`
-	// ReloadInterval specifies mTLS key and cert reload interval
-	// from mounted secret volume
+	Authorization *ScrapeAuthorizationConfig `json:"authorization,omitempty"`
+
+	// BasicAuth specifies the basic authentication settings of the
+	// scrape requests. Cannot be specified along with Authorization.
 	//
 	// +k8s:optional
-	// +default=ref(DefaultTLSReloadInterval)
-	ReloadInterval time.Duration `json:"reloadInterval,omitzero"`
+	BasicAuth *ScrapeBasicAuthConfig `json:"basic_auth,omitempty"`
+
+	// EnableHTTP2 specifies whether HTTP/2 is enabled for the scrape
+	// requests. Defaults to the setting of the Prometheus receiver, if
+	// not specified.
+	//
+	// +k8s:optional
+	EnableHTTP2 *bool `json:"enable_http2,omitzero"`
+
+	// FollowRedirects specifies whether the scrape requests follow HTTP
+	// 3xx redirects. Defaults to the setting of the Prometheus receiver,
+	// if not specified.
+	//
+	// +k8s:optional
+	FollowRedirects *bool `json:"follow_redirects,omitzero"`
+
+	// ProxyURL specifies the URL of the proxy used for the scrape
+	// requests. Valid schemes are `http', `https' and `socks5'.
+	//
+	// +k8s:optional
+	ProxyURL string `json:"proxy_url,omitempty"`
+
+	// BodySizeLimit specifies the maximum size of the uncompressed
+	// response body of a scrape, e.g. `10MB'. Scrapes with larger bodies
+	// fail. The size of the body is not limited, if not specified or
+	// `0'.
+	//
+	// +k8s:optional
+	BodySizeLimit string `json:"body_size_limit,omitempty"`
+
+	// HonorTimestamps specifies whether the timestamps exposed by the
+	// target are kept. The time of the scrape is used instead, if
+	// disabled. Defaults to the setting of the Prometheus receiver, which
+	// keeps the timestamps, if not specified.
+	//
+	// +k8s:optional
+	HonorTimestamps *bool `json:"honor_timestamps,omitzero"`
+
+	// TrackTimestampsStaleness specifies whether the samples with
+	// timestamps exposed by the target are marked as stale, when they
+	// disappear from the target. Requires the timestamps to be honored.
+	// Defaults to the setting of the Prometheus receiver, which does not
+	// track the staleness, if not specified.
+	//
+	// +k8s:optional
+	TrackTimestampsStaleness *bool `json:"track_timestamps_staleness,omitzero"`
+
+	// Limits specifies the limits of the scraped samples and labels of
+	// the job, which take precedence over the limits of the receiver.
+	//
+	// +k8s:optional
+	Limits ScrapeLimitsConfig `json:"limits,omitzero"`
+}
+
+// ScrapeLimitsConfig provides the limits of the scraped samples and labels,
+// which protect the collector against a cardinality explosion. Scrapes,
+// which exceed any of the limits, fail. A value of zero means no limit.
+type ScrapeLimitsConfig struct {
+	// SampleLimit specifies the maximum number of samples per scrape.
+	//
+	// +k8s:optional
+	SampleLimit int `json:"sample_limit,omitzero"`
+
+	// LabelLimit specifies the maximum number of labels per sample.
+	//
+	// +k8s:optional
+	LabelLimit int `json:"label_limit,omitzero"`
+
+	// LabelNameLengthLimit specifies the maximum length of a label name.
+	//
+	// +k8s:optional
+	LabelNameLengthLimit int `json:"label_name_length_limit,omitzero"`
+
+	// LabelValueLengthLimit specifies the maximum length of a label value.
+	//
+	// +k8s:optional
+	LabelValueLengthLimit int `json:"label_value_length_limit,omitzero"`
+}
+
+// PrometheusReceiverConfig provides the Prometheus Receiver configuration
+// settings.
+//
+// See [Prometheus Receiver] for more details.
+//
+// [Prometheus Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/prometheusreceiver
+type PrometheusReceiverConfig struct {
+	// ScrapeConfigs specifies additional scrape jobs with static targets,
+	// which are scraped by the receiver along with the targets provided
+	// by the Target Allocator. The credentials of the jobs are referenced
+	// from the Secrets specified in `.spec.resources' of the Shoot.
+	//
+	// +k8s:optional
+	ScrapeConfigs []ScrapeConfig `json:"scrape_configs,omitempty"`
+
+	// NativeHistograms specifies whether native histograms are scraped
+	// and preserved by the receiver. Native histograms are converted to
+	// exponential histograms, which are passed through as-is by the OTLP
+	// exporters. Note that exemplars are preserved regardless of this
+	// setting.
+	//
+	// +k8s:optional
+	// +default=false
+	NativeHistograms *bool `json:"native_histograms,omitzero"`
+
+	// Limits specifies the default limits of the scraped samples and
+	// labels of all scrape jobs of the receiver, including the jobs
+	// provided by the Target Allocator.
+	//
+	// +k8s:optional
+	Limits ScrapeLimitsConfig `json:"limits,omitzero"`
+
+	// UseStartTimeMetric specifies whether the start time of the
+	// cumulative metrics is derived from the start time metric of the
+	// scraped target, e.g. `process_start_time_seconds', instead of the
+	// time of the first scrape. This keeps the rates of the counters
+	// correct across restarts of the targets.
+	//
+	// +k8s:optional
+	// +default=false
+	UseStartTimeMetric *bool `json:"use_start_time_metric,omitzero"`
+
+	// StartTimeMetricRegex specifies the regular expression matching the
+	// name of the start time metric. The receiver uses the
+	// `process_start_time_seconds' metric, if not specified.
+	//
+	// +k8s:optional
+	StartTimeMetricRegex string `json:"start_time_metric_regex,omitzero"`
+}
+
+// StatsDReceiverConfig provides the StatsD Receiver configuration settings.
+// The received metrics are processed by the `metrics' pipeline, hence the
+// receiver is not supported in deployment mode.
+//
+// See [StatsD Receiver] for more details.
+//
+// [StatsD Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/statsdreceiver
+type StatsDReceiverConfig struct {
+	// Enabled specifies whether the StatsD receiver is enabled or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Endpoint specifies the UDP address in the form of `host:port', on
+	// which the receiver listens. The port is exposed by the collector.
+	// Default value is [DefaultStatsDReceiverEndpoint].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultStatsDReceiverEndpoint)
+	Endpoint string `json:"endpoint,omitzero"`
+
+	// AggregationInterval specifies the interval, at which the received
+	// metrics are aggregated and passed to the pipeline. Default value is
+	// [DefaultStatsDReceiverAggregationInterval].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultStatsDReceiverAggregationInterval)
+	AggregationInterval time.Duration `json:"aggregation_interval,omitzero"`
+
+	// EnableMetricType specifies whether the StatsD metric type (e.g.
+	// `counter' or `gauge') is added as the `metric_type' attribute to
+	// the metrics.
+	//
+	// +k8s:optional
+	// +default=false
+	EnableMetricType *bool `json:"enable_metric_type,omitzero"`
+}
+
+// HostMetricsReceiverConfig provides the Host Metrics Receiver configuration
+// settings. The receiver is run by a separate collector in daemonset mode on
+// every node of the seed cluster, which mounts the root filesystem of the
+// node. Its metrics are forwarded to the `metrics' pipeline of the collector,
+// hence the receiver is not supported in deployment mode.
+//
+// The receiver is only supported by seed-class extensions and must be allowed
+// by the operator of the extension.
+//
+// See [Host Metrics Receiver] for more details.
+//
+// [Host Metrics Receiver]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/receiver/hostmetricsreceiver
+type HostMetricsReceiverConfig struct {
+	// Enabled specifies whether the host metrics receiver is enabled or
+	// not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Scrapers specifies the scrapers of the receiver, i.e. `cpu',
+	// `disk', `filesystem', `load', `memory', `network', `paging',
+	// `processes' and `system'.
+	//
+	// +k8s:optional
+	// +default=["cpu","memory","disk","network","filesystem"]
+	Scrapers []string `json:"scrapers,omitempty"`
+
+	// CollectionInterval specifies the interval, at which the metrics are
+	// collected. Default value is
+	// [DefaultHostMetricsReceiverCollectionInterval].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultHostMetricsReceiverCollectionInterval)
+	CollectionInterval time.Duration `json:"collection_interval,omitzero"`
+}
+
+// CollectorReceiversConfig provides the collector receivers settings.
+type CollectorReceiversConfig struct {
+	// OTLPReceiver provides the OTLP Receiver settings.
+	//
+	// +k8s:optional
+	OTLPReceiver OTLPReceiverConfig `json:"otlp,omitzero"`
+
+	// PrometheusReceiver provides the Prometheus Receiver settings.
+	//
+	// +k8s:optional
+	PrometheusReceiver PrometheusReceiverConfig `json:"prometheus,omitzero"`
+
+	// StatsDReceiver provides the StatsD Receiver settings.
+	//
+	// +k8s:optional
+	StatsDReceiver StatsDReceiverConfig `json:"statsd,omitzero"`
+
+	// HostMetricsReceiver provides the Host Metrics Receiver settings.
+	//
+	// +k8s:optional
+	HostMetricsReceiver HostMetricsReceiverConfig `json:"hostmetrics,omitzero"`
+}
+
+// ForwardPipelineConfig provides the settings for an additional pipeline of
+// the collector, which receives data from other pipelines via the [Forward
+// Connector].
+//
+// [Forward Connector]: https://github.com/open-telemetry/opentelemetry-collector/tree/main/connector/forwardconnector
+type ForwardPipelineConfig struct {
+	// Name specifies the name of the pipeline, which is prefixed with the
+	// signal type of the pipeline, e.g. `logs/archive'.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// From specifies the names of the pipelines, which forward their data
+	// to this pipeline. These can be either the pipelines managed by the
+	// extension (`logs', `logs/events' and `metrics'), or forward
+	// pipelines, which are specified before this one.
+	//
+	// +k8s:required
+	From []string `json:"from"`
+
+	// Processors specifies the names of the processors of the pipeline in
+	// the order they are applied, i.e. `memory_limiter', `batch' and the
+	// named processors, e.g. `batch/large'. If not specified, the
+	// `memory_limiter' and `batch' processors are used. An empty list
+	// disables the processing of the pipeline.
+	//
+	// +k8s:optional
+	// +default=["memory_limiter","batch"]
+	Processors []string `json:"processors,omitempty"`
+
+	// Exporters specifies the names of the exporters of the pipeline. If
+	// not specified, all enabled exporters are used.
+	//
+	// +k8s:optional
+	Exporters []string `json:"exporters,omitempty"`
+}
+
+// CollectorPipelinesConfig provides the settings for the additional pipelines
+// of the collector.
+type CollectorPipelinesConfig struct {
+	// Forward specifies the pipelines, which are chained to other pipelines
+	// via the forward connector.
+	//
+	// +k8s:optional
+	Forward []ForwardPipelineConfig `json:"forward,omitempty"`
+}
+
+// StartupProbeConfig provides the settings for the startup probe of the
+// collector.
+//
+// The liveness probe of the collector is not performed until the startup
+// probe succeeds, so the collector has at most FailureThreshold *
+// PeriodSeconds seconds to start up, e.g. while loading a large set of scrape
+// targets from the Target Allocator.
+type StartupProbeConfig struct {
+	// FailureThreshold specifies the number of consecutive failures of the
+	// probe, after which the collector container is restarted. Default
+	// value is [DefaultStartupProbeFailureThreshold].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultStartupProbeFailureThreshold)
+	FailureThreshold int32 `json:"failure_threshold,omitzero"`
+
+	// PeriodSeconds specifies how often (in seconds) the probe is
+	// performed. Default value is [DefaultStartupProbePeriodSeconds].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultStartupProbePeriodSeconds)
+	PeriodSeconds int32 `json:"period_seconds,omitzero"`
+}
+
+// UpdateStrategyConfig provides the settings of the rolling update of the
+// collector pods.
+//
+// Note that the OTel Operator exposes the update strategy of Deployments only,
+// hence the settings apply to the collector in `deployment' mode and to the
+// OTLP gateway, but not to the collector in `statefulset' mode.
+type UpdateStrategyConfig struct {
+	// MaxUnavailable specifies the maximum number or percentage of pods,
+	// which are unavailable during the update.
+	//
+	// +k8s:optional
+	MaxUnavailable *intstr.IntOrString `json:"max_unavailable,omitempty"`
+
+	// MaxSurge specifies the maximum number or percentage of pods, which
+	// are created in addition to the desired number of pods during the
+	// update.
+	//
+	// +k8s:optional
+	MaxSurge *intstr.IntOrString `json:"max_surge,omitempty"`
+}
+
+// SecurityContextConfig provides the settings of the security context of the
+// collector pods, which run as non-root with the `RuntimeDefault' seccomp
+// profile.
+type SecurityContextConfig struct {
+	// RunAsUser specifies the UID, with which the collector runs. Default
+	// value is [DefaultRunAsUser].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultRunAsUser)
+	RunAsUser int64 `json:"run_as_user,omitzero"`
+
+	// RunAsGroup specifies the GID, with which the collector runs. It
+	// also owns the volumes of the collector pods. Default value is
+	// [DefaultRunAsGroup].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultRunAsGroup)
+	RunAsGroup int64 `json:"run_as_group,omitzero"`
+}
+
+// GatewayConfig provides the settings for the OTLP gateway, which is deployed
+// in front of the collector.
+//
+// When enabled, a lightweight and stateless collector (the receiver tier) is
+// deployed, which receives the OTLP data and forwards it via OTLP to the
+// collector (the processing tier), which processes and exports it.
+type GatewayConfig struct {
+	// Enabled specifies whether the OTLP gateway is deployed or not. The
+	// gateway is supported in statefulset mode only.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Replicas specifies the number of replicas of the OTLP gateway.
+	// Default value is [DefaultGatewayReplicas].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultGatewayReplicas)
+	Replicas int32 `json:"replicas,omitzero"`
+}
+
+// DNSConfig provides the DNS settings of the collector and Target Allocator
+// pods.
+type DNSConfig struct {
+	// Policy specifies the DNS policy of the pods. Valid options are
+	// `ClusterFirst', `ClusterFirstWithHostNet', `Default' and `None'.
+	// Defaults to `ClusterFirst', if not specified.
+	//
+	// +k8s:optional
+	Policy corev1.DNSPolicy `json:"policy,omitzero"`
+
+	// Config specifies the DNS parameters of the pods, which are merged
+	// with the ones generated from the DNS policy. It is required when
+	// the `None' DNS policy is used.
+	//
+	// +k8s:optional
+	Config *corev1.PodDNSConfig `json:"config,omitzero"`
+}
+
+// CollectorLogsConfig provides the settings for the collector internal logs.
+//
+// See [Configure internal logs] for more details.
+//
+// [Configure internal logs]: https://opentelemetry.io/docs/collector/internal-telemetry/#configure-internal-logs
+type CollectorLogsConfig struct {
+	// Level specifies the log level of the collector.
+	//
+	// +k8s:optional
+	// +default=ref(LogLevelInfo)
+	Level LogLevel `json:"level,omitzero"`
+
+	// Encoding specifies the encoding for logs of the collector.
+	//
+	// +k8s:optional
+	// +default=ref(LogEncodingConsole)
+	Encoding LogEncoding `json:"encoding,omitzero"`
+}
+
+// CollectorMetricsConfig provides the settings for the collector internal
+// metrics.
+//
+// See [Metrics verbosity] for more details.
+//
+// [Metrics verbosity]: https://opentelemetry.io/docs/collector/internal-telemetry/#metric-verbosity
+type CollectorMetricsConfig struct {
+	// Level specifies the collector internal metrics verbosity level.
+	//
+	// +k8s:optional
+	// +default=ref(MetricsVerbosityLevelNormal)
+	Level MetricsVerbosityLevel `json:"level,omitzero"`
+
+	// ResourceAttributes specifies whether the internal telemetry of the
+	// collector carries the resource attributes of the cluster, i.e.
+	// `k8s.cluster.name', `gardener.project.name', `gardener.shoot.name'
+	// and `gardener.seed.name', so that it is distinguishable from the
+	// internal telemetry of other collectors.
+	//
+	// +k8s:optional
+	// +default=false
+	ResourceAttributes *bool `json:"resource_attributes,omitzero"`
+
+	// Push provides the settings of the periodic reader, which pushes the
+	// internal metrics via OTLP in addition to exposing them for the
+	// self-scrape of the collector.
+	//
+	// +k8s:optional
+	Push MetricsPushConfig `json:"push,omitzero"`
+
+	// Naming specifies the naming convention of the internal metrics
+	// exposed for the self-scrape of the collector. Valid options are
+	// `prometheus' and `otel'.
+	//
+	// +k8s:optional
+	// +default=ref(MetricsNamingPrometheus)
+	Naming MetricsNaming `json:"naming,omitzero"`
+
+	// Prometheus provides the settings of the Prometheus reader, which
+	// exposes the internal metrics for the self-scrape of the collector.
+	//
+	// +k8s:optional
+	Prometheus MetricsPrometheusConfig `json:"prometheus,omitzero"`
+
+	// DisabledMetrics specifies the names of the instruments of the
+	// internal metrics, which are dropped via views of the internal
+	// telemetry, e.g. `otelcol_processor_batch_batch_send_size'. The
+	// names may contain the `*' and `?' wildcards. This reduces the
+	// cardinality of the internal metrics, e.g. of the per-component
+	// histograms, beyond the verbosity level.
+	//
+	// +k8s:optional
+	DisabledMetrics []string `json:"disabled_metrics,omitempty"`
+}
+
+// MetricsNaming specifies the naming convention of the internal metrics of the
+// collector exposed by the Prometheus reader.
+//
+// +k8s:enum
+type MetricsNaming string
+
+const (
+	// MetricsNamingPrometheus specifies that the names of the internal
+	// metrics carry the unit and type suffixes, e.g.
+	// `otelcol_exporter_sent_metric_points_total'.
+	MetricsNamingPrometheus MetricsNaming = "prometheus"
+	// MetricsNamingOTel specifies that the names of the internal metrics
+	// match the names of the OTel instruments, i.e. without the unit and
+	// type suffixes, e.g. `otelcol_exporter_sent_metric_points'.
+	MetricsNamingOTel MetricsNaming = "otel"
+)
+
+// MetricsPrometheusConfig provides the settings of the Prometheus reader,
+// which exposes the internal metrics of the collector.
+type MetricsPrometheusConfig struct {
+	// WithoutUnits specifies whether the unit suffix is omitted from the
+	// names of the internal metrics. Implied by the `otel' naming.
+	//
+	// +k8s:optional
+	WithoutUnits *bool `json:"without_units,omitzero"`
+
+	// WithoutTypeSuffix specifies whether the type suffix, e.g. `_total'
+	// for counters, is omitted from the names of the internal metrics.
+	// Implied by the `otel' naming.
+	//
+	// +k8s:optional
+	WithoutTypeSuffix *bool `json:"without_type_suffix,omitzero"`
+}
+
+// MetricsPushProtocol specifies the protocol of the periodic reader, which
+// pushes the internal metrics of the collector via OTLP.
+//
+// +k8s:enum
+type MetricsPushProtocol string
+
+const (
+	// MetricsPushProtocolGRPC specifies that the internal metrics are
+	// pushed via OTLP/gRPC.
+	MetricsPushProtocolGRPC MetricsPushProtocol = "grpc"
+	// MetricsPushProtocolHTTPProtobuf specifies that the internal metrics
+	// are pushed via OTLP/HTTP with protobuf encoding.
+	MetricsPushProtocolHTTPProtobuf MetricsPushProtocol = "http/protobuf"
+)
+
+// MetricsPushConfig provides the settings of the periodic reader, which
+// pushes the internal metrics of the collector via OTLP.
+//
+// Note that the CA, the client certificate and the token of the OTLP HTTP
+// exporter are only used along with its endpoint. An explicit endpoint must
+// accept the metrics without a bearer token.
+type MetricsPushConfig struct {
+	// Enabled specifies whether the internal metrics are pushed or not.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Endpoint specifies the URL, to which the internal metrics are
+	// pushed. If empty, the metrics endpoint of the OTLP HTTP exporter is
+	// used along with its CA, client certificate and token, which requires
+	// the `http/protobuf' protocol and an exporter, which does not skip the
+	// verification of the server certificate.
+	//
+	// +k8s:optional
+	Endpoint string `json:"endpoint,omitzero"`
+
+	// Protocol specifies the protocol, via which the internal metrics are
+	// pushed. Valid options are `grpc' and `http/protobuf'.
+	//
+	// +k8s:optional
+	// +default=ref(MetricsPushProtocolHTTPProtobuf)
+	Protocol MetricsPushProtocol `json:"protocol,omitzero"`
+
+	// Interval specifies the interval, at which the internal metrics are
+	// pushed. Default value is [DefaultMetricsPushInterval].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultMetricsPushInterval)
+	Interval time.Duration `json:"interval,omitzero"`
+}
+
+// MetricsTransformOperationConfig provides the settings for an operation of a
+// transform of the Metrics Transform processor.
+type MetricsTransformOperationConfig struct {
+	// Action specifies the action of the operation. Valid options are
+	// `add_label', `update_label', `delete_label_value',
+	// `aggregate_labels' and `aggregate_label_values'.
+	//
+	// +k8s:required
+	Action string `json:"action"`
+
+	// Label specifies the label the operation applies to. Required by
+	// the `update_label', `delete_label_value' and
+	// `aggregate_label_values' actions.
+	//
+	// +k8s:optional
+	Label string `json:"label,omitempty"`
+
+	// NewLabel specifies the new name of the label. Required by the
+	// `add_label' and `update_label' actions.
+	//
+	// +k8s:optional
+	NewLabel string `json:"new_label,omitempty"`
+
+	// LabelValue specifies the label value, which is deleted by the
+	// `delete_label_value' action.
+	//
+	// +k8s:optional
+	LabelValue string `json:"label_value,omitempty"`
+
+	// NewValue specifies the value of the label. Required by the
+	// `add_label' and `aggregate_label_values' actions.
+	//
+	// +k8s:optional
+	NewValue string `json:"new_value,omitempty"`
+
+	// LabelSet specifies the labels, which are kept by the
+	// `aggregate_labels' action.
+	//
+	// +k8s:optional
+	LabelSet []string `json:"label_set,omitempty"`
+
+	// AggregatedValues specifies the label values, which are aggregated
+	// by the `aggregate_label_values' action.
+	//
+	// +k8s:optional
+	AggregatedValues []string `json:"aggregated_values,omitempty"`
+
+	// AggregationType specifies how the data points are aggregated by the
+	// `aggregate_labels' and `aggregate_label_values' actions. Valid
+	// options are `sum', `mean', `min', `max', `count' and `median'.
+	//
+	// +k8s:optional
+	AggregationType string `json:"aggregation_type,omitempty"`
+}
+
+// MetricsTransformConfig provides the settings for a transform of the Metrics
+// Transform processor.
+type MetricsTransformConfig struct {
+	// Include specifies the name of the metrics, which are transformed.
+	//
+	// +k8s:required
+	Include string `json:"include"`
+
+	// MatchType specifies how Include is matched against the metric
+	// names. Valid options are `strict' and `regexp'.
+	//
+	// +k8s:optional
+	// +default="strict"
+	MatchType string `json:"match_type,omitempty"`
+
+	// Action specifies the action of the transform. Valid options are
+	// `update', `insert' and `combine'. The `combine' action requires the
+	// `regexp' match type.
+	//
+	// +k8s:required
+	Action string `json:"action"`
+
+	// NewName specifies the new name of the metric. Required by the
+	// `insert' and `combine' actions.
+	//
+	// +k8s:optional
+	NewName string `json:"new_name,omitempty"`
+
+	// Operations specifies the operations, which are applied to the
+	// labels and data points of the metrics.
+	//
+	// +k8s:optional
+	Operations []MetricsTransformOperationConfig `json:"operations,omitempty"`
+}
+
+// MetricsTransformProcessorConfig provides the Metrics Transform processor
+// configuration settings. The processor is added to the `metrics' pipeline,
+// if any transform is specified.
+//
+// See [Metrics Transform Processor] for more details.
+//
+// [Metrics Transform Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/metricstransformprocessor
+type MetricsTransformProcessorConfig struct {
+	// Transforms specifies the transforms of the processor in the order
+	// they are applied.
+	//
+	// +k8s:optional
+	Transforms []MetricsTransformConfig `json:"transforms,omitempty"`
+}
+
+// BatchProcessorConfig provides the Batch processor configuration settings.
+// The processor batches the data of the pipelines of the collector before
+// they are exported.
+//
+// See [Batch Processor] for more details.
+//
+// [Batch Processor]: https://github.com/open-telemetry/opentelemetry-collector/tree/main/processor/batchprocessor
+type BatchProcessorConfig struct {
+	// Enabled specifies whether the Batch processor is enabled or not.
+	// When disabled, the processor is removed from the pipelines, e.g.
+	// when the exporters batch the data in their sending queues instead.
+	// Default is true.
+	//
+	// +k8s:optional
+	// +default=true
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// SendBatchMaxSize specifies the maximum number of spans, data points
+	// or log records of a batch, which overrides the one configured for
+	// the extension. It must not exceed the maximum size of the requests
+	// of the exporters measured in items.
+	//
+	// +k8s:optional
+	SendBatchMaxSize int `json:"send_batch_max_size,omitzero"`
+}
+
+// CollectorProcessorsConfig provides the settings, which apply to the
+// processors of the collector.
+type CollectorProcessorsConfig struct {
+	// MetricsTransform specifies the settings of the Metrics Transform
+	// processor of the `metrics' pipeline.
+	//
+	// +k8s:optional
+	MetricsTransform MetricsTransformProcessorConfig `json:"metricstransform,omitzero"`
+
+	// ErrorMode specifies how the processors, which evaluate OTTL
+	// statements or conditions, e.g. the transform processor, handle
+	// errors. Valid options are `ignore', `silent' and `propagate'.
+	//
+	// +k8s:optional
+	// +default=ref(ErrorModePropagate)
+	ErrorMode ErrorMode `json:"error_mode,omitzero"`
+
+	// Batch specifies the settings of the Batch processor of the
+	// pipelines.
+	//
+	// +k8s:optional
+	Batch BatchProcessorConfig `json:"batch,omitzero"`
+
+	// Named provides additional named instances of the processors, which
+	// are defined once and referenced by their name, e.g. `batch/large',
+	// in the processors of the forward pipelines.
+	//
+	// +k8s:optional
+	Named []NamedProcessorConfig `json:"named,omitempty"`
+
+	// TailSampling specifies the settings of the Tail Sampling processors
+	// of the referenced collector configuration, e.g. in order to bound
+	// the memory usage on a high volume of traces.
+	//
+	// +k8s:optional
+	TailSampling TailSamplingProcessorConfig `json:"tail_sampling,omitzero"`
+}
+
+// TailSamplingProcessorConfig provides the settings, which apply to the Tail
+// Sampling processors of the referenced collector configuration. The settings,
+// which are specified, override the ones of the referenced processors, the
+// others default to the ones of the referenced processors and eventually to
+// the upstream defaults.
+//
+// See [Tail Sampling Processor] for more details.
+//
+// [Tail Sampling Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/tailsamplingprocessor
+type TailSamplingProcessorConfig struct {
+	// DecisionWait specifies the time since the first span of a trace,
+	// after which the sampling decision is made. Longer times keep more
+	// traces in memory, but consider late spans. The upstream default is
+	// 30 seconds.
+	//
+	// +k8s:optional
+	DecisionWait time.Duration `json:"decision_wait,omitzero"`
+
+	// NumTraces specifies the number of traces kept in memory, which must
+	// be at most 1000000. The traces are dropped before their decision,
+	// when the number is exceeded. The upstream default is 50000.
+	//
+	// +k8s:optional
+	NumTraces int `json:"num_traces,omitzero"`
+
+	// ExpectedNewTracesPerSec specifies the expected number of new traces
+	// per second, which is used to allocate the data structures upfront.
+	// The upstream default is 0.
+	//
+	// +k8s:optional
+	ExpectedNewTracesPerSec int `json:"expected_new_traces_per_sec,omitzero"`
+}
+
+// NamedBatchProcessorConfig provides the settings for a named instance of the
+// Batch processor. The settings, which are not specified, are the ones of the
+// extension.
+type NamedBatchProcessorConfig struct {
+	// Timeout specifies the time, after which a batch is sent regardless
+	// of its size.
+	//
+	// +k8s:optional
+	Timeout time.Duration `json:"timeout,omitzero"`
+
+	// SendBatchSize specifies the number of items, after which a batch is
+	// sent regardless of the timeout.
+	//
+	// +k8s:optional
+	SendBatchSize int `json:"send_batch_size,omitzero"`
+
+	// SendBatchMaxSize specifies the maximum number of items of a batch,
+	// which must not be lower than SendBatchSize. Larger batches are
+	// split.
+	//
+	// +k8s:optional
+	SendBatchMaxSize int `json:"send_batch_max_size,omitzero"`
+}
+
+// NamedMemoryLimiterProcessorConfig provides the settings for a named instance
+// of the Memory Limiter processor. The settings, which are not specified, are
+// the ones of the extension.
+type NamedMemoryLimiterProcessorConfig struct {
+	// CheckInterval specifies the interval, at which the memory usage is
+	// checked.
+	//
+	// +k8s:optional
+	CheckInterval time.Duration `json:"check_interval,omitzero"`
+
+	// LimitPercentage specifies the maximum memory usage in percent of
+	// the available memory, which must be between 1 and 100.
+	//
+	// +k8s:optional
+	LimitPercentage int `json:"limit_percentage,omitzero"`
+
+	// SpikeLimitPercentage specifies the maximum spike of the memory usage
+	// between two checks in percent of the available memory, which must
+	// be lower than LimitPercentage. It requires LimitPercentage to be
+	// set and defaults to a fifth of it.
+	//
+	// +k8s:optional
+	SpikeLimitPercentage int `json:"spike_limit_percentage,omitzero"`
+}
+
+// NamedTransformProcessorConfig provides the settings for a named instance of
+// the Transform processor. The statements are given as a flat list, i.e. the
+// OTTL context of each statement is inferred from its paths.
+//
+// See [Transform Processor] for more details.
+//
+// [Transform Processor]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/transformprocessor
+type NamedTransformProcessorConfig struct {
+	// MetricStatements specifies the OTTL statements, which are applied
+	// to the metrics.
+	//
+	// +k8s:optional
+	MetricStatements []string `json:"metric_statements,omitempty"`
+
+	// LogStatements specifies the OTTL statements, which are applied to
+	// the logs.
+	//
+	// +k8s:optional
+	LogStatements []string `json:"log_statements,omitempty"`
+}
+
+// NamedProcessorConfig provides the settings for a named instance of a
+// processor. Exactly one of the processor types must be specified.
+type NamedProcessorConfig struct {
+	// Name specifies the name of the instance, which must be a valid DNS
+	// label.
+	Name string `json:"name"`
+
+	// Batch provides the Batch processor settings.
+	//
+	// +k8s:optional
+	Batch *NamedBatchProcessorConfig `json:"batch,omitempty"`
+
+	// MemoryLimiter provides the Memory Limiter processor settings.
+	//
+	// +k8s:optional
+	MemoryLimiter *NamedMemoryLimiterProcessorConfig `json:"memory_limiter,omitempty"`
+
+	// Transform provides the Transform processor settings.
+	//
+	// +k8s:optional
+	Transform *NamedTransformProcessorConfig `json:"transform,omitempty"`
+}
+
+// ServiceGraphConnectorConfig provides the Service Graph Connector
+// configuration settings. The connector derives the metrics of the service
+// graph, i.e. the requests between the services, from the spans of the
+// `traces' pipelines of the referenced collector configuration, and passes
+// them to the `metrics/servicegraph' pipeline.
+//
+// See [Service Graph Connector] for more details.
+//
+// [Service Graph Connector]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/connector/servicegraphconnector
+type ServiceGraphConnectorConfig struct {
+	// Enabled specifies whether the Service Graph connector is enabled or
+	// not. The connector requires a `traces' pipeline of the referenced
+	// collector configuration.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// LatencyHistogramBuckets specifies the buckets of the histograms of
+	// the request latency between the services, e.g. `100ms'. If not set,
+	// the default buckets of the connector are used.
+	//
+	// +k8s:optional
+	LatencyHistogramBuckets []time.Duration `json:"latency_histogram_buckets,omitempty"`
+
+	// Dimensions specifies the additional attributes of the spans, which
+	// are added as labels to the metrics of the service graph, e.g.
+	// `k8s.namespace.name'.
+	//
+	// +k8s:optional
+	Dimensions []string `json:"dimensions,omitempty"`
+}
+
+// SpanMetricsExemplarsConfig provides the settings of the exemplars of the
+// Span Metrics connector, which correlate the metrics with the traces.
+type SpanMetricsExemplarsConfig struct {
+	// Enabled specifies whether the metrics carry exemplars, which
+	// reference the trace and span IDs of the spans.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// MaxPerDataPoint specifies the maximum number of exemplars per data
+	// point. If not set, the number of exemplars is not limited.
+	//
+	// +k8s:optional
+	MaxPerDataPoint *int32 `json:"max_per_data_point,omitempty"`
+}
+
+// SpanMetricsConnectorConfig provides the Span Metrics Connector
+// configuration settings. The connector derives the request, error and
+// duration (RED) metrics from the spans of the `traces' pipelines of the
+// referenced collector configuration, and passes them to the
+// `metrics/spanmetrics' pipeline.
+//
+// See [Span Metrics Connector] for more details.
+//
+// [Span Metrics Connector]: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/connector/spanmetricsconnector
+type SpanMetricsConnectorConfig struct {
+	// Enabled specifies whether the Span Metrics connector is enabled or
+	// not. The connector requires a `traces' pipeline of the referenced
+	// collector configuration.
+	//
+	// +k8s:optional
+	// +default=false
+	Enabled *bool `json:"enabled,omitzero"`
+
+	// Dimensions specifies the additional attributes of the spans, which
+	// are added as labels to the metrics, e.g. `http.method'.
+	//
+	// +k8s:optional
+	Dimensions []string `json:"dimensions,omitempty"`
+
+	// Exemplars specifies the settings of the exemplars, which correlate
+	// the metrics with the traces.
+	//
+	// +k8s:optional
+	Exemplars SpanMetricsExemplarsConfig `json:"exemplars,omitzero"`
+}
+
+// CollectorConnectorsConfig provides the settings of the connectors of the
+// collector.
+type CollectorConnectorsConfig struct {
+	// ServiceGraphConnector provides the Service Graph connector settings.
+	//
+	// +k8s:optional
+	ServiceGraphConnector ServiceGraphConnectorConfig `json:"servicegraph,omitzero"`
+
+	// SpanMetricsConnector provides the Span Metrics connector settings.
+	//
+	// +k8s:optional
+	SpanMetricsConnector SpanMetricsConnectorConfig `json:"spanmetrics,omitzero"`
+}
+
+// TargetAllocatorConfig provides the settings of the Target Allocator, which
+// discovers the scrape targets of the Prometheus receiver.
+type TargetAllocatorConfig struct {
+	// ServiceDiscoveryRole specifies the Kubernetes resource, which is
+	// used to discover the endpoints of the services selected by the
+	// ServiceMonitors. Valid options are `Endpoints' and `EndpointSlice'.
+	// EndpointSlices scale better for services with many endpoints.
+	//
+	// +k8s:optional
+	// +default=ref(ServiceDiscoveryRoleEndpoints)
+	ServiceDiscoveryRole ServiceDiscoveryRole `json:"service_discovery_role,omitzero"`
+
+	// RevisionHistoryLimit specifies the number of old ReplicaSets of the
+	// Target Allocator deployment, which are retained. Note that the
+	// workloads of the collector are managed by the OTel Operator, which
+	// does not support this setting.
+	//
+	// +k8s:optional
+	// +default=ref(DefaultTargetAllocatorRevisionHistoryLimit)
+	RevisionHistoryLimit *int32 `json:"revision_history_limit,omitempty"`
+
+	// LogLevel specifies the log level of the Target Allocator, which is
+	// independent of the log level of the collector, e.g. in order to debug
+	// the assignment of the scrape targets. Valid options are `DEBUG',
+	// `INFO' and `ERROR'. If not set, the Target Allocator logs at `INFO'
+	// level.
+	//
+	// +k8s:optional
+	LogLevel LogLevel `json:"log_level,omitempty"`
+
+	// ScrapeInterval specifies the scrape interval, which applies to the
+	// endpoints of the ServiceMonitors discovered by the Target Allocator,
+	// which do not specify an interval. It is independent of the scrape
+	// intervals of the self-monitoring and the additional scrape jobs. If
+	// not set, it defaults to [DefaultTargetAllocatorScrapeInterval].
+	//
+	// +k8s:optional
+	// +default=ref(DefaultTargetAllocatorScrapeInterval)
+	ScrapeInterval time.Duration `json:"scrape_interval,omitzero"`
+}
+
+// PortConfig provides the settings for an additional port of the collector,
+// e.g. of a receiver configured via `config_ref'.
+type PortConfig struct {
+	// Name specifies the name of the port, which must be a valid IANA
+	// service name.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// Port specifies the number of the port.
+	//
+	// +k8s:required
+	Port int32 `json:"port"`
+
+	// Protocol specifies the protocol of the port, i.e. `TCP', `UDP' or
+	// `SCTP'.
+	//
+	// +k8s:optional
+	// +default="TCP"
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+}
+
+// VolumeConfig provides the settings for an additional volume of the
+// collector, e.g. for the components configured via `config_ref'.
+type VolumeConfig struct {
+	// Name specifies the name of the volume, which must be a valid DNS
+	// label.
+	//
+	// +k8s:required
+	Name string `json:"name"`
+
+	// MountPath specifies the absolute path, at which the volume is
+	// mounted into the collector container. It must not overlap with the
+	// paths of the volumes managed by the extension.
+	//
+	// +k8s:required
+	MountPath string `json:"mountPath"`
+
+	// ResourceRef references a key of a Secret or ConfigMap from
+	// `.spec.resources' of the Shoot, which is mounted read-only as a file
+	// named after the data key.
+	//
+	// +k8s:optional
+	ResourceRef *ResourceReferenceDetails `json:"resourceRef,omitempty"`
+
+	// EmptyDir specifies a writable volume, which shares the lifetime of
+	// the collector pod, e.g. for the `file_storage' extension.
+	//
+	// +k8s:optional
+	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+}
+
+// CollectorConfigSpec specifies the desired state of [CollectorConfig]
+type CollectorConfigSpec struct {
+	// Mode specifies the deployment mode of the collector. Valid options
+	// are `statefulset' and `deployment'.
+	//
+	// +k8s:optional
+	// +default=ref(CollectorModeStatefulSet)
+	Mode CollectorMode `json:"mode,omitzero"`
+
+	// Distribution specifies the distribution of the collector. Valid
+	// options are `contrib' and `k8s'. The configured components must be
+	// available in the distribution.
+	//
+	// +k8s:optional
+	// +default=ref(CollectorDistributionContrib)
+	Distribution CollectorDistribution `json:"distribution,omitzero"`
+
+	// Exporters specifies the exporters configuration of the collector.
+	//
+	// +k8s:required
+	Exporters CollectorExportersConfig `json:"exporters,omitzero"`
+
+	// Receivers specifies the receivers configuration of the collector.
+	//
+	// +k8s:optional
+	Receivers CollectorReceiversConfig `json:"receivers,omitzero"`
+
+	// Pipelines specifies the additional pipelines of the collector.
+	//
+	// +k8s:optional
+	Pipelines CollectorPipelinesConfig `json:"pipelines,omitzero"`
+
+	// Processors specifies the settings, which apply to the processors
+	// of the collector.
+	//
+	// +k8s:optional
+	Processors CollectorProcessorsConfig `json:"processors,omitzero"`
+
+	// Connectors specifies the settings of the connectors of the
+	// collector.
+	//
+	// +k8s:optional
+	Connectors CollectorConnectorsConfig `json:"connectors,omitzero"`
+
+	// TargetAllocator specifies the settings of the Target Allocator.
+	//
+	// +k8s:optional
+	TargetAllocator TargetAllocatorConfig `json:"target_allocator,omitzero"`
+
+	// PodAnnotations specifies additional annotations of the collector
+	// (including the OTLP gateway) and Target Allocator pods, e.g.
+	// `sidecar.istio.io/inject: "false"' in order to prevent the
+	// injection of a service mesh sidecar, which interferes with the mTLS
+	// between the collector and the Target Allocator. Annotations of the
+	// `gardener.cloud' domain are reserved.
+	//
+	// +k8s:optional
+	PodAnnotations map[string]string `json:"pod_annotations,omitempty"`
+
+	// ServiceAccountAnnotations specifies additional annotations of the
+	// service account of the collector, e.g. in order to bind it to an
+	// identity of a cloud provider like `eks.amazonaws.com/role-arn' for
+	// IAM roles for service accounts. Annotations of the `gardener.cloud'
+	// domain are reserved, as well as the annotations managed by the
+	// exporters, e.g. `iam.gke.io/gcp-service-account' of the Google Cloud
+	// exporter.
+	//
+	// +k8s:optional
+	ServiceAccountAnnotations map[string]string `json:"service_account_annotations,omitempty"`
+
+	// MetricNamePrefix specifies the prefix, which is prepended to the
+	// names of all metrics processed by the `metrics' pipeline, e.g.
+	// `myteam_'. This avoids collisions of the metrics, when multiple
+	// collectors write to a shared backend. The prefix must start with a
+	// letter or an underscore and may contain letters, digits,
+	// underscores, colons and dots only.
+	//
+	// +k8s:optional
+	MetricNamePrefix string `json:"metric_name_prefix,omitempty"`
+
+	// StartupProbe specifies the settings for the startup probe of the
+	// collector.
+	//
+	// +k8s:optional
+	StartupProbe StartupProbeConfig `json:"startup_probe,omitzero"`
+
+	// SecurityContext specifies the settings of the security context of
+	// the collector pods.
+	//
+	// +k8s:optional
+	SecurityContext SecurityContextConfig `json:"security_context,omitzero"`
+
+	// UpdateStrategy specifies the settings of the rolling update of the
+	// collector pods.
+	//
+	// +k8s:optional
+	UpdateStrategy UpdateStrategyConfig `json:"update_strategy,omitzero"`
+
+	// ShutdownTimeout only affects the termination grace period of the
+	// collector pods, which is set to the larger of 60 seconds and the 5
+	// seconds of the pre-stop hook plus this timeout. It is not passed to
+	// the collector, which has no setting for the duration of its
+	// shutdown, but gives it more time to drain the sending queues of the
+	// exporters before the pods are killed. It must be a whole number of
+	// seconds. The grace period is 60 seconds, if not set.
+	//
+	// +k8s:optional
+	ShutdownTimeout time.Duration `json:"shutdown_timeout,omitzero"`
+
+	// RolloutOnSecretRotation specifies whether the collector pods are
+	// rolled out, when the data of the secrets referenced by the exporters
+	// and receivers changes, e.g. after a rotation of the TLS material.
+	// The projected secrets are updated in place, but not every component
+	// of the collector watches its files. Default is true.
+	//
+	// +k8s:optional
+	// +default=true
+	RolloutOnSecretRotation *bool `json:"rollout_on_secret_rotation,omitzero"`
+
+	// Logs specifies the settings for the collector logs.
+	//
+	// +k8s:optional
+	Logs CollectorLogsConfig `json:"logs,omitzero"`
+
+	// Metrics specifies the settings for the internal collector metrics.
+	//
+	// +k8s:optional
+	Metrics CollectorMetricsConfig `json:"metrics,omitzero"`
+
+	// DNS specifies the DNS settings of the collector and Target
+	// Allocator pods.
+	//
+	// +k8s:optional
+	DNS DNSConfig `json:"dns,omitzero"`
+
+	// Gateway specifies the settings for the OTLP gateway, which receives
+	// the OTLP data in front of the collector.
+	//
+	// +k8s:optional
+	Gateway GatewayConfig `json:"gateway,omitzero"`
+
+	// ConfigRef references a key of a ConfigMap from `.spec.resources' of
+	// the Shoot, which contains a collector configuration. Its receivers,
+	// processors, exporters, connectors, extensions and pipelines are
+	// merged into the configuration generated by the extension, which
+	// keeps managing the components it configures, e.g. the Prometheus
+	// receiver along with the Target Allocator.
+	//
+	// +k8s:optional
+	ConfigRef *ResourceReference `json:"config_ref,omitempty"`
+
+	// Ports specifies the additional ports, which are exposed by the
+	// collector, e.g. for the receivers configured via `config_ref'.
+	// The ports are added to the Service of the collector and are allowed
+	// by its network policies.
+	//
+	// +k8s:optional
+	Ports []PortConfig `json:"ports,omitempty"`
+
+	// Volumes specifies the additional volumes, which are mounted into
+	// the collector container. Each volume either references a resource
+	// of the Shoot or is an empty directory.
+	//
+	// +k8s:optional
+	Volumes []VolumeConfig `json:"volumes,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CollectorConfig provides the OpenTelemetry Collector API configuration.
+type CollectorConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Spec provides the extension configuration spec.
+	Spec CollectorConfigSpec `json:"spec,omitzero"`
+}
+
+// TLSVersion specifies a version of the TLS protocol.
+//
+// +k8s:enum
+type TLSVersion string
+
+const (
+	// TLSVersion12 specifies TLS 1.2.
+	TLSVersion12 TLSVersion = "1.2"
+	// TLSVersion13 specifies TLS 1.3.
+	TLSVersion13 TLSVersion = "1.3"
+)
+
+// TLSConfig provides the TLS settings used by exporters.
+type TLSConfig struct {
+	// InsecureSkipVerify specifies whether to skip verifying the
+	// certificate or not.
+	// +k8s:optional
+	// +default=false
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+	// CA references the CA certificate to use for verifying the server certificate.
+	// For a client this verifies the server certificate.
+	// For a server this verifies client certificates.
+	// If empty uses system root CA.
+	//
+	// +k8s:optional
+	CA *ResourceReference `json:"ca,omitempty"`
+	// IncludeSystemCAs specifies whether the system root CAs are trusted in
+	// addition to the CA or not, e.g. when the exporter connects to a
+	// backend signed by a private CA via a proxy signed by a public CA. By
+	// default the CA replaces the system root CAs. It requires the CA to be
+	// set and cannot be combined with InsecureSkipVerify.
+	//
+	// +k8s:optional
+	IncludeSystemCAs *bool `json:"includeSystemCAs,omitempty"`
+	// Cert references the client certificate to use for TLS required connections.
+	//
+	// +k8s:optional
+	Cert *ResourceReference `json:"cert,omitempty"`
+	// Key references the client key to use for TLS required connections.
+	//
+	// +k8s:optional
+	Key *ResourceReference `json:"key,omitempty"`
+	// ReloadInterval specifies mTLS key and cert reload interval
+	// from mounted secret volume
+	//
+	// +k8s:optional
+	// +default=ref(DefaultTLSReloadInterval)
+	ReloadInterval time.Duration `json:"reloadInterval,omitzero"`
+	// MinVersion specifies the minimum TLS version of the connections. If
+	// not set, the default of the collector is used, i.e. TLS 1.2.
+	//
+	// +k8s:optional
+	MinVersion TLSVersion `json:"minVersion,omitempty"`
+	// MaxVersion specifies the maximum TLS version of the connections. If
+	// not set, the latest version supported by the collector is used.
+	//
+	// +k8s:optional
+	MaxVersion TLSVersion `json:"maxVersion,omitempty"`
+	// CipherSuites restricts the cipher suites of TLS 1.2 connections to the
+	// given names, e.g. TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384. The cipher
+	// suites of TLS 1.3 are not configurable. If not set, the default cipher
+	// suites of the collector are used.
+	//
+	// +k8s:optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
 }
 
 // ResourceReference references data from a Secret.
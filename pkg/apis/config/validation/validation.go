@@ -6,9 +6,29 @@ package validation
 
 import (
 	"cmp"
+	"crypto/tls"
+	"fmt"
+	"maps"
+	"net"
 	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
 )
@@ -17,11 +37,101 @@ import (
 func Validate(cfg config.CollectorConfig) error {
 	allErrs := make(field.ErrorList, 0)
 
+	supportedModes := []config.CollectorMode{
+		config.CollectorModeStatefulSet,
+		config.CollectorModeDeployment,
+	}
+	if cfg.Spec.Mode != "" && !slices.Contains(supportedModes, cfg.Spec.Mode) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(field.NewPath("spec.mode"), cfg.Spec.Mode, supportedModes),
+		)
+	}
+
+	supportedDistributions := []config.CollectorDistribution{
+		config.CollectorDistributionContrib,
+		config.CollectorDistributionK8s,
+	}
+	if cfg.Spec.Distribution != "" && !slices.Contains(supportedDistributions, cfg.Spec.Distribution) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(field.NewPath("spec.distribution"), cfg.Spec.Distribution, supportedDistributions),
+		)
+	}
+
+	supportedErrorModes := []config.ErrorMode{
+		config.ErrorModeIgnore,
+		config.ErrorModeSilent,
+		config.ErrorModePropagate,
+	}
+	if errorMode := cfg.Spec.Processors.ErrorMode; errorMode != "" && !slices.Contains(supportedErrorModes, errorMode) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(field.NewPath("spec.processors.error_mode"), errorMode, supportedErrorModes),
+		)
+	}
+
+	supportedDiscoveryRoles := []config.ServiceDiscoveryRole{
+		config.ServiceDiscoveryRoleEndpoints,
+		config.ServiceDiscoveryRoleEndpointSlice,
+	}
+	if role := cfg.Spec.TargetAllocator.ServiceDiscoveryRole; role != "" && !slices.Contains(supportedDiscoveryRoles, role) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(field.NewPath("spec.target_allocator.service_discovery_role"), role, supportedDiscoveryRoles),
+		)
+	}
+
+	// The Target Allocator does not support the WARN level.
+	supportedTargetAllocatorLogLevels := []config.LogLevel{
+		config.LogLevelDebug,
+		config.LogLevelInfo,
+		config.LogLevelError,
+	}
+	if level := cfg.Spec.TargetAllocator.LogLevel; level != "" && !slices.Contains(supportedTargetAllocatorLogLevels, level) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(field.NewPath("spec.target_allocator.log_level"), level, supportedTargetAllocatorLogLevels),
+		)
+	}
+
+	// An unset scrape interval of the Target Allocator falls back to the
+	// default one.
+	if interval := cfg.Spec.TargetAllocator.ScrapeInterval; interval < 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(field.NewPath("spec.target_allocator.scrape_interval"), interval.String(), "scrape interval must be positive"),
+		)
+	}
+
+	supportedMetricsLevels := []config.MetricsVerbosityLevel{
+		config.MetricsVerbosityLevelNone,
+		config.MetricsVerbosityLevelBasic,
+		config.MetricsVerbosityLevelNormal,
+		config.MetricsVerbosityLevelDetailed,
+	}
+	if level := cfg.Spec.Metrics.Level; level != "" && !slices.Contains(supportedMetricsLevels, level) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(field.NewPath("spec.metrics.level"), level, supportedMetricsLevels),
+		)
+	}
+
+	if prefix := cfg.Spec.MetricNamePrefix; prefix != "" && !metricNamePrefixRegexp.MatchString(prefix) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(field.NewPath("spec.metric_name_prefix"), prefix, "prefix must be a valid fragment of a metric name"),
+		)
+	}
+
 	// We require at least one exporter to be enabled
 	anyExporterEnabled := []bool{
 		cfg.Spec.Exporters.DebugExporter.IsEnabled(),
 		cfg.Spec.Exporters.OTLPHTTPExporter.IsEnabled(),
 		cfg.Spec.Exporters.OTLPGRPCExporter.IsEnabled(),
+		cfg.Spec.Exporters.FileExporter.IsEnabled(),
+		cfg.Spec.Exporters.GoogleCloudExporter.IsEnabled(),
+		slices.ContainsFunc(cfg.Spec.Exporters.Named, config.NamedExporterConfig.IsEnabled),
 	}
 
 	if !cmp.Or(anyExporterEnabled...) {
@@ -60,12 +170,7 @@ func Validate(cfg config.CollectorConfig) error {
 
 	for _, f := range urlFields {
 		if f.value != "" {
-			if _, err := url.Parse(f.value); err != nil {
-				allErrs = append(
-					allErrs,
-					field.Invalid(field.NewPath(f.path), f.value, "invalid URL specified"),
-				)
-			}
+			allErrs = append(allErrs, validateEndpoint(field.NewPath(f.path), f.value, true)...)
 		}
 	}
 
@@ -92,6 +197,26 @@ func Validate(cfg config.CollectorConfig) error {
 			path:  "spec.exporters.otlp_grpc.write_buffer_size",
 			value: cfg.Spec.Exporters.OTLPGRPCExporter.WriteBufferSize,
 		},
+		{
+			path:  "spec.startup_probe.failure_threshold",
+			value: int(cfg.Spec.StartupProbe.FailureThreshold),
+		},
+		{
+			path:  "spec.startup_probe.period_seconds",
+			value: int(cfg.Spec.StartupProbe.PeriodSeconds),
+		},
+		{
+			path:  "spec.target_allocator.revision_history_limit",
+			value: int(ptr.Deref(cfg.Spec.TargetAllocator.RevisionHistoryLimit, 0)),
+		},
+		{
+			path:  "spec.exporters.file.rotation.max_days",
+			value: cfg.Spec.Exporters.FileExporter.Rotation.MaxDays,
+		},
+		{
+			path:  "spec.exporters.file.rotation.max_backups",
+			value: cfg.Spec.Exporters.FileExporter.Rotation.MaxBackups,
+		},
 	}
 
 	for _, f := range nonNegativeFields {
@@ -118,6 +243,10 @@ func Validate(cfg config.CollectorConfig) error {
 			path: "spec.exporters.otlp_grpc.token",
 			ref:  cfg.Spec.Exporters.OTLPGRPCExporter.Token,
 		},
+		{
+			path: "spec.config_ref",
+			ref:  cfg.Spec.ConfigRef,
+		},
 	}
 
 	// Referenced resources from the OTLP HTTP exporter
@@ -158,6 +287,42 @@ func Validate(cfg config.CollectorConfig) error {
 		)
 	}
 
+	// Referenced resources from the OTLP receiver
+	resourceRefs = append(
+		resourceRefs,
+		resourceRef{
+			path: "spec.receivers.otlp.auth.token",
+			ref:  cfg.Spec.Receivers.OTLPReceiver.Auth.Token,
+		},
+		resourceRef{
+			path: "spec.receivers.otlp.auth.htpasswd",
+			ref:  cfg.Spec.Receivers.OTLPReceiver.Auth.Htpasswd,
+		},
+	)
+
+	// Referenced resources from the additional scrape jobs
+	for i, sc := range cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs {
+		path := field.NewPath("spec.receivers.prometheus.scrape_configs").Index(i)
+		if sc.Authorization != nil {
+			resourceRefs = append(
+				resourceRefs,
+				resourceRef{
+					path: path.Child("authorization", "credentials").String(),
+					ref:  sc.Authorization.Credentials,
+				},
+			)
+		}
+		if sc.BasicAuth != nil {
+			resourceRefs = append(
+				resourceRefs,
+				resourceRef{
+					path: path.Child("basic_auth", "password").String(),
+					ref:  sc.BasicAuth.Password,
+				},
+			)
+		}
+	}
+
 	for _, f := range resourceRefs {
 		if f.ref != nil {
 			if f.ref.ResourceRef.Name == "" || f.ref.ResourceRef.DataKey == "" {
@@ -195,5 +360,1845 @@ func Validate(cfg config.CollectorConfig) error {
 		}
 	}
 
+	if endpoint := cfg.Spec.Exporters.OTLPGRPCExporter.Endpoint; endpoint != "" {
+		allErrs = append(allErrs, validateEndpoint(field.NewPath("spec.exporters.otlp_grpc.endpoint"), endpoint, false)...)
+	}
+
+	// JSON encoded payloads are meant to be human-readable and are
+	// commonly decompressed by the OTLP/HTTP receivers with gzip or zstd
+	// only, so snappy-compressed JSON payloads result in decode errors on
+	// the backend side.
+	otlpHTTPExporter := cfg.Spec.Exporters.OTLPHTTPExporter
+	if otlpHTTPExporter.IsEnabled() &&
+		otlpHTTPExporter.Encoding == config.MessageEncodingJSON &&
+		otlpHTTPExporter.Compression == config.CompressionSnappy {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				field.NewPath("spec.exporters.otlp_http.compression"),
+				otlpHTTPExporter.Compression,
+				"snappy compression is not supported with json encoding",
+			),
+		)
+	}
+
+	receiverAuth := cfg.Spec.Receivers.OTLPReceiver.Auth
+	if receiverAuth.Token != nil && receiverAuth.Htpasswd != nil {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(field.NewPath("spec.receivers.otlp.auth"), "at most one of token and htpasswd may be specified"),
+		)
+	}
+
+	for i, selector := range cfg.Spec.Receivers.OTLPReceiver.AllowedClients {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(
+			&selector,
+			metav1validation.LabelSelectorValidationOptions{},
+			field.NewPath("spec.receivers.otlp.allowed_clients").Index(i),
+		)...)
+	}
+
+	allErrs = append(allErrs, validateSendingQueue(
+		field.NewPath("spec.exporters.otlp_http"),
+		cfg.Spec.Exporters.OTLPHTTPExporter.SendingQueue,
+		cfg.Spec.Exporters.OTLPHTTPExporter.RetryOnFailure,
+	)...)
+	allErrs = append(allErrs, validateSendingQueue(
+		field.NewPath("spec.exporters.otlp_grpc"),
+		cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue,
+		cfg.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure,
+	)...)
+	allErrs = append(allErrs, validateTLS(
+		field.NewPath("spec.exporters.otlp_http.tls"),
+		cfg.Spec.Exporters.OTLPHTTPExporter.TLS,
+	)...)
+	allErrs = append(allErrs, validateTLS(
+		field.NewPath("spec.exporters.otlp_grpc.tls"),
+		cfg.Spec.Exporters.OTLPGRPCExporter.TLS,
+	)...)
+	allErrs = append(allErrs, validateScrapeConfigs(cfg)...)
+	allErrs = append(allErrs, validatePipelines(cfg)...)
+	allErrs = append(allErrs, validateDNS(cfg)...)
+	allErrs = append(allErrs, validateGateway(cfg)...)
+	allErrs = append(allErrs, validatePorts(cfg)...)
+	allErrs = append(allErrs, validateVolumes(cfg)...)
+	allErrs = append(allErrs, validateSecurityContext(cfg)...)
+	allErrs = append(allErrs, validateUpdateStrategy(cfg)...)
+	allErrs = append(allErrs, validateShutdownTimeout(cfg)...)
+	allErrs = append(allErrs, validateStatsDReceiver(cfg)...)
+	allErrs = append(allErrs, validateServiceGraphConnector(cfg)...)
+	allErrs = append(allErrs, validateSpanMetricsConnector(cfg)...)
+	allErrs = append(allErrs, validateHostMetricsReceiver(cfg)...)
+	allErrs = append(allErrs, validatePodAnnotations(cfg)...)
+	allErrs = append(allErrs, validateServiceAccountAnnotations(cfg)...)
+	allErrs = append(allErrs, validateMetricsTransform(cfg)...)
+	allErrs = append(allErrs, validateBatchProcessor(cfg)...)
+	allErrs = append(allErrs, validateFileExporter(cfg)...)
+	allErrs = append(allErrs, validateDebugExporterPath(cfg)...)
+	allErrs = append(allErrs, validateGoogleCloudExporter(cfg)...)
+	allErrs = append(allErrs, validateDuplicateExporters(cfg)...)
+	allErrs = append(allErrs, validateNamedExporters(cfg)...)
+	allErrs = append(allErrs, validateNamedProcessors(cfg)...)
+	allErrs = append(allErrs, validateTailSampling(cfg)...)
+	allErrs = append(allErrs, validateMetricsPush(cfg)...)
+	allErrs = append(allErrs, validateMetricsNaming(cfg)...)
+	allErrs = append(allErrs, validateDisabledMetrics(cfg)...)
+
 	return allErrs.ToAggregate()
 }
+
+// validateTLS validates the TLS versions and cipher suites of the exporter
+// with the given path.
+func validateTLS(path *field.Path, cfg *config.TLSConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	supportedVersions := []config.TLSVersion{
+		config.TLSVersion12,
+		config.TLSVersion13,
+	}
+	if v := cfg.MinVersion; v != "" && !slices.Contains(supportedVersions, v) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("minVersion"), v, supportedVersions))
+	}
+	if v := cfg.MaxVersion; v != "" && !slices.Contains(supportedVersions, v) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("maxVersion"), v, supportedVersions))
+	}
+
+	// Both versions are of the form "1.x", hence they compare lexically.
+	if cfg.MinVersion != "" && cfg.MaxVersion != "" && cfg.MinVersion > cfg.MaxVersion {
+		allErrs = append(allErrs, field.Invalid(path.Child("maxVersion"), cfg.MaxVersion, "value must not be lower than minVersion"))
+	}
+
+	// Only the secure cipher suites implemented by Go are accepted, which
+	// excludes the ones with known security issues.
+	supportedCipherSuites := make([]string, 0)
+	for _, suite := range tls.CipherSuites() {
+		supportedCipherSuites = append(supportedCipherSuites, suite.Name)
+	}
+
+	seen := sets.New[string]()
+	for i, name := range cfg.CipherSuites {
+		switch {
+		case !slices.Contains(supportedCipherSuites, name):
+			allErrs = append(allErrs, field.NotSupported(path.Child("cipherSuites").Index(i), name, supportedCipherSuites))
+		case seen.Has(name):
+			allErrs = append(allErrs, field.Duplicate(path.Child("cipherSuites").Index(i), name))
+		}
+		seen.Insert(name)
+	}
+
+	// The cipher suites of TLS 1.3 are not configurable, hence restricting
+	// them has no effect.
+	if len(cfg.CipherSuites) > 0 && cfg.MinVersion == config.TLSVersion13 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("cipherSuites"), "cipher suites cannot be configured for TLS 1.3"))
+	}
+
+	// Without a CA the system root CAs are trusted anyway, and without
+	// verification none of them is.
+	if ptr.Deref(cfg.IncludeSystemCAs, false) {
+		switch {
+		case cfg.CA == nil:
+			allErrs = append(allErrs, field.Forbidden(path.Child("includeSystemCAs"), "system root CAs can only be included in addition to a CA"))
+		case ptr.Deref(cfg.InsecureSkipVerify, false):
+			allErrs = append(allErrs, field.Forbidden(path.Child("includeSystemCAs"), "system root CAs cannot be included when the certificate is not verified"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSendingQueue validates the sending queue settings of the exporter
+// with the given path.
+func validateSendingQueue(basePath *field.Path, queue config.SendingQueueConfig, retry config.RetryOnFailureConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	path := basePath.Child("sending_queue")
+
+	if queue.NumConsumers < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("num_consumers"), queue.NumConsumers, "value cannot be negative"))
+	}
+
+	if queue.QueueSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("queue_size"), queue.QueueSize, "value cannot be negative"))
+	}
+
+	supportedSizers := []config.RequestSizer{config.RequestSizerItems, config.RequestSizerBytes}
+	if sizer := queue.Batch.Sizer; sizer != "" && !slices.Contains(supportedSizers, sizer) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("batch", "sizer"), sizer, supportedSizers))
+	}
+
+	if queue.Batch.MaxSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("batch", "max_size"), queue.Batch.MaxSize, "value cannot be negative"))
+	}
+
+	if queue.Batch.IsEnabled() && queue.Enabled != nil && !*queue.Enabled {
+		allErrs = append(allErrs, field.Forbidden(path.Child("batch", "max_size"), "cannot split the requests with a disabled sending queue"))
+	}
+
+	if !queue.IsBlockOnOverflowEnabled() {
+		return allErrs
+	}
+
+	if queue.Enabled != nil && !*queue.Enabled {
+		allErrs = append(allErrs, field.Forbidden(path.Child("block_on_overflow"), "cannot block on overflow with a disabled sending queue"))
+	}
+
+	// The memory_limiter processor refuses data in front of a full queue,
+	// which never drains, if the exporter retries forever. This stalls
+	// the pipelines until the collector is restarted.
+	if retry.Enabled != nil && *retry.Enabled && retry.MaxElapsedTime != nil && *retry.MaxElapsedTime == 0 {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(path.Child("block_on_overflow"), "cannot block on overflow with unlimited retries, which stalls the memory_limiter processor"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateScrapeLimits validates the given limits of the scraped samples and
+// labels.
+func validateScrapeLimits(path *field.Path, limits config.ScrapeLimitsConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	items := []struct {
+		name  string
+		value int
+	}{
+		{"sample_limit", limits.SampleLimit},
+		{"label_limit", limits.LabelLimit},
+		{"label_name_length_limit", limits.LabelNameLengthLimit},
+		{"label_value_length_limit", limits.LabelValueLengthLimit},
+	}
+
+	for _, item := range items {
+		if item.value < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child(item.name), item.value, "value cannot be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+// supportedProxySchemes are the URL schemes supported for the proxy of the
+// scrape requests.
+var supportedProxySchemes = []string{"http", "https", "socks5"}
+
+// metricNamePrefixRegexp matches the valid prefixes of metric names, which
+// start with a letter or an underscore.
+var metricNamePrefixRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_:.]*$`)
+
+// googleCloudProjectIDRegexp matches the valid IDs of Google Cloud projects.
+var googleCloudProjectIDRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+// googleServiceAccountRegexp matches the emails of Google service accounts.
+var googleServiceAccountRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]@[a-z][a-z0-9-]{4,28}[a-z0-9]\.iam\.gserviceaccount\.com$`)
+
+// bodySizeLimitRegexp matches the sizes supported by the `body_size_limit'
+// setting of the scrape configs, which are parsed as base-2 units by
+// Prometheus.
+var bodySizeLimitRegexp = regexp.MustCompile(`^(0|[1-9][0-9]*(B|KB|KiB|MB|MiB|GB|GiB|TB|TiB|PB|PiB|EB|EiB))$`)
+
+// validateStartTimeMetric validates the settings of the start time metric of
+// the Prometheus receiver from the given [config.CollectorConfig].
+func validateStartTimeMetric(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	receiver := cfg.Spec.Receivers.PrometheusReceiver
+	basePath := field.NewPath("spec.receivers.prometheus")
+
+	if receiver.IsUseStartTimeMetricEnabled() && cfg.Spec.Mode == config.CollectorModeDeployment {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("use_start_time_metric"), "start time metric is not supported in deployment mode"))
+	}
+
+	if receiver.StartTimeMetricRegex == "" {
+		return allErrs
+	}
+
+	if !receiver.IsUseStartTimeMetricEnabled() {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("start_time_metric_regex"), "regex requires the start time metric to be used"))
+	}
+
+	if _, err := regexp.Compile(receiver.StartTimeMetricRegex); err != nil {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("start_time_metric_regex"), receiver.StartTimeMetricRegex, fmt.Sprintf("invalid regular expression: %v", err)))
+	}
+
+	return allErrs
+}
+
+// validateScrapeConfigs validates the additional scrape jobs of the Prometheus
+// receiver from the given [config.CollectorConfig].
+func validateScrapeConfigs(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	scrapeConfigs := cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs
+	basePath := field.NewPath("spec.receivers.prometheus.scrape_configs")
+
+	// The Prometheus receiver is not configured in deployment mode.
+	if len(scrapeConfigs) > 0 && cfg.Spec.Mode == config.CollectorModeDeployment {
+		allErrs = append(allErrs, field.Forbidden(basePath, "scrape configs are not supported in deployment mode"))
+	}
+
+	if cfg.Spec.Receivers.PrometheusReceiver.IsNativeHistogramsEnabled() && cfg.Spec.Mode == config.CollectorModeDeployment {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(field.NewPath("spec.receivers.prometheus.native_histograms"), "native histograms are not supported in deployment mode"),
+		)
+	}
+
+	allErrs = append(allErrs, validateStartTimeMetric(cfg)...)
+	allErrs = append(allErrs, validateScrapeLimits(
+		field.NewPath("spec.receivers.prometheus.limits"),
+		cfg.Spec.Receivers.PrometheusReceiver.Limits,
+	)...)
+
+	// Job names must be unique, including the self-scrape jobs of the
+	// collector and the Target Allocator.
+	jobNames := sets.New(config.SelfScrapeJobName, config.TargetAllocatorScrapeJobName)
+	for i, sc := range scrapeConfigs {
+		path := basePath.Index(i)
+
+		switch {
+		case sc.JobName == "":
+			allErrs = append(allErrs, field.Required(path.Child("job_name"), "empty job name specified"))
+		case jobNames.Has(sc.JobName):
+			allErrs = append(allErrs, field.Duplicate(path.Child("job_name"), sc.JobName))
+		}
+		jobNames.Insert(sc.JobName)
+
+		if len(sc.Targets) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("targets"), "no targets specified"))
+		}
+
+		for j, target := range sc.Targets {
+			if host, port, err := net.SplitHostPort(target); err != nil || host == "" || port == "" {
+				allErrs = append(allErrs, field.Invalid(path.Child("targets").Index(j), target, "target must be in the form of host:port"))
+			}
+		}
+
+		if sc.Scheme != "" && sc.Scheme != "http" && sc.Scheme != "https" {
+			allErrs = append(allErrs, field.NotSupported(path.Child("scheme"), sc.Scheme, []string{"http", "https"}))
+		}
+
+		if sc.ScrapeInterval < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("scrape_interval"), sc.ScrapeInterval, "value cannot be negative"))
+		}
+
+		if sc.Authorization != nil && sc.BasicAuth != nil {
+			allErrs = append(allErrs, field.Forbidden(path, "at most one of authorization and basic_auth may be specified"))
+		}
+
+		if sc.Authorization != nil && sc.Authorization.Credentials == nil {
+			allErrs = append(allErrs, field.Required(path.Child("authorization", "credentials"), "no credentials specified"))
+		}
+
+		if sc.BasicAuth != nil {
+			if sc.BasicAuth.Username == "" {
+				allErrs = append(allErrs, field.Required(path.Child("basic_auth", "username"), "empty username specified"))
+			}
+			if sc.BasicAuth.Password == nil {
+				allErrs = append(allErrs, field.Required(path.Child("basic_auth", "password"), "no password specified"))
+			}
+		}
+
+		if sc.ProxyURL != "" {
+			proxyURL, err := url.Parse(sc.ProxyURL)
+			switch {
+			case err != nil || proxyURL.Host == "":
+				allErrs = append(allErrs, field.Invalid(path.Child("proxy_url"), sc.ProxyURL, "invalid proxy URL specified"))
+			case !slices.Contains(supportedProxySchemes, proxyURL.Scheme):
+				allErrs = append(allErrs, field.NotSupported(path.Child("proxy_url"), proxyURL.Scheme, supportedProxySchemes))
+			}
+		}
+
+		if sc.BodySizeLimit != "" && !bodySizeLimitRegexp.MatchString(sc.BodySizeLimit) {
+			allErrs = append(allErrs, field.Invalid(path.Child("body_size_limit"), sc.BodySizeLimit, "invalid size specified, e.g. 10MB"))
+		}
+
+		// The staleness of the samples is tracked based on the
+		// timestamps exposed by the target only.
+		if ptr.Deref(sc.TrackTimestampsStaleness, false) && !ptr.Deref(sc.HonorTimestamps, true) {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(path.Child("track_timestamps_staleness"), "cannot track the staleness of the timestamps, which are not honored"),
+			)
+		}
+
+		allErrs = append(allErrs, validateScrapeLimits(path.Child("limits"), sc.Limits)...)
+	}
+
+	return allErrs
+}
+
+// validatePipelines validates the additional pipelines of the given
+// [config.CollectorConfig].
+func validatePipelines(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	// Signal type of each known pipeline. Forward pipelines may only
+	// reference pipelines, which are known at the point of their
+	// definition, which also rules out cycles between them.
+	knownPipelines := map[string]string{
+		config.PipelineNameLogs:   "logs",
+		config.PipelineNameEvents: "logs",
+	}
+
+	// The metrics pipeline is fed by the Prometheus receiver, which
+	// requires the Target Allocator, so it is not available in deployment
+	// mode.
+	if cfg.Spec.Mode != config.CollectorModeDeployment {
+		knownPipelines[config.PipelineNameMetrics] = "metrics"
+	}
+	if cfg.Spec.Connectors.ServiceGraphConnector.IsEnabled() {
+		knownPipelines[config.PipelineNameServiceGraph] = "metrics"
+	}
+	if cfg.Spec.Connectors.SpanMetricsConnector.IsEnabled() {
+		knownPipelines[config.PipelineNameSpanMetrics] = "metrics"
+	}
+	enabledExporters := cfg.Spec.Exporters.EnabledExporterNames()
+
+	// The pipelines, which are chained to the managed pipelines via the
+	// failover connector, are reserved.
+	reservedPipelines := sets.New[string]()
+	if cfg.Spec.Exporters.FileExporter.IsFallbackEnabled() {
+		for name := range knownPipelines {
+			primary, fallback := config.FailoverPipelineNames(name)
+			reservedPipelines.Insert(primary, fallback)
+		}
+	}
+	supportedProcessors := []string{config.ProcessorNameMemoryLimiter}
+	if cfg.Spec.Processors.Batch.IsEnabled() {
+		supportedProcessors = append(supportedProcessors, config.ProcessorNameBatch)
+	}
+	for _, processor := range cfg.Spec.Processors.Named {
+		if processor.ProcessorType() != "" {
+			supportedProcessors = append(supportedProcessors, processor.ProcessorName())
+		}
+	}
+
+	for i, pipeline := range cfg.Spec.Pipelines.Forward {
+		path := field.NewPath("spec.pipelines.forward").Index(i)
+
+		signal, _, _ := strings.Cut(pipeline.Name, "/")
+		switch {
+		case pipeline.Name == "":
+			allErrs = append(allErrs, field.Required(path.Child("name"), "empty pipeline name specified"))
+			continue
+		case signal != "logs" && signal != "metrics":
+			allErrs = append(allErrs, field.Invalid(path.Child("name"), pipeline.Name, "pipeline name must be prefixed with either `logs/' or `metrics/'"))
+			continue
+		}
+
+		if _, ok := knownPipelines[pipeline.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), pipeline.Name))
+			continue
+		}
+
+		if reservedPipelines.Has(pipeline.Name) {
+			allErrs = append(allErrs, field.Forbidden(path.Child("name"), "pipeline name is reserved for the fallback of the file exporter"))
+			continue
+		}
+
+		if len(pipeline.From) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("from"), "no source pipeline specified"))
+		}
+
+		for j, from := range pipeline.From {
+			fromSignal, ok := knownPipelines[from]
+			switch {
+			case !ok:
+				allErrs = append(allErrs, field.NotFound(path.Child("from").Index(j), from))
+			case fromSignal != signal:
+				allErrs = append(allErrs, field.Invalid(path.Child("from").Index(j), from, "source pipeline has a different signal type"))
+			}
+		}
+
+		processors := sets.New[string]()
+		for j, processor := range pipeline.Processors {
+			switch {
+			case !slices.Contains(supportedProcessors, processor):
+				allErrs = append(allErrs, field.NotSupported(path.Child("processors").Index(j), processor, supportedProcessors))
+			case processors.Has(processor):
+				allErrs = append(allErrs, field.Duplicate(path.Child("processors").Index(j), processor))
+			}
+			processors.Insert(processor)
+		}
+
+		for j, exporter := range pipeline.Exporters {
+			if !slices.Contains(enabledExporters, exporter) {
+				allErrs = append(allErrs, field.NotSupported(path.Child("exporters").Index(j), exporter, enabledExporters))
+			}
+		}
+
+		knownPipelines[pipeline.Name] = signal
+	}
+
+	// Validate the verbosity of the debug exporters and the pipelines of
+	// the per-pipeline debug exporters.
+	supportedVerbosities := []config.DebugExporterVerbosity{
+		config.DebugExporterVerbosityBasic,
+		config.DebugExporterVerbosityNormal,
+		config.DebugExporterVerbosityDetailed,
+	}
+
+	debugExporter := cfg.Spec.Exporters.DebugExporter
+	if debugExporter.Verbosity != "" && !slices.Contains(supportedVerbosities, debugExporter.Verbosity) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(field.NewPath("spec.exporters.debug.verbosity"), debugExporter.Verbosity, supportedVerbosities),
+		)
+	}
+
+	debugPipelines := sets.New[string]()
+	for i, item := range debugExporter.Pipelines {
+		path := field.NewPath("spec.exporters.debug.pipelines").Index(i)
+
+		if _, ok := knownPipelines[item.Pipeline]; !ok {
+			allErrs = append(allErrs, field.NotFound(path.Child("pipeline"), item.Pipeline))
+		}
+
+		if debugPipelines.Has(item.Pipeline) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("pipeline"), item.Pipeline))
+		}
+		debugPipelines.Insert(item.Pipeline)
+
+		if item.Verbosity != "" && !slices.Contains(supportedVerbosities, item.Verbosity) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("verbosity"), item.Verbosity, supportedVerbosities))
+		}
+	}
+
+	return allErrs
+}
+
+// validateDNS validates the DNS settings of the pods from the given
+// [config.CollectorConfig].
+func validateDNS(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	dns := cfg.Spec.DNS
+	basePath := field.NewPath("spec.dns")
+
+	supportedPolicies := []corev1.DNSPolicy{
+		corev1.DNSClusterFirst,
+		corev1.DNSClusterFirstWithHostNet,
+		corev1.DNSDefault,
+		corev1.DNSNone,
+	}
+	if dns.Policy != "" && !slices.Contains(supportedPolicies, dns.Policy) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(basePath.Child("policy"), dns.Policy, supportedPolicies),
+		)
+	}
+
+	configPath := basePath.Child("config")
+	if dns.Policy == corev1.DNSNone && (dns.Config == nil || len(dns.Config.Nameservers) == 0) {
+		allErrs = append(
+			allErrs,
+			field.Required(configPath.Child("nameservers"), "at least one nameserver is required with the None DNS policy"),
+		)
+	}
+
+	if dns.Config == nil {
+		return allErrs
+	}
+
+	// Kubernetes allows at most 3 nameservers, 32 search domains and
+	// requires the nameservers to be IP addresses.
+	if len(dns.Config.Nameservers) > 3 {
+		allErrs = append(
+			allErrs,
+			field.TooMany(configPath.Child("nameservers"), len(dns.Config.Nameservers), 3),
+		)
+	}
+
+	for i, ns := range dns.Config.Nameservers {
+		if net.ParseIP(ns) == nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(configPath.Child("nameservers").Index(i), ns, "must be a valid IP address"),
+			)
+		}
+	}
+
+	if len(dns.Config.Searches) > 32 {
+		allErrs = append(
+			allErrs,
+			field.TooMany(configPath.Child("searches"), len(dns.Config.Searches), 32),
+		)
+	}
+
+	for i, opt := range dns.Config.Options {
+		if opt.Name == "" {
+			allErrs = append(
+				allErrs,
+				field.Required(configPath.Child("options").Index(i).Child("name"), "option name is required"),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// validateGateway validates the settings of the OTLP gateway from the given
+// [config.CollectorConfig].
+func validateGateway(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	gateway := cfg.Spec.Gateway
+	basePath := field.NewPath("spec.gateway")
+
+	if !gateway.IsEnabled() {
+		return allErrs
+	}
+
+	// In deployment mode the collector is a stateless OTLP gateway
+	// already, so there is no processing tier to forward the data to.
+	if cfg.Spec.Mode == config.CollectorModeDeployment {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(basePath.Child("enabled"), "the gateway is not supported in deployment mode"),
+		)
+	}
+
+	if gateway.Replicas < 1 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(basePath.Child("replicas"), gateway.Replicas, "at least one replica is required"),
+		)
+	}
+
+	return allErrs
+}
+
+// validatePorts validates the additional ports of the collector from the given
+// [config.CollectorConfig].
+func validatePorts(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	basePath := field.NewPath("spec.ports")
+	supportedProtocols := []string{
+		string(corev1.ProtocolTCP),
+		string(corev1.ProtocolUDP),
+		string(corev1.ProtocolSCTP),
+	}
+	names := sets.New[string]()
+	ports := sets.New[string]()
+
+	// The port of the StatsD receiver is exposed by the collector as well.
+	if statsd := cfg.Spec.Receivers.StatsDReceiver; statsd.IsEnabled() {
+		if _, port, err := net.SplitHostPort(statsd.Endpoint); err == nil {
+			names.Insert(config.ReceiverNameStatsD)
+			ports.Insert(fmt.Sprintf("%s/%d", corev1.ProtocolUDP, portNumber(port)))
+		}
+	}
+
+	for i, port := range cfg.Spec.Ports {
+		path := basePath.Index(i)
+
+		for _, msg := range utilvalidation.IsValidPortName(port.Name) {
+			allErrs = append(allErrs, field.Invalid(path.Child("name"), port.Name, msg))
+		}
+		if names.Has(port.Name) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), port.Name))
+		}
+		names.Insert(port.Name)
+
+		for _, msg := range utilvalidation.IsValidPortNum(int(port.Port)) {
+			allErrs = append(allErrs, field.Invalid(path.Child("port"), port.Port, msg))
+		}
+
+		if !slices.Contains(supportedProtocols, string(port.Protocol)) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("protocol"), port.Protocol, supportedProtocols))
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%d", port.Protocol, port.Port)
+		if ports.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("port"), port.Port))
+		}
+		ports.Insert(key)
+	}
+
+	return allErrs
+}
+
+// managedVolumeMountPaths are the paths, at which the volumes managed by the
+// extension and the OTel Operator are mounted into the collector container.
+var managedVolumeMountPaths = []string{
+	"/conf",
+	"/etc/auth",
+	"/etc/ssl",
+	"/hostfs",
+	"/var/run/secrets",
+}
+
+// mountPathsOverlap returns whether one of the given mount paths is equal to
+// or located below the other one.
+func mountPathsOverlap(a, b string) bool {
+	if a == "/" || b == "/" {
+		return true
+	}
+
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// validateVolumes validates the additional volumes of the collector from the
+// given [config.CollectorConfig].
+func validateVolumes(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	basePath := field.NewPath("spec.volumes")
+	names := sets.New[string]()
+
+	reservedPaths := slices.Clone(managedVolumeMountPaths)
+	if cfg.Spec.Exporters.FileExporter.IsEnabled() {
+		reservedPaths = append(reservedPaths, filepath.Dir(cfg.Spec.Exporters.FileExporter.Path))
+	}
+	if cfg.Spec.Exporters.DebugExporter.IsEnabled() && cfg.Spec.Exporters.DebugExporter.Path != "" {
+		reservedPaths = append(reservedPaths, filepath.Dir(cfg.Spec.Exporters.DebugExporter.Path))
+	}
+
+	var mountPaths []string
+	for i, volume := range cfg.Spec.Volumes {
+		path := basePath.Index(i)
+
+		for _, msg := range utilvalidation.IsDNS1123Label(volume.Name) {
+			allErrs = append(allErrs, field.Invalid(path.Child("name"), volume.Name, msg))
+		}
+		if names.Has(volume.Name) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), volume.Name))
+		}
+		names.Insert(volume.Name)
+
+		switch {
+		case !filepath.IsAbs(volume.MountPath):
+			allErrs = append(allErrs, field.Invalid(path.Child("mountPath"), volume.MountPath, "path must be absolute"))
+		case filepath.Clean(volume.MountPath) != volume.MountPath:
+			allErrs = append(allErrs, field.Invalid(path.Child("mountPath"), volume.MountPath, "path must be clean"))
+		case slices.ContainsFunc(reservedPaths, func(p string) bool { return mountPathsOverlap(p, volume.MountPath) }):
+			allErrs = append(allErrs, field.Forbidden(path.Child("mountPath"), "path overlaps with the volumes managed by the extension"))
+		case slices.ContainsFunc(mountPaths, func(p string) bool { return mountPathsOverlap(p, volume.MountPath) }):
+			allErrs = append(allErrs, field.Duplicate(path.Child("mountPath"), volume.MountPath))
+		default:
+			mountPaths = append(mountPaths, volume.MountPath)
+		}
+
+		switch {
+		case volume.ResourceRef == nil && volume.EmptyDir == nil:
+			allErrs = append(allErrs, field.Required(path, "either resourceRef or emptyDir must be specified"))
+		case volume.ResourceRef != nil && volume.EmptyDir != nil:
+			allErrs = append(allErrs, field.Forbidden(path.Child("emptyDir"), "must not be specified along with resourceRef"))
+		case volume.ResourceRef != nil:
+			if volume.ResourceRef.Name == "" {
+				allErrs = append(allErrs, field.Required(path.Child("resourceRef.name"), "resource name is required"))
+			}
+			for _, msg := range utilvalidation.IsConfigMapKey(volume.ResourceRef.DataKey) {
+				allErrs = append(allErrs, field.Invalid(path.Child("resourceRef.dataKey"), volume.ResourceRef.DataKey, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateSecurityContext validates the security context of the collector pods
+// from the given [config.CollectorConfig].
+func validateSecurityContext(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	securityContext := cfg.Spec.SecurityContext
+	basePath := field.NewPath("spec.security_context")
+
+	for _, msg := range utilvalidation.IsValidUserID(securityContext.RunAsUser) {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("run_as_user"), securityContext.RunAsUser, msg))
+	}
+	for _, msg := range utilvalidation.IsValidGroupID(securityContext.RunAsGroup) {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("run_as_group"), securityContext.RunAsGroup, msg))
+	}
+
+	return allErrs
+}
+
+// validateIntOrPercent validates the given number or percentage of pods and
+// returns it as a percentage, i.e. numbers are returned as is.
+func validateIntOrPercent(value *intstr.IntOrString, path *field.Path) (int, field.ErrorList) {
+	allErrs := make(field.ErrorList, 0)
+	if value == nil {
+		return 0, allErrs
+	}
+
+	if value.Type == intstr.String {
+		msgs := utilvalidation.IsValidPercent(value.StrVal)
+		for _, msg := range msgs {
+			allErrs = append(allErrs, field.Invalid(path, value.StrVal, msg))
+		}
+		if len(msgs) > 0 {
+			return 0, allErrs
+		}
+
+		percent, _ := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+
+		return percent, allErrs
+	}
+
+	if value.IntVal < 0 {
+		allErrs = append(allErrs, field.Invalid(path, value.IntVal, "value cannot be negative"))
+	}
+
+	return int(value.IntVal), allErrs
+}
+
+// validateUpdateStrategy validates the rolling update of the collector pods
+// from the given [config.CollectorConfig].
+func validateUpdateStrategy(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	strategy := cfg.Spec.UpdateStrategy
+	basePath := field.NewPath("spec.update_strategy")
+
+	if !strategy.IsConfigured() {
+		return allErrs
+	}
+
+	// The OTel Operator exposes the update strategy of Deployments only.
+	if cfg.Spec.Mode != config.CollectorModeDeployment && !cfg.Spec.Gateway.IsEnabled() {
+		allErrs = append(allErrs, field.Forbidden(basePath, "update strategy applies to the deployment mode and the OTLP gateway only"))
+	}
+
+	maxUnavailable, errs := validateIntOrPercent(strategy.MaxUnavailable, basePath.Child("max_unavailable"))
+	allErrs = append(allErrs, errs...)
+	maxSurge, errs := validateIntOrPercent(strategy.MaxSurge, basePath.Child("max_surge"))
+	allErrs = append(allErrs, errs...)
+
+	if strategy.MaxUnavailable != nil && strategy.MaxUnavailable.Type == intstr.String && maxUnavailable > 100 {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("max_unavailable"), strategy.MaxUnavailable.StrVal, "value must not be greater than 100%"))
+	}
+
+	// The rolling update does not make progress, if neither pods are
+	// unavailable nor additional pods are created.
+	if strategy.MaxUnavailable != nil && strategy.MaxSurge != nil && maxUnavailable == 0 && maxSurge == 0 {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("max_unavailable"), strategy.MaxUnavailable.String(), "value must not be 0, when max_surge is 0"))
+	}
+
+	return allErrs
+}
+
+// validateShutdownTimeout validates the shutdown timeout of the collector,
+// from which the termination grace period of the pods is derived in seconds.
+func validateShutdownTimeout(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	path := field.NewPath("spec.shutdown_timeout")
+	timeout := cfg.Spec.ShutdownTimeout
+
+	switch {
+	case timeout < 0:
+		allErrs = append(allErrs, field.Invalid(path, timeout.String(), "timeout cannot be negative"))
+	case timeout%time.Second != 0:
+		allErrs = append(allErrs, field.Invalid(path, timeout.String(), "timeout must be a whole number of seconds"))
+	}
+
+	return allErrs
+}
+
+// validateServiceGraphConnector validates the settings of the Service Graph
+// connector from the given [config.CollectorConfig].
+func validateServiceGraphConnector(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	connector := cfg.Spec.Connectors.ServiceGraphConnector
+	basePath := field.NewPath("spec.connectors.servicegraph")
+
+	if !connector.IsEnabled() {
+		return allErrs
+	}
+
+	// The extension does not manage any traces pipeline, hence the spans
+	// are received by the pipelines of the referenced configuration only.
+	if cfg.Spec.ConfigRef == nil {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("enabled"), "connector requires a traces pipeline of the referenced collector configuration"))
+	}
+
+	for i, bucket := range connector.LatencyHistogramBuckets {
+		path := basePath.Child("latency_histogram_buckets").Index(i)
+		switch {
+		case bucket <= 0:
+			allErrs = append(allErrs, field.Invalid(path, bucket.String(), "bucket must be positive"))
+		case i > 0 && bucket <= connector.LatencyHistogramBuckets[i-1]:
+			allErrs = append(allErrs, field.Invalid(path, bucket.String(), "buckets must be in increasing order"))
+		}
+	}
+
+	allErrs = append(allErrs, validateConnectorDimensions(basePath.Child("dimensions"), connector.Dimensions)...)
+
+	return allErrs
+}
+
+// maxTailSamplingNumTraces is the maximum number of traces kept in memory by
+// the Tail Sampling processors. The collector pods have no memory limit, which
+// the number could be derived from, hence it is bounded by a static maximum,
+// which amounts to a few GiB for traces of a moderate size.
+const maxTailSamplingNumTraces = 1000000
+
+// validateTailSampling validates the settings of the Tail Sampling processors
+// from the given [config.CollectorConfig].
+func validateTailSampling(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	processor := cfg.Spec.Processors.TailSampling
+	basePath := field.NewPath("spec.processors.tail_sampling")
+
+	if processor == (config.TailSamplingProcessorConfig{}) {
+		return allErrs
+	}
+
+	// The extension does not manage any traces pipeline, hence the Tail
+	// Sampling processors are configured by the referenced configuration
+	// only.
+	if cfg.Spec.ConfigRef == nil {
+		allErrs = append(allErrs, field.Forbidden(basePath, "settings apply to the tail sampling processors of the referenced collector configuration only"))
+	}
+
+	if processor.DecisionWait < 0 {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("decision_wait"), processor.DecisionWait.String(), "decision wait must be positive"))
+	}
+
+	switch {
+	case processor.NumTraces < 0:
+		allErrs = append(allErrs, field.Invalid(basePath.Child("num_traces"), processor.NumTraces, "value must be positive"))
+	case processor.NumTraces > maxTailSamplingNumTraces:
+		allErrs = append(allErrs, field.Invalid(basePath.Child("num_traces"), processor.NumTraces, fmt.Sprintf("value must not be greater than %d", maxTailSamplingNumTraces)))
+	}
+
+	if processor.ExpectedNewTracesPerSec < 0 {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("expected_new_traces_per_sec"), processor.ExpectedNewTracesPerSec, "value cannot be negative"))
+	}
+
+	return allErrs
+}
+
+// validateSpanMetricsConnector validates the settings of the Span Metrics
+// connector from the given [config.CollectorConfig].
+func validateSpanMetricsConnector(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	connector := cfg.Spec.Connectors.SpanMetricsConnector
+	basePath := field.NewPath("spec.connectors.spanmetrics")
+
+	if !connector.IsEnabled() {
+		return allErrs
+	}
+
+	// The extension does not manage any traces pipeline, hence the spans
+	// are received by the pipelines of the referenced configuration only.
+	if cfg.Spec.ConfigRef == nil {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("enabled"), "connector requires a traces pipeline of the referenced collector configuration"))
+	}
+
+	allErrs = append(allErrs, validateConnectorDimensions(basePath.Child("dimensions"), connector.Dimensions)...)
+
+	exemplars := connector.Exemplars
+	if limit := exemplars.MaxPerDataPoint; limit != nil {
+		switch {
+		case !exemplars.IsEnabled():
+			allErrs = append(allErrs, field.Forbidden(basePath.Child("exemplars", "max_per_data_point"), "limit requires the exemplars to be enabled"))
+		case *limit <= 0:
+			allErrs = append(allErrs, field.Invalid(basePath.Child("exemplars", "max_per_data_point"), *limit, "value must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateConnectorDimensions validates the dimensions of a connector with the
+// given path.
+func validateConnectorDimensions(path *field.Path, items []string) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	dimensions := sets.New[string]()
+	for i, dimension := range items {
+		switch {
+		case dimension == "":
+			allErrs = append(allErrs, field.Required(path.Index(i), "empty dimension specified"))
+		case dimensions.Has(dimension):
+			allErrs = append(allErrs, field.Duplicate(path.Index(i), dimension))
+		}
+		dimensions.Insert(dimension)
+	}
+
+	return allErrs
+}
+
+// validateStatsDReceiver validates the settings of the StatsD receiver from
+// the given [config.CollectorConfig].
+func validateStatsDReceiver(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	receiver := cfg.Spec.Receivers.StatsDReceiver
+	basePath := field.NewPath("spec.receivers.statsd")
+
+	if !receiver.IsEnabled() {
+		return allErrs
+	}
+
+	// The received metrics are processed by the metrics pipeline, which is
+	// not available in deployment mode.
+	if cfg.Spec.Mode == config.CollectorModeDeployment {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(basePath.Child("enabled"), "the statsd receiver is not supported in deployment mode"),
+		)
+	}
+
+	_, port, err := net.SplitHostPort(receiver.Endpoint)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("endpoint"), receiver.Endpoint, err.Error()))
+	} else {
+		for _, msg := range utilvalidation.IsValidPortNum(portNumber(port)) {
+			allErrs = append(allErrs, field.Invalid(basePath.Child("endpoint"), receiver.Endpoint, msg))
+		}
+	}
+
+	if receiver.AggregationInterval <= 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(basePath.Child("aggregation_interval"), receiver.AggregationInterval.String(), "aggregation interval must be positive"),
+		)
+	}
+
+	return allErrs
+}
+
+// portNumber returns the number of the given port, or zero if it is not a
+// number.
+func portNumber(port string) int {
+	n, err := strconv.Atoi(port)
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
+
+// validateHostMetricsReceiver validates the settings of the host metrics
+// receiver from the given [config.CollectorConfig].
+func validateHostMetricsReceiver(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	receiver := cfg.Spec.Receivers.HostMetricsReceiver
+	basePath := field.NewPath("spec.receivers.hostmetrics")
+
+	if !receiver.IsEnabled() {
+		return allErrs
+	}
+
+	// The collected metrics are processed by the metrics pipeline, which
+	// is not available in deployment mode.
+	if cfg.Spec.Mode == config.CollectorModeDeployment {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(basePath.Child("enabled"), "the hostmetrics receiver is not supported in deployment mode"),
+		)
+	}
+
+	if len(receiver.Scrapers) == 0 {
+		allErrs = append(allErrs, field.Required(basePath.Child("scrapers"), "no scraper specified"))
+	}
+
+	supportedScrapers := []string{"cpu", "disk", "filesystem", "load", "memory", "network", "paging", "processes", "system"}
+	seen := sets.New[string]()
+	for i, scraper := range receiver.Scrapers {
+		path := basePath.Child("scrapers").Index(i)
+		switch {
+		case !slices.Contains(supportedScrapers, scraper):
+			allErrs = append(allErrs, field.NotSupported(path, scraper, supportedScrapers))
+		case seen.Has(scraper):
+			allErrs = append(allErrs, field.Duplicate(path, scraper))
+		}
+		seen.Insert(scraper)
+	}
+
+	if receiver.CollectionInterval <= 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(basePath.Child("collection_interval"), receiver.CollectionInterval.String(), "collection interval must be positive"),
+		)
+	}
+
+	return allErrs
+}
+
+// validatePodAnnotations validates the additional annotations of the collector
+// and Target Allocator pods from the given [config.CollectorConfig].
+func validatePodAnnotations(cfg config.CollectorConfig) field.ErrorList {
+	basePath := field.NewPath("spec.pod_annotations")
+	allErrs := apivalidation.ValidateAnnotations(cfg.Spec.PodAnnotations, basePath)
+
+	for _, key := range slices.Sorted(maps.Keys(cfg.Spec.PodAnnotations)) {
+		domain, _, ok := strings.Cut(key, "/")
+		if ok && (domain == "gardener.cloud" || strings.HasSuffix(domain, ".gardener.cloud")) {
+			allErrs = append(allErrs, field.Forbidden(basePath.Key(key), "annotations of the gardener.cloud domain are reserved"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateServiceAccountAnnotations validates the additional annotations of the
+// service account of the collector from the given [config.CollectorConfig].
+func validateServiceAccountAnnotations(cfg config.CollectorConfig) field.ErrorList {
+	basePath := field.NewPath("spec.service_account_annotations")
+	allErrs := apivalidation.ValidateAnnotations(cfg.Spec.ServiceAccountAnnotations, basePath)
+
+	managedKeys := sets.New[string]()
+	if cfg.Spec.Exporters.GoogleCloudExporter.IsEnabled() {
+		managedKeys.Insert(config.AnnotationGCPServiceAccount)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(cfg.Spec.ServiceAccountAnnotations)) {
+		domain, _, ok := strings.Cut(key, "/")
+		switch {
+		case ok && (domain == "gardener.cloud" || strings.HasSuffix(domain, ".gardener.cloud")):
+			allErrs = append(allErrs, field.Forbidden(basePath.Key(key), "annotations of the gardener.cloud domain are reserved"))
+		case managedKeys.Has(key):
+			allErrs = append(allErrs, field.Forbidden(basePath.Key(key), "annotation is managed by the enabled exporters"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMetricsTransform validates the transforms of the Metrics Transform
+// processor from the given [config.CollectorConfig].
+func validateMetricsTransform(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	basePath := field.NewPath("spec.processors.metricstransform.transforms")
+
+	supportedMatchTypes := []string{"strict", "regexp"}
+	supportedActions := []string{"update", "insert", "combine"}
+
+	for i, transform := range cfg.Spec.Processors.MetricsTransform.Transforms {
+		path := basePath.Index(i)
+		matchType := cmp.Or(transform.MatchType, "strict")
+
+		if transform.Include == "" {
+			allErrs = append(allErrs, field.Required(path.Child("include"), "metric name must be specified"))
+		}
+
+		switch {
+		case !slices.Contains(supportedMatchTypes, matchType):
+			allErrs = append(allErrs, field.NotSupported(path.Child("match_type"), matchType, supportedMatchTypes))
+		case matchType == "regexp":
+			if _, err := regexp.Compile(transform.Include); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child("include"), transform.Include, err.Error()))
+			}
+		}
+
+		switch transform.Action {
+		case "update":
+		case "insert", "combine":
+			if transform.NewName == "" {
+				allErrs = append(allErrs, field.Required(path.Child("new_name"), "new name is required by the "+transform.Action+" action"))
+			}
+			if transform.Action == "combine" && matchType != "regexp" {
+				allErrs = append(allErrs, field.Invalid(path.Child("match_type"), matchType, "combine action requires the regexp match type"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(path.Child("action"), transform.Action, supportedActions))
+		}
+
+		for j, op := range transform.Operations {
+			allErrs = append(allErrs, validateMetricsTransformOperation(path.Child("operations").Index(j), op)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateBatchProcessor validates the settings of the Batch processor of the
+// collector. The maximum size of the batches must not exceed the maximum size
+// of the requests of the exporters measured in items, which would split every
+// large batch again.
+func validateBatchProcessor(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	batch := cfg.Spec.Processors.Batch
+	path := field.NewPath("spec.processors.batch.send_batch_max_size")
+
+	switch {
+	case batch.SendBatchMaxSize < 0:
+		return append(allErrs, field.Invalid(path, batch.SendBatchMaxSize, "value cannot be negative"))
+	case batch.SendBatchMaxSize == 0:
+		return allErrs
+	case !batch.IsEnabled():
+		return append(allErrs, field.Forbidden(path, "cannot be specified with a disabled batch processor"))
+	}
+
+	queues := make(map[string]config.SendingQueueConfig)
+	if exporter := cfg.Spec.Exporters.OTLPHTTPExporter; exporter.IsEnabled() {
+		queues[config.ExporterNameOTLPHTTP] = exporter.SendingQueue
+	}
+	if exporter := cfg.Spec.Exporters.OTLPGRPCExporter; exporter.IsEnabled() {
+		queues[config.ExporterNameOTLPGRPC] = exporter.SendingQueue
+	}
+	for _, exporter := range cfg.Spec.Exporters.Named {
+		switch {
+		case !exporter.IsEnabled():
+			continue
+		case exporter.OTLPHTTPExporter != nil:
+			queues[exporter.ExporterName()] = exporter.OTLPHTTPExporter.SendingQueue
+		case exporter.OTLPGRPCExporter != nil:
+			queues[exporter.ExporterName()] = exporter.OTLPGRPCExporter.SendingQueue
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(queues)) {
+		queue := queues[name]
+		if !queue.Batch.IsEnabled() || cmp.Or(queue.Batch.Sizer, config.RequestSizerItems) != config.RequestSizerItems {
+			continue
+		}
+
+		if batch.SendBatchMaxSize > queue.Batch.MaxSize {
+			msg := fmt.Sprintf("exceeds the maximum size %d of the requests of the %s exporter", queue.Batch.MaxSize, name)
+			allErrs = append(allErrs, field.Invalid(path, batch.SendBatchMaxSize, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMetricsTransformOperation validates the given operation of a
+// transform of the Metrics Transform processor.
+func validateMetricsTransformOperation(path *field.Path, op config.MetricsTransformOperationConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	supportedActions := []string{"add_label", "update_label", "delete_label_value", "aggregate_labels", "aggregate_label_values"}
+	supportedAggregationTypes := []string{"sum", "mean", "min", "max", "count", "median"}
+
+	requireField := func(name, value string) {
+		if value == "" {
+			allErrs = append(allErrs, field.Required(path.Child(name), "required by the "+op.Action+" action"))
+		}
+	}
+
+	switch op.Action {
+	case "add_label":
+		requireField("new_label", op.NewLabel)
+		requireField("new_value", op.NewValue)
+	case "update_label":
+		requireField("label", op.Label)
+	case "delete_label_value":
+		requireField("label", op.Label)
+		requireField("label_value", op.LabelValue)
+	case "aggregate_labels":
+		if len(op.LabelSet) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("label_set"), "required by the "+op.Action+" action"))
+		}
+	case "aggregate_label_values":
+		requireField("label", op.Label)
+		requireField("new_value", op.NewValue)
+		if len(op.AggregatedValues) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("aggregated_values"), "required by the "+op.Action+" action"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("action"), op.Action, supportedActions))
+	}
+
+	isAggregation := op.Action == "aggregate_labels" || op.Action == "aggregate_label_values"
+	switch {
+	case isAggregation && op.AggregationType == "":
+		allErrs = append(allErrs, field.Required(path.Child("aggregation_type"), "required by the "+op.Action+" action"))
+	case op.AggregationType != "" && !slices.Contains(supportedAggregationTypes, op.AggregationType):
+		allErrs = append(allErrs, field.NotSupported(path.Child("aggregation_type"), op.AggregationType, supportedAggregationTypes))
+	}
+
+	return allErrs
+}
+
+// validateFileExporter validates the settings of the file exporter from the
+// given [config.CollectorConfig].
+func validateFileExporter(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	exporter := cfg.Spec.Exporters.FileExporter
+	basePath := field.NewPath("spec.exporters.file")
+
+	if !exporter.IsEnabled() {
+		return allErrs
+	}
+
+	// The file is written to a dedicated volume, which is mounted at the
+	// parent directory of the file.
+	switch {
+	case !filepath.IsAbs(exporter.Path):
+		allErrs = append(allErrs, field.Invalid(basePath.Child("path"), exporter.Path, "path must be absolute"))
+	case filepath.Clean(exporter.Path) != exporter.Path:
+		allErrs = append(allErrs, field.Invalid(basePath.Child("path"), exporter.Path, "path must be clean"))
+	case filepath.Dir(exporter.Path) == "/":
+		allErrs = append(allErrs, field.Invalid(basePath.Child("path"), exporter.Path, "path must not be located in the root directory"))
+	}
+
+	supportedFormats := []config.FileExporterFormat{
+		config.FileExporterFormatJSON,
+		config.FileExporterFormatProto,
+	}
+	if exporter.Format != "" && !slices.Contains(supportedFormats, exporter.Format) {
+		allErrs = append(allErrs, field.NotSupported(basePath.Child("format"), exporter.Format, supportedFormats))
+	}
+
+	if exporter.Rotation.MaxMegabytes <= 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(basePath.Child("rotation", "max_megabytes"), exporter.Rotation.MaxMegabytes, "value must be positive"),
+		)
+	}
+
+	// The fallback receives the data refused by the other exporters only.
+	if exporter.IsFallbackEnabled() && len(cfg.Spec.Exporters.EnabledExporterNames()) < 2 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(basePath.Child("fallback"), true, "fallback requires another exporter to be enabled"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateDuplicateExporters validates that the OTLP HTTP and OTLP gRPC
+// exporters do not export to the same endpoint with the same credentials,
+// which would otherwise receive the signals twice.
+func validateDuplicateExporters(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	httpExporter := cfg.Spec.Exporters.OTLPHTTPExporter
+	grpcExporter := cfg.Spec.Exporters.OTLPGRPCExporter
+
+	if !httpExporter.IsEnabled() || !grpcExporter.IsEnabled() {
+		return allErrs
+	}
+
+	// The endpoint of the gRPC exporter is specified with an optional
+	// scheme, hence the endpoints are compared by their host and port.
+	hostPort := func(endpoint string) string {
+		if _, rest, ok := strings.Cut(endpoint, "://"); ok {
+			endpoint = rest
+		}
+		host, _, _ := strings.Cut(endpoint, "/")
+
+		return host
+	}
+
+	if hostPort(httpExporter.Endpoint) == hostPort(grpcExporter.Endpoint) &&
+		ptr.Equal(httpExporter.Token, grpcExporter.Token) {
+		allErrs = append(
+			allErrs,
+			field.Duplicate(field.NewPath("spec.exporters.otlp_grpc.endpoint"), grpcExporter.Endpoint),
+		)
+	}
+
+	return allErrs
+}
+
+// validateMetricsPush validates the settings of the periodic reader, which
+// pushes the internal metrics of the collector via OTLP.
+func validateMetricsPush(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	push := cfg.Spec.Metrics.Push
+	basePath := field.NewPath("spec.metrics.push")
+
+	if !push.IsEnabled() {
+		return allErrs
+	}
+
+	if cfg.Spec.Metrics.Level == config.MetricsVerbosityLevelNone {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("enabled"), "internal metrics are disabled via level none"))
+	}
+
+	supportedProtocols := []config.MetricsPushProtocol{
+		config.MetricsPushProtocolGRPC,
+		config.MetricsPushProtocolHTTPProtobuf,
+	}
+	if push.Protocol != "" && !slices.Contains(supportedProtocols, push.Protocol) {
+		allErrs = append(allErrs, field.NotSupported(basePath.Child("protocol"), push.Protocol, supportedProtocols))
+	}
+
+	if push.Interval < 0 {
+		allErrs = append(allErrs, field.Invalid(basePath.Child("interval"), push.Interval.String(), "value must not be negative"))
+	}
+
+	// The endpoint of the OTLP HTTP exporter is used along with its CA,
+	// client certificate and token, unless an endpoint is specified. The
+	// internal telemetry cannot skip the verification of the server
+	// certificate though.
+	if push.Endpoint == "" {
+		exporter := cfg.Spec.Exporters.OTLPHTTPExporter
+		switch {
+		case !exporter.IsEnabled():
+			allErrs = append(allErrs, field.Required(basePath.Child("endpoint"), "endpoint is required, unless the otlp_http exporter is enabled"))
+		case exporter.TLS != nil && ptr.Deref(exporter.TLS.InsecureSkipVerify, false):
+			allErrs = append(allErrs, field.Required(basePath.Child("endpoint"), "endpoint is required, since insecureSkipVerify of the otlp_http exporter does not apply to the pushed metrics"))
+		case push.Protocol == config.MetricsPushProtocolGRPC:
+			allErrs = append(allErrs, field.Required(basePath.Child("endpoint"), "endpoint is required for the grpc protocol"))
+		}
+
+		return allErrs
+	}
+
+	supportedSchemes := []string{"http", "https"}
+	endpoint, err := url.Parse(push.Endpoint)
+	switch {
+	case err != nil || endpoint.Host == "":
+		allErrs = append(allErrs, field.Invalid(basePath.Child("endpoint"), push.Endpoint, "invalid URL specified"))
+	case !slices.Contains(supportedSchemes, endpoint.Scheme):
+		allErrs = append(allErrs, field.NotSupported(basePath.Child("endpoint"), endpoint.Scheme, supportedSchemes))
+	}
+
+	return allErrs
+}
+
+// validateMetricsNaming validates the naming convention of the internal metrics
+// exposed by the Prometheus reader of the collector.
+func validateMetricsNaming(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	metrics := cfg.Spec.Metrics
+	basePath := field.NewPath("spec.metrics")
+
+	supportedNamings := []config.MetricsNaming{
+		config.MetricsNamingPrometheus,
+		config.MetricsNamingOTel,
+	}
+	if metrics.Naming != "" && !slices.Contains(supportedNamings, metrics.Naming) {
+		allErrs = append(allErrs, field.NotSupported(basePath.Child("naming"), metrics.Naming, supportedNamings))
+	}
+
+	// The otel naming omits both suffixes, hence keeping either of them
+	// contradicts the naming.
+	if metrics.Naming == config.MetricsNamingOTel {
+		prometheusPath := basePath.Child("prometheus")
+		if ptr.Equal(metrics.Prometheus.WithoutUnits, ptr.To(false)) {
+			allErrs = append(allErrs, field.Invalid(prometheusPath.Child("without_units"), false, "unit suffix is always omitted with the otel naming"))
+		}
+		if ptr.Equal(metrics.Prometheus.WithoutTypeSuffix, ptr.To(false)) {
+			allErrs = append(allErrs, field.Invalid(prometheusPath.Child("without_type_suffix"), false, "type suffix is always omitted with the otel naming"))
+		}
+	}
+
+	return allErrs
+}
+
+// instrumentNameRegexp matches the names of the instruments of the internal
+// metrics, which may contain the `*' and `?' wildcards.
+var instrumentNameRegexp = regexp.MustCompile(`^[a-zA-Z*?][a-zA-Z0-9_.\-/*?]{0,254}$`)
+
+// validateDisabledMetrics validates the disabled internal metrics from the
+// given [config.CollectorConfig].
+func validateDisabledMetrics(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	metrics := cfg.Spec.Metrics
+	basePath := field.NewPath("spec.metrics.disabled_metrics")
+
+	if len(metrics.DisabledMetrics) == 0 {
+		return allErrs
+	}
+
+	if metrics.Level == config.MetricsVerbosityLevelNone {
+		allErrs = append(allErrs, field.Forbidden(basePath, "internal metrics are disabled via level none"))
+	}
+
+	names := sets.New[string]()
+	for i, name := range metrics.DisabledMetrics {
+		path := basePath.Index(i)
+		if !instrumentNameRegexp.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(path, name, "invalid instrument name"))
+		}
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(path, name))
+		}
+		names.Insert(name)
+	}
+
+	return allErrs
+}
+
+// validateDebugExporterPath validates the path of the file, to which the
+// debug exporter from the given [config.CollectorConfig] writes its output.
+func validateDebugExporterPath(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	exporter := cfg.Spec.Exporters.DebugExporter
+	path := field.NewPath("spec.exporters.debug.path")
+
+	if !exporter.IsEnabled() || exporter.Path == "" {
+		return allErrs
+	}
+
+	// The file is written to a dedicated volume, which is mounted at the
+	// parent directory of the file, hence it must not overlap with the
+	// volume of the file exporter.
+	switch {
+	case !filepath.IsAbs(exporter.Path):
+		allErrs = append(allErrs, field.Invalid(path, exporter.Path, "path must be absolute"))
+	case filepath.Clean(exporter.Path) != exporter.Path:
+		allErrs = append(allErrs, field.Invalid(path, exporter.Path, "path must be clean"))
+	case filepath.Dir(exporter.Path) == "/":
+		allErrs = append(allErrs, field.Invalid(path, exporter.Path, "path must not be located in the root directory"))
+	case slices.ContainsFunc(managedVolumeMountPaths, func(p string) bool { return mountPathsOverlap(p, filepath.Dir(exporter.Path)) }):
+		allErrs = append(allErrs, field.Forbidden(path, "path overlaps with the volumes managed by the extension"))
+	case cfg.Spec.Exporters.FileExporter.IsEnabled() &&
+		mountPathsOverlap(filepath.Dir(cfg.Spec.Exporters.FileExporter.Path), filepath.Dir(exporter.Path)):
+		allErrs = append(allErrs, field.Forbidden(path, "path overlaps with the volume of the file exporter"))
+	}
+
+	return allErrs
+}
+
+// validateGoogleCloudExporter validates the settings of the Google Cloud
+// exporter from the given [config.CollectorConfig].
+func validateGoogleCloudExporter(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	exporter := cfg.Spec.Exporters.GoogleCloudExporter
+	basePath := field.NewPath("spec.exporters.googlecloud")
+
+	if !exporter.IsEnabled() {
+		return allErrs
+	}
+
+	if cfg.Spec.Distribution == config.CollectorDistributionK8s {
+		allErrs = append(allErrs, field.Forbidden(basePath.Child("enabled"), "exporter is not available in the k8s distribution"))
+	}
+
+	switch {
+	case exporter.Project == "":
+		allErrs = append(allErrs, field.Required(basePath.Child("project"), "project is required"))
+	case !googleCloudProjectIDRegexp.MatchString(exporter.Project):
+		allErrs = append(allErrs, field.Invalid(basePath.Child("project"), exporter.Project, "invalid project ID specified"))
+	}
+
+	// The exporter authenticates via Workload Identity only.
+	switch {
+	case exporter.ServiceAccount == "":
+		allErrs = append(allErrs, field.Required(basePath.Child("service_account"), "service account is required for Workload Identity"))
+	case !googleServiceAccountRegexp.MatchString(exporter.ServiceAccount):
+		allErrs = append(allErrs, field.Invalid(basePath.Child("service_account"), exporter.ServiceAccount, "invalid Google service account email specified"))
+	}
+
+	return allErrs
+}
+
+// maxNamedExporterNameLength is the maximum length of the name of a named
+// exporter, which is part of the names of its volumes.
+const maxNamedExporterNameLength = 20
+
+// validateNamedExporters validates the named instances of the exporters from
+// the given [config.CollectorConfig].
+func validateNamedExporters(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	basePath := field.NewPath("spec.exporters.named")
+	names := sets.New[string]()
+
+	for i, exporter := range cfg.Spec.Exporters.Named {
+		path := basePath.Index(i)
+
+		switch {
+		case exporter.Name == "":
+			allErrs = append(allErrs, field.Required(path.Child("name"), "empty exporter name specified"))
+		case len(exporter.Name) > maxNamedExporterNameLength:
+			allErrs = append(allErrs, field.TooLong(path.Child("name"), exporter.Name, maxNamedExporterNameLength))
+		default:
+			for _, msg := range utilvalidation.IsDNS1123Label(exporter.Name) {
+				allErrs = append(allErrs, field.Invalid(path.Child("name"), exporter.Name, msg))
+			}
+		}
+
+		// The instances of different types are distinct in the
+		// collector configuration, but the names are unique anyway to
+		// keep the references to them unambiguous.
+		if names.Has(exporter.Name) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), exporter.Name))
+		}
+		names.Insert(exporter.Name)
+
+		types := 0
+		if exporter.OTLPGRPCExporter != nil {
+			types++
+			allErrs = append(allErrs, validateNamedOTLPGRPCExporter(path.Child("otlp_grpc"), *exporter.OTLPGRPCExporter)...)
+		}
+		if exporter.OTLPHTTPExporter != nil {
+			types++
+			allErrs = append(allErrs, validateNamedOTLPHTTPExporter(path.Child("otlp_http"), *exporter.OTLPHTTPExporter)...)
+		}
+		if exporter.DebugExporter != nil {
+			types++
+			allErrs = append(allErrs, validateNamedDebugExporter(path.Child("debug"), *exporter.DebugExporter)...)
+		}
+
+		switch {
+		case types == 0:
+			allErrs = append(allErrs, field.Required(path, "exactly one exporter type must be specified"))
+		case types > 1:
+			allErrs = append(allErrs, field.Forbidden(path, "exactly one exporter type must be specified"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNamedProcessors validates the named instances of the processors.
+func validateNamedProcessors(cfg config.CollectorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	basePath := field.NewPath("spec.processors.named")
+	names := sets.New[string]()
+
+	for i, processor := range cfg.Spec.Processors.Named {
+		path := basePath.Index(i)
+
+		if processor.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("name"), "empty processor name specified"))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(processor.Name) {
+				allErrs = append(allErrs, field.Invalid(path.Child("name"), processor.Name, msg))
+			}
+		}
+
+		// The names are unique across the processor types, so that the
+		// references of the pipelines are unambiguous.
+		if names.Has(processor.Name) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), processor.Name))
+		}
+		names.Insert(processor.Name)
+
+		types := 0
+		if processor.Batch != nil {
+			types++
+			allErrs = append(allErrs, validateNamedBatchProcessor(path.Child("batch"), *processor.Batch)...)
+		}
+		if processor.MemoryLimiter != nil {
+			types++
+			allErrs = append(allErrs, validateNamedMemoryLimiterProcessor(path.Child("memory_limiter"), *processor.MemoryLimiter)...)
+		}
+		if processor.Transform != nil {
+			types++
+			allErrs = append(allErrs, validateNamedTransformProcessor(path.Child("transform"), *processor.Transform)...)
+		}
+
+		switch {
+		case types == 0:
+			allErrs = append(allErrs, field.Required(path, "exactly one processor type must be specified"))
+		case types > 1:
+			allErrs = append(allErrs, field.Forbidden(path, "exactly one processor type must be specified"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNamedBatchProcessor validates the settings of a named instance of
+// the Batch processor with the given path.
+func validateNamedBatchProcessor(path *field.Path, cfg config.NamedBatchProcessorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if cfg.Timeout < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("timeout"), cfg.Timeout.String(), "timeout cannot be negative"))
+	}
+	if cfg.SendBatchSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("send_batch_size"), cfg.SendBatchSize, "value cannot be negative"))
+	}
+
+	switch {
+	case cfg.SendBatchMaxSize < 0:
+		allErrs = append(allErrs, field.Invalid(path.Child("send_batch_max_size"), cfg.SendBatchMaxSize, "value cannot be negative"))
+	case cfg.SendBatchMaxSize > 0 && cfg.SendBatchMaxSize < cfg.SendBatchSize:
+		allErrs = append(allErrs, field.Invalid(path.Child("send_batch_max_size"), cfg.SendBatchMaxSize, "value must not be lower than send_batch_size"))
+	}
+
+	return allErrs
+}
+
+// validateNamedMemoryLimiterProcessor validates the settings of a named
+// instance of the Memory Limiter processor with the given path.
+func validateNamedMemoryLimiterProcessor(path *field.Path, cfg config.NamedMemoryLimiterProcessorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if cfg.CheckInterval < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("check_interval"), cfg.CheckInterval.String(), "check interval cannot be negative"))
+	}
+	if cfg.LimitPercentage < 0 || cfg.LimitPercentage > 100 {
+		allErrs = append(allErrs, field.Invalid(path.Child("limit_percentage"), cfg.LimitPercentage, "value must be between 1 and 100"))
+	}
+
+	switch {
+	case cfg.SpikeLimitPercentage < 0:
+		allErrs = append(allErrs, field.Invalid(path.Child("spike_limit_percentage"), cfg.SpikeLimitPercentage, "value cannot be negative"))
+	case cfg.SpikeLimitPercentage > 0 && cfg.LimitPercentage == 0:
+		allErrs = append(allErrs, field.Required(path.Child("limit_percentage"), "limit percentage is required along with the spike limit percentage"))
+	case cfg.SpikeLimitPercentage > 0 && cfg.SpikeLimitPercentage >= cfg.LimitPercentage:
+		allErrs = append(allErrs, field.Invalid(path.Child("spike_limit_percentage"), cfg.SpikeLimitPercentage, "value must be lower than limit_percentage"))
+	}
+
+	return allErrs
+}
+
+// validateNamedTransformProcessor validates the settings of a named instance
+// of the Transform processor with the given path. Each OTTL statement is
+// parsed on its own, so that the offending statements are named by their
+// index.
+func validateNamedTransformProcessor(path *field.Path, cfg config.NamedTransformProcessorConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if len(cfg.MetricStatements) == 0 && len(cfg.LogStatements) == 0 {
+		allErrs = append(allErrs, field.Required(path, "at least one statement must be specified"))
+	}
+
+	statements := []struct {
+		key        string
+		statements []string
+	}{
+		{key: "metric_statements", statements: cfg.MetricStatements},
+		{key: "log_statements", statements: cfg.LogStatements},
+	}
+	for _, item := range statements {
+		for i, statement := range item.statements {
+			if err := validateOTTLStatement(item.key, statement); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child(item.key).Index(i), statement, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateOTTLStatement parses the given OTTL statement the same way as the
+// Transform processor, when it is given in the list of statements with the
+// given key, e.g. `metric_statements'.
+func validateOTTLStatement(key, statement string) error {
+	cfg := transformprocessor.NewFactory().CreateDefaultConfig()
+	if err := confmap.NewFromStringMap(map[string]any{key: []any{statement}}).Unmarshal(cfg); err != nil {
+		return err
+	}
+
+	return xconfmap.Validate(cfg)
+}
+
+// validateExporterReferences validates the references to the token and the
+// TLS resources of the exporter with the given path.
+func validateExporterReferences(path *field.Path, token *config.ResourceReference, tls *config.TLSConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	refs := map[string]*config.ResourceReference{"token": token}
+	if tls != nil {
+		refs["tls.ca"] = tls.CA
+		refs["tls.cert"] = tls.Cert
+		refs["tls.key"] = tls.Key
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(refs)) {
+		ref := refs[name]
+		if ref != nil && (ref.ResourceRef.Name == "" || ref.ResourceRef.DataKey == "") {
+			allErrs = append(allErrs, field.Invalid(path.Child(name), path.Child(name).String(), "name or dataKey is empty"))
+		}
+	}
+
+	return allErrs
+}
+
+// endpointPlaceholders provides placeholder values of the cluster variables,
+// which are used to validate the templates of the exporter endpoints. The
+// actual values are only known, when the extension is reconciled.
+var endpointPlaceholders = config.EndpointVariables{
+	Region:    "region",
+	Provider:  "provider",
+	ShootName: "shoot",
+	SeedName:  "seed",
+}
+
+// validateEndpoint validates the given endpoint of an exporter with the given
+// path, which may be a template referencing the cluster variables. If isURL is
+// true, the expanded endpoint must be a valid URL.
+func validateEndpoint(path *field.Path, endpoint string, isURL bool) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	expanded, err := config.ExpandEndpoint(endpoint, endpointPlaceholders)
+	if err != nil {
+		return append(allErrs, field.Invalid(path, endpoint, fmt.Sprintf("invalid endpoint template: %v", err)))
+	}
+
+	if isURL {
+		if _, err := url.Parse(expanded); err != nil {
+			allErrs = append(allErrs, field.Invalid(path, endpoint, "invalid URL specified"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNamedOTLPHTTPExporter validates the settings of a named OTLP HTTP
+// exporter with the given path.
+func validateNamedOTLPHTTPExporter(path *field.Path, cfg config.OTLPHTTPExporterConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	endpoints := map[string]string{
+		"endpoint":          cfg.Endpoint,
+		"traces_endpoint":   cfg.TracesEndpoint,
+		"metrics_endpoint":  cfg.MetricsEndpoint,
+		"logs_endpoint":     cfg.LogsEndpoint,
+		"profiles_endpoint": cfg.ProfilesEndpoint,
+	}
+	for _, name := range slices.Sorted(maps.Keys(endpoints)) {
+		if value := endpoints[name]; value != "" {
+			allErrs = append(allErrs, validateEndpoint(path.Child(name), value, true)...)
+		}
+	}
+
+	if cfg.ReadBufferSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("read_buffer_size"), cfg.ReadBufferSize, "value cannot be negative"))
+	}
+	if cfg.WriteBufferSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("write_buffer_size"), cfg.WriteBufferSize, "value cannot be negative"))
+	}
+
+	if cfg.Encoding == config.MessageEncodingJSON && cfg.Compression == config.CompressionSnappy {
+		allErrs = append(allErrs, field.Invalid(path.Child("compression"), cfg.Compression, "snappy compression is not supported with json encoding"))
+	}
+
+	allErrs = append(allErrs, validateExporterReferences(path, cfg.Token, cfg.TLS)...)
+	allErrs = append(allErrs, validateSendingQueue(path, cfg.SendingQueue, cfg.RetryOnFailure)...)
+	allErrs = append(allErrs, validateTLS(path.Child("tls"), cfg.TLS)...)
+
+	return allErrs
+}
+
+// validateNamedOTLPGRPCExporter validates the settings of a named OTLP gRPC
+// exporter with the given path.
+func validateNamedOTLPGRPCExporter(path *field.Path, cfg config.OTLPGRPCExporterConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+
+	if cfg.IsEnabled() && cfg.Endpoint == "" {
+		allErrs = append(allErrs, field.Invalid(path.Child("endpoint"), path.Child("endpoint").String(), "empty value specified"))
+	}
+	if cfg.Endpoint != "" {
+		allErrs = append(allErrs, validateEndpoint(path.Child("endpoint"), cfg.Endpoint, false)...)
+	}
+
+	if cfg.ReadBufferSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("read_buffer_size"), cfg.ReadBufferSize, "value cannot be negative"))
+	}
+	if cfg.WriteBufferSize < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("write_buffer_size"), cfg.WriteBufferSize, "value cannot be negative"))
+	}
+
+	allErrs = append(allErrs, validateExporterReferences(path, cfg.Token, cfg.TLS)...)
+	allErrs = append(allErrs, validateSendingQueue(path, cfg.SendingQueue, cfg.RetryOnFailure)...)
+	allErrs = append(allErrs, validateTLS(path.Child("tls"), cfg.TLS)...)
+
+	return allErrs
+}
+
+// validateNamedDebugExporter validates the settings of a named debug exporter
+// with the given path.
+func validateNamedDebugExporter(path *field.Path, cfg config.DebugExporterConfig) field.ErrorList {
+	allErrs := make(field.ErrorList, 0)
+	supportedVerbosities := []config.DebugExporterVerbosity{
+		config.DebugExporterVerbosityBasic,
+		config.DebugExporterVerbosityNormal,
+		config.DebugExporterVerbosityDetailed,
+	}
+
+	if cfg.Verbosity != "" && !slices.Contains(supportedVerbosities, cfg.Verbosity) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("verbosity"), cfg.Verbosity, supportedVerbosities))
+	}
+
+	// The per-pipeline debug exporters are supported by the debug exporter
+	// of the spec.exporters.debug field only.
+	if len(cfg.Pipelines) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("pipelines"), "pipelines are not supported by named debug exporters"))
+	}
+	if cfg.Path != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("path"), "path is not supported by named debug exporters"))
+	}
+
+	return allErrs
+}
@@ -3,3 +3,2304 @@
 // SPDX-License-Identifier: Apache-2.0
 
 package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config"
+	"github.com/gardener/gardener-extension-otelcol/pkg/apis/config/validation"
+)
+
+var _ = Describe("Validation", func() {
+	var cfg config.CollectorConfig
+
+	BeforeEach(func() {
+		cfg = config.CollectorConfig{
+			Spec: config.CollectorConfigSpec{
+				Mode: config.CollectorModeStatefulSet,
+				Exporters: config.CollectorExportersConfig{
+					DebugExporter: config.DebugExporterConfig{
+						Enabled:   new(true),
+						Verbosity: config.DebugExporterVerbosityBasic,
+					},
+				},
+			},
+		}
+	})
+
+	It("should succeed with a valid config", func() {
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with an unsupported distribution", func() {
+		cfg.Spec.Distribution = "core"
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.distribution: Unsupported value")))
+	})
+
+	It("should fail without enabled exporters", func() {
+		cfg.Spec.Exporters = config.CollectorExportersConfig{}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("no exporter enabled")))
+	})
+
+	It("should fail with an unsupported mode", func() {
+		cfg.Spec.Mode = "daemonset"
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.mode: Unsupported value")))
+	})
+
+	It("should fail with an unsupported error mode", func() {
+		cfg.Spec.Processors.ErrorMode = "skip"
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.error_mode: Unsupported value")))
+	})
+
+	It("should succeed with the EndpointSlice service discovery role", func() {
+		cfg.Spec.TargetAllocator.ServiceDiscoveryRole = config.ServiceDiscoveryRoleEndpointSlice
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with a negative revision history limit of the Target Allocator", func() {
+		cfg.Spec.TargetAllocator.RevisionHistoryLimit = new(int32(-1))
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.target_allocator.revision_history_limit: Invalid value")))
+	})
+
+	It("should fail with an unsupported service discovery role", func() {
+		cfg.Spec.TargetAllocator.ServiceDiscoveryRole = "Pod"
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.target_allocator.service_discovery_role: Unsupported value")))
+	})
+
+	It("should succeed with the DEBUG log level of the Target Allocator", func() {
+		cfg.Spec.TargetAllocator.LogLevel = config.LogLevelDebug
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with an unsupported log level of the Target Allocator", func() {
+		cfg.Spec.TargetAllocator.LogLevel = config.LogLevelWarn
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.target_allocator.log_level: Unsupported value: \"WARN\"")))
+	})
+
+	It("should succeed with a scrape interval of the Target Allocator", func() {
+		cfg.Spec.TargetAllocator.ScrapeInterval = time.Minute
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with a negative scrape interval of the Target Allocator", func() {
+		cfg.Spec.TargetAllocator.ScrapeInterval = -time.Second
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.target_allocator.scrape_interval: Invalid value: \"-1s\"")))
+	})
+
+	It("should succeed with valid pod annotations", func() {
+		cfg.Spec.PodAnnotations = map[string]string{"sidecar.istio.io/inject": "false"}
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with an invalid pod annotation key", func() {
+		cfg.Spec.PodAnnotations = map[string]string{"sidecar.istio.io/in ject": "false"}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pod_annotations: Invalid value")))
+	})
+
+	It("should fail with a reserved pod annotation", func() {
+		cfg.Spec.PodAnnotations = map[string]string{"networking.resources.gardener.cloud/to-all": "allowed"}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pod_annotations[networking.resources.gardener.cloud/to-all]: Forbidden")))
+	})
+
+	It("should succeed with a valid metric name prefix", func() {
+		cfg.Spec.MetricNamePrefix = "myteam_"
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with an invalid metric name prefix", func() {
+		cfg.Spec.MetricNamePrefix = "my-team"
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metric_name_prefix: Invalid value")))
+	})
+
+	It("should fail with negative startup probe settings", func() {
+		cfg.Spec.StartupProbe = config.StartupProbeConfig{FailureThreshold: -1, PeriodSeconds: -1}
+		err := validation.Validate(cfg)
+		Expect(err).To(MatchError(ContainSubstring("spec.startup_probe.failure_threshold")))
+		Expect(err).To(MatchError(ContainSubstring("spec.startup_probe.period_seconds")))
+	})
+
+	It("should fail with json encoding and snappy compression of the OTLP HTTP exporter", func() {
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:     new(true),
+			Endpoint:    "https://example.com:4318",
+			Encoding:    config.MessageEncodingJSON,
+			Compression: config.CompressionSnappy,
+		}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_http.compression")))
+
+		cfg.Spec.Exporters.OTLPHTTPExporter.Compression = config.CompressionGzip
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with OTLP HTTP and gRPC exporters for the same endpoint", func() {
+		cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "https://example.com:4317",
+		}
+		cfg.Spec.Exporters.OTLPGRPCExporter = config.OTLPGRPCExporterConfig{
+			Enabled:  new(true),
+			Endpoint: "example.com:4317",
+		}
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.endpoint: Duplicate value")))
+
+		// Different credentials export the signals to different tenants.
+		cfg.Spec.Exporters.OTLPGRPCExporter.Token = &config.ResourceReference{
+			ResourceRef: config.ResourceReferenceDetails{Name: "token", DataKey: "token"},
+		}
+		Expect(validation.Validate(cfg)).To(Succeed())
+	})
+
+	It("should fail with an unsupported metrics level", func() {
+		cfg.Spec.Metrics.Level = "verbose"
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring(`spec.metrics.level: Unsupported value: "verbose"`)))
+	})
+
+	It("should fail with native histograms in deployment mode", func() {
+		cfg.Spec.Receivers.PrometheusReceiver.NativeHistograms = new(true)
+		Expect(validation.Validate(cfg)).To(Succeed())
+
+		cfg.Spec.Mode = config.CollectorModeDeployment
+		Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.native_histograms: Forbidden")))
+	})
+
+	Context("Start time metric", func() {
+		It("should succeed with a valid regex", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.UseStartTimeMetric = new(true)
+			cfg.Spec.Receivers.PrometheusReceiver.StartTimeMetricRegex = "^(.+_)*process_start_time_seconds$"
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid regex", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.UseStartTimeMetric = new(true)
+			cfg.Spec.Receivers.PrometheusReceiver.StartTimeMetricRegex = "process_start_(time"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.start_time_metric_regex: Invalid value")))
+		})
+
+		It("should fail with a regex without the start time metric", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.StartTimeMetricRegex = "process_start_time_seconds"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.start_time_metric_regex: Forbidden")))
+		})
+
+		It("should fail in deployment mode", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.UseStartTimeMetric = new(true)
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.use_start_time_metric: Forbidden")))
+		})
+	})
+
+	Context("Endpoint templates", func() {
+		It("should succeed with references of the cluster variables", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://metrics.{{.Region}}.{{.Provider}}.example.org/{{.SeedName}}/{{.ShootName}}",
+			}
+			cfg.Spec.Exporters.OTLPGRPCExporter = config.OTLPGRPCExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "otlp.{{.Region}}.example.org:4317",
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an unknown cluster variable", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://metrics.{{.Zone}}.example.org",
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring(`invalid endpoint template: unknown cluster variable "Zone"`)))
+		})
+
+		It("should fail with a control structure", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter = config.OTLPGRPCExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "otlp.{{if .Region}}eu{{end}}.example.org:4317",
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.endpoint: Invalid value")))
+		})
+
+		It("should fail with an invalid template", func() {
+			cfg.Spec.Exporters.Named = []config.NamedExporterConfig{{
+				Name: "backup",
+				OTLPHTTPExporter: &config.OTLPHTTPExporterConfig{
+					Enabled:  new(true),
+					Endpoint: "https://metrics.{{.Region.example.org",
+				},
+			}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[0].otlp_http.endpoint: Invalid value")))
+		})
+	})
+
+	Context("Per-pipeline debug exporters", func() {
+		It("should succeed with debug exporters for known pipelines", func() {
+			cfg.Spec.Exporters.DebugExporter.Pipelines = []config.PipelineDebugExporterConfig{
+				{Pipeline: config.PipelineNameLogs, Verbosity: config.DebugExporterVerbosityDetailed},
+				{Pipeline: config.PipelineNameMetrics, Verbosity: config.DebugExporterVerbosityBasic},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an unknown pipeline", func() {
+			cfg.Spec.Exporters.DebugExporter.Pipelines = []config.PipelineDebugExporterConfig{
+				{Pipeline: "traces", Verbosity: config.DebugExporterVerbosityBasic},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.pipelines[0].pipeline: Not found")))
+		})
+
+		It("should fail with a duplicate pipeline", func() {
+			cfg.Spec.Exporters.DebugExporter.Pipelines = []config.PipelineDebugExporterConfig{
+				{Pipeline: config.PipelineNameLogs, Verbosity: config.DebugExporterVerbosityBasic},
+				{Pipeline: config.PipelineNameLogs, Verbosity: config.DebugExporterVerbosityDetailed},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.pipelines[1].pipeline: Duplicate value")))
+		})
+
+		It("should fail with an unsupported verbosity", func() {
+			cfg.Spec.Exporters.DebugExporter.Verbosity = "verbose"
+			cfg.Spec.Exporters.DebugExporter.Pipelines = []config.PipelineDebugExporterConfig{
+				{Pipeline: config.PipelineNameLogs, Verbosity: "verbose"},
+			}
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.debug.verbosity: Unsupported value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.debug.pipelines[0].verbosity: Unsupported value")))
+		})
+	})
+
+	Context("OTLP receiver auth", func() {
+		It("should succeed with a bearer token", func() {
+			cfg.Spec.Receivers.OTLPReceiver.Auth.Token = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otlp-auth", DataKey: "token"},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with both bearer token and htpasswd", func() {
+			ref := &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otlp-auth", DataKey: "token"},
+			}
+			cfg.Spec.Receivers.OTLPReceiver.Auth = config.OTLPReceiverAuthConfig{Token: ref, Htpasswd: ref}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.otlp.auth: Forbidden")))
+		})
+
+		It("should fail with an invalid selector of the allowed clients", func() {
+			cfg.Spec.Receivers.OTLPReceiver.AllowedClients = []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"app": "foo/bar"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.otlp.allowed_clients[0].matchLabels")))
+		})
+
+		It("should fail with an incomplete resource reference", func() {
+			cfg.Spec.Receivers.OTLPReceiver.Auth.Htpasswd = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otlp-auth"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.otlp.auth.htpasswd")))
+		})
+	})
+
+	Context("Sending queue", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter = config.OTLPGRPCExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://example.com:4317",
+				RetryOnFailure: config.RetryOnFailureConfig{
+					Enabled:        new(true),
+					MaxElapsedTime: new(5 * time.Minute),
+				},
+				SendingQueue: config.SendingQueueConfig{
+					Enabled:         new(true),
+					NumConsumers:    10,
+					QueueSize:       1000,
+					BlockOnOverflow: new(true),
+				},
+			}
+		})
+
+		It("should succeed with a blocking sending queue", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with negative sizes", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.NumConsumers = -1
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.QueueSize = -1
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.sending_queue.num_consumers: Invalid value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.sending_queue.queue_size: Invalid value")))
+		})
+
+		It("should fail to block on overflow with a disabled sending queue", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Enabled = new(false)
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.sending_queue.block_on_overflow: Forbidden")))
+		})
+
+		It("should fail to block on overflow with unlimited retries", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime = new(time.Duration(0))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("unlimited retries")))
+		})
+
+		It("should succeed to drop on overflow with unlimited retries", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.RetryOnFailure.MaxElapsedTime = new(time.Duration(0))
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.BlockOnOverflow = new(false)
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with a maximum request size", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Batch = config.SendingQueueBatchConfig{Sizer: config.RequestSizerBytes, MaxSize: 4 << 20}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid request sizing", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Batch = config.SendingQueueBatchConfig{Sizer: "requests", MaxSize: -1}
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.sending_queue.batch.sizer: Unsupported value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.sending_queue.batch.max_size: Invalid value")))
+		})
+
+		It("should fail to split the requests with a disabled sending queue", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Enabled = new(false)
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.BlockOnOverflow = new(false)
+			cfg.Spec.Exporters.OTLPGRPCExporter.SendingQueue.Batch = config.SendingQueueBatchConfig{Sizer: config.RequestSizerItems, MaxSize: 1000}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.sending_queue.batch.max_size: Forbidden")))
+		})
+	})
+
+	Context("Batch processor", func() {
+		BeforeEach(func() {
+			cfg.Spec.Processors.Batch = config.BatchProcessorConfig{Enabled: new(true), SendBatchMaxSize: 1000}
+			cfg.Spec.Exporters.Named = []config.NamedExporterConfig{
+				{
+					Name: "backup",
+					OTLPHTTPExporter: &config.OTLPHTTPExporterConfig{
+						Enabled:  new(true),
+						Endpoint: "https://backup.example.org:4318",
+						SendingQueue: config.SendingQueueConfig{
+							Enabled: new(true),
+							Batch:   config.SendingQueueBatchConfig{Sizer: config.RequestSizerItems, MaxSize: 1000},
+						},
+					},
+				},
+			}
+		})
+
+		It("should succeed with batches not exceeding the maximum request size", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with a maximum request size measured in bytes", func() {
+			cfg.Spec.Exporters.Named[0].OTLPHTTPExporter.SendingQueue.Batch.Sizer = config.RequestSizerBytes
+			cfg.Spec.Processors.Batch.SendBatchMaxSize = 5000
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with batches exceeding the maximum request size", func() {
+			cfg.Spec.Processors.Batch.SendBatchMaxSize = 5000
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("exceeds the maximum size 1000 of the requests of the otlp_http/backup exporter")))
+		})
+
+		It("should fail with a negative maximum batch size", func() {
+			cfg.Spec.Processors.Batch.SendBatchMaxSize = -1
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.batch.send_batch_max_size: Invalid value")))
+		})
+
+		It("should fail with a maximum batch size of a disabled batch processor", func() {
+			cfg.Spec.Processors.Batch.Enabled = new(false)
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.batch.send_batch_max_size: Forbidden")))
+		})
+	})
+
+	Context("Gateway", func() {
+		It("should succeed with an enabled gateway", func() {
+			cfg.Spec.Gateway = config.GatewayConfig{Enabled: new(true), Replicas: 2}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an enabled gateway in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			cfg.Spec.Gateway = config.GatewayConfig{Enabled: new(true), Replicas: 2}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.gateway.enabled: Forbidden")))
+		})
+
+		It("should fail with an enabled gateway without replicas", func() {
+			cfg.Spec.Gateway = config.GatewayConfig{Enabled: new(true)}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.gateway.replicas")))
+		})
+	})
+
+	Context("Named processors", func() {
+		It("should fail with an invalid and a duplicate name", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "Large", Batch: &config.NamedBatchProcessorConfig{}},
+				{Name: "strict", Batch: &config.NamedBatchProcessorConfig{}},
+				{Name: "strict", MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{}},
+			}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.processors.named[0].name: Invalid value: \"Large\"")),
+				MatchError(ContainSubstring("spec.processors.named[2].name: Duplicate value: \"strict\"")),
+			))
+		})
+
+		It("should fail without or with multiple processor types", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "none"},
+				{Name: "both", Batch: &config.NamedBatchProcessorConfig{}, MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{}},
+			}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.processors.named[0]: Required value: exactly one processor type must be specified")),
+				MatchError(ContainSubstring("spec.processors.named[1]: Forbidden: exactly one processor type must be specified")),
+			))
+		})
+
+		It("should fail with a maximum batch size lower than the batch size", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "large", Batch: &config.NamedBatchProcessorConfig{SendBatchSize: 2000, SendBatchMaxSize: 1000}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.named[0].batch.send_batch_max_size: Invalid value: 1000")))
+		})
+
+		It("should fail with invalid memory limits", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "over", MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{LimitPercentage: 120}},
+				{Name: "spike", MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{LimitPercentage: 50, SpikeLimitPercentage: 50}},
+				{Name: "nolimit", MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{SpikeLimitPercentage: 10}},
+			}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.processors.named[0].memory_limiter.limit_percentage: Invalid value: 120")),
+				MatchError(ContainSubstring("spec.processors.named[1].memory_limiter.spike_limit_percentage: Invalid value: 50")),
+				MatchError(ContainSubstring("spec.processors.named[2].memory_limiter.limit_percentage: Required value")),
+			))
+		})
+
+		It("should succeed with valid OTTL statements", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "rename", Transform: &config.NamedTransformProcessorConfig{
+					MetricStatements: []string{`set(metric.name, Concat(["gardener_", metric.name], ""))`},
+					LogStatements:    []string{`delete_key(log.attributes, "password")`},
+				}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with malformed OTTL statements naming their index", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "empty", Transform: &config.NamedTransformProcessorConfig{}},
+				{Name: "broken", Transform: &config.NamedTransformProcessorConfig{
+					MetricStatements: []string{`set(metric.name, "foo")`, `set(metric.name, "foo"`},
+					LogStatements:    []string{`unknown_function(log.body)`},
+				}},
+			}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.processors.named[0].transform: Required value: at least one statement must be specified")),
+				MatchError(ContainSubstring("spec.processors.named[1].transform.metric_statements[1]: Invalid value")),
+				MatchError(ContainSubstring("spec.processors.named[1].transform.log_statements[0]: Invalid value")),
+				Not(MatchError(ContainSubstring("metric_statements[0]"))),
+			))
+		})
+	})
+
+	Context("Named exporters", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.Named = []config.NamedExporterConfig{
+				{
+					Name: "backup",
+					OTLPHTTPExporter: &config.OTLPHTTPExporterConfig{
+						Enabled:  new(true),
+						Endpoint: "https://backup.example.org:4318",
+					},
+				},
+				{
+					Name:             "backup-grpc",
+					OTLPGRPCExporter: &config.OTLPGRPCExporterConfig{Enabled: new(true), Endpoint: "grpc.example.org:4317"},
+				},
+			}
+		})
+
+		It("should succeed with valid named exporters", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with a named exporter as the only exporter", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.Enabled = new(false)
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with a forward pipeline to a named exporter", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/backup", From: []string{"logs"}, Exporters: []string{"otlp_http/backup"}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid name", func() {
+			cfg.Spec.Exporters.Named[0].Name = "Backup"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[0].name: Invalid value")))
+		})
+
+		It("should fail with a too long name", func() {
+			cfg.Spec.Exporters.Named[0].Name = "backup-of-the-backup-exporter"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[0].name: Too long")))
+		})
+
+		It("should fail with a duplicate name", func() {
+			cfg.Spec.Exporters.Named[1].Name = "backup"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[1].name: Duplicate value")))
+		})
+
+		It("should fail without an exporter type", func() {
+			cfg.Spec.Exporters.Named[0].OTLPHTTPExporter = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[0]: Required value: exactly one exporter type must be specified")))
+		})
+
+		It("should fail with multiple exporter types", func() {
+			cfg.Spec.Exporters.Named[0].DebugExporter = &config.DebugExporterConfig{Enabled: new(true)}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[0]: Forbidden: exactly one exporter type must be specified")))
+		})
+
+		It("should fail with an empty endpoint of an OTLP gRPC exporter", func() {
+			cfg.Spec.Exporters.Named[1].OTLPGRPCExporter.Endpoint = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[1].otlp_grpc.endpoint: Invalid value")))
+		})
+
+		It("should fail with an incomplete token reference", func() {
+			cfg.Spec.Exporters.Named[0].OTLPHTTPExporter.Token = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "backup-token"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[0].otlp_http.token: Invalid value")))
+		})
+
+		It("should fail with pipelines of a debug exporter", func() {
+			cfg.Spec.Exporters.Named = []config.NamedExporterConfig{{
+				Name: "verbose",
+				DebugExporter: &config.DebugExporterConfig{
+					Enabled:   new(true),
+					Pipelines: []config.PipelineDebugExporterConfig{{Pipeline: "logs"}},
+				},
+			}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.named[0].debug.pipelines: Forbidden")))
+		})
+	})
+
+	Context("Google Cloud exporter", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.GoogleCloudExporter = config.GoogleCloudExporterConfig{
+				Enabled:        new(true),
+				Project:        "my-project",
+				ServiceAccount: "otelcol@my-project.iam.gserviceaccount.com",
+			}
+		})
+
+		It("should succeed with a valid configuration", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without a project", func() {
+			cfg.Spec.Exporters.GoogleCloudExporter.Project = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.googlecloud.project: Required value")))
+		})
+
+		It("should fail with an invalid project", func() {
+			cfg.Spec.Exporters.GoogleCloudExporter.Project = "My_Project"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.googlecloud.project: Invalid value")))
+		})
+
+		It("should fail without a service account", func() {
+			cfg.Spec.Exporters.GoogleCloudExporter.ServiceAccount = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.googlecloud.service_account: Required value")))
+		})
+
+		It("should fail with an invalid service account", func() {
+			cfg.Spec.Exporters.GoogleCloudExporter.ServiceAccount = "otelcol@example.org"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.googlecloud.service_account: Invalid value")))
+		})
+
+		It("should fail with the k8s distribution", func() {
+			cfg.Spec.Distribution = config.CollectorDistributionK8s
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.googlecloud.enabled: Forbidden")))
+		})
+	})
+
+	Context("Service account annotations", func() {
+		It("should succeed with valid annotations", func() {
+			cfg.Spec.ServiceAccountAnnotations = map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/otelcol"}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid annotation key", func() {
+			cfg.Spec.ServiceAccountAnnotations = map[string]string{"invalid key": "foo"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.service_account_annotations: Invalid value")))
+		})
+
+		It("should fail with a reserved annotation", func() {
+			cfg.Spec.ServiceAccountAnnotations = map[string]string{"resources.gardener.cloud/mode": "Ignore"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.service_account_annotations[resources.gardener.cloud/mode]: Forbidden")))
+		})
+
+		It("should fail with an annotation managed by the Google Cloud exporter", func() {
+			cfg.Spec.Exporters.GoogleCloudExporter = config.GoogleCloudExporterConfig{
+				Enabled:        new(true),
+				Project:        "my-project",
+				ServiceAccount: "otelcol@my-project.iam.gserviceaccount.com",
+			}
+			cfg.Spec.ServiceAccountAnnotations = map[string]string{"iam.gke.io/gcp-service-account": "other@my-project.iam.gserviceaccount.com"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.service_account_annotations[iam.gke.io/gcp-service-account]: Forbidden")))
+		})
+	})
+
+	Context("Service Graph connector", func() {
+		BeforeEach(func() {
+			cfg.Spec.ConfigRef = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otelcol-config", DataKey: "config.yaml"},
+			}
+			cfg.Spec.Connectors.ServiceGraphConnector = config.ServiceGraphConnectorConfig{
+				Enabled:                 new(true),
+				LatencyHistogramBuckets: []time.Duration{100 * time.Millisecond, time.Second},
+				Dimensions:              []string{"k8s.namespace.name"},
+			}
+		})
+
+		It("should succeed with a valid connector", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with a debug exporter for the service graph pipeline", func() {
+			cfg.Spec.Exporters.DebugExporter.Pipelines = []config.PipelineDebugExporterConfig{{Pipeline: "metrics/servicegraph"}}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without a referenced collector configuration", func() {
+			cfg.Spec.ConfigRef = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.connectors.servicegraph.enabled: Forbidden: connector requires a traces pipeline")))
+		})
+
+		It("should fail with invalid buckets", func() {
+			cfg.Spec.Connectors.ServiceGraphConnector.LatencyHistogramBuckets = []time.Duration{0, time.Second, time.Second}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.connectors.servicegraph.latency_histogram_buckets[0]: Invalid value: \"0s\": bucket must be positive")),
+				MatchError(ContainSubstring("spec.connectors.servicegraph.latency_histogram_buckets[2]: Invalid value: \"1s\": buckets must be in increasing order")),
+			))
+		})
+
+		It("should fail with invalid dimensions", func() {
+			cfg.Spec.Connectors.ServiceGraphConnector.Dimensions = []string{"", "k8s.pod.name", "k8s.pod.name"}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.connectors.servicegraph.dimensions[0]: Required value")),
+				MatchError(ContainSubstring("spec.connectors.servicegraph.dimensions[2]: Duplicate value")),
+			))
+		})
+	})
+
+	Context("Span Metrics connector", func() {
+		BeforeEach(func() {
+			cfg.Spec.ConfigRef = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otelcol-config", DataKey: "config.yaml"},
+			}
+			cfg.Spec.Connectors.SpanMetricsConnector = config.SpanMetricsConnectorConfig{
+				Enabled:    new(true),
+				Dimensions: []string{"http.method"},
+				Exemplars:  config.SpanMetricsExemplarsConfig{Enabled: new(true), MaxPerDataPoint: new(int32(5))},
+			}
+		})
+
+		It("should succeed with a valid connector", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with a forward pipeline from the span metrics pipeline", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{{Name: "metrics/red", From: []string{"metrics/spanmetrics"}}}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without a referenced collector configuration", func() {
+			cfg.Spec.ConfigRef = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.connectors.spanmetrics.enabled: Forbidden: connector requires a traces pipeline")))
+		})
+
+		It("should fail with a limit of disabled exemplars", func() {
+			cfg.Spec.Connectors.SpanMetricsConnector.Exemplars.Enabled = new(false)
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.connectors.spanmetrics.exemplars.max_per_data_point: Forbidden: limit requires the exemplars to be enabled")))
+		})
+
+		It("should fail with an invalid limit of the exemplars", func() {
+			cfg.Spec.Connectors.SpanMetricsConnector.Exemplars.MaxPerDataPoint = new(int32(0))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.connectors.spanmetrics.exemplars.max_per_data_point: Invalid value: 0")))
+		})
+
+		It("should fail with duplicate dimensions", func() {
+			cfg.Spec.Connectors.SpanMetricsConnector.Dimensions = []string{"http.method", "http.method"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.connectors.spanmetrics.dimensions[1]: Duplicate value")))
+		})
+	})
+
+	Context("TLS versions and cipher suites", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS = &config.TLSConfig{
+				MinVersion:   config.TLSVersion12,
+				MaxVersion:   config.TLSVersion13,
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			}
+		})
+
+		It("should succeed with valid TLS versions and cipher suites", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with unsupported TLS versions", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.MinVersion = "1.0"
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.MaxVersion = "1.4"
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.exporters.otlp_http.tls.minVersion: Unsupported value: \"1.0\"")),
+				MatchError(ContainSubstring("spec.exporters.otlp_http.tls.maxVersion: Unsupported value: \"1.4\"")),
+			))
+		})
+
+		It("should fail with a maximum version lower than the minimum version", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.MinVersion = config.TLSVersion13
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.MaxVersion = config.TLSVersion12
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.CipherSuites = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_http.tls.maxVersion: Invalid value: \"1.2\": value must not be lower than minVersion")))
+		})
+
+		It("should fail with unknown, insecure and duplicate cipher suites", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.CipherSuites = []string{
+				"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+				"TLS_RSA_WITH_RC4_128_SHA",
+				"foo",
+				"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+			}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.exporters.otlp_http.tls.cipherSuites[1]: Unsupported value: \"TLS_RSA_WITH_RC4_128_SHA\"")),
+				MatchError(ContainSubstring("spec.exporters.otlp_http.tls.cipherSuites[2]: Unsupported value: \"foo\"")),
+				MatchError(ContainSubstring("spec.exporters.otlp_http.tls.cipherSuites[3]: Duplicate value")),
+			))
+		})
+
+		It("should fail with cipher suites for TLS 1.3", func() {
+			cfg.Spec.Exporters.OTLPGRPCExporter.TLS = &config.TLSConfig{
+				MinVersion:   config.TLSVersion13,
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_grpc.tls.cipherSuites: Forbidden: cipher suites cannot be configured for TLS 1.3")))
+		})
+
+		It("should succeed with the system root CAs in addition to a CA", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.CA = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "private-ca", DataKey: "ca.crt"},
+			}
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.IncludeSystemCAs = new(true)
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with the system root CAs without a CA", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.IncludeSystemCAs = new(true)
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_http.tls.includeSystemCAs: Forbidden: system root CAs can only be included in addition to a CA")))
+		})
+
+		It("should fail with the system root CAs without verification", func() {
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.CA = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "private-ca", DataKey: "ca.crt"},
+			}
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.IncludeSystemCAs = new(true)
+			cfg.Spec.Exporters.OTLPHTTPExporter.TLS.InsecureSkipVerify = new(true)
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.otlp_http.tls.includeSystemCAs: Forbidden: system root CAs cannot be included when the certificate is not verified")))
+		})
+	})
+
+	Context("Update strategy", func() {
+		BeforeEach(func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			cfg.Spec.UpdateStrategy = config.UpdateStrategyConfig{
+				MaxUnavailable: new(intstr.FromInt32(0)),
+				MaxSurge:       new(intstr.FromString("25%")),
+			}
+		})
+
+		It("should succeed with a valid update strategy", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with the OTLP gateway in statefulset mode", func() {
+			cfg.Spec.Mode = config.CollectorModeStatefulSet
+			cfg.Spec.Gateway = config.GatewayConfig{Enabled: new(true), Replicas: 2}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail in statefulset mode", func() {
+			cfg.Spec.Mode = config.CollectorModeStatefulSet
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.update_strategy: Forbidden")))
+		})
+
+		It("should fail with invalid values", func() {
+			cfg.Spec.UpdateStrategy = config.UpdateStrategyConfig{
+				MaxUnavailable: new(intstr.FromString("150%")),
+				MaxSurge:       new(intstr.FromInt32(-1)),
+			}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.update_strategy.max_unavailable: Invalid value: \"150%\": value must not be greater than 100%")),
+				MatchError(ContainSubstring("spec.update_strategy.max_surge: Invalid value")),
+			))
+		})
+
+		It("should fail with an invalid percentage", func() {
+			cfg.Spec.UpdateStrategy.MaxSurge = new(intstr.FromString("half"))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.update_strategy.max_surge: Invalid value")))
+		})
+
+		It("should fail without progress", func() {
+			cfg.Spec.UpdateStrategy.MaxSurge = new(intstr.FromString("0%"))
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.update_strategy.max_unavailable: Invalid value")))
+		})
+	})
+
+	Context("Shutdown timeout", func() {
+		It("should succeed with a shutdown timeout", func() {
+			cfg.Spec.ShutdownTimeout = 2 * time.Minute
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a negative shutdown timeout", func() {
+			cfg.Spec.ShutdownTimeout = -time.Second
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.shutdown_timeout: Invalid value: \"-1s\": timeout cannot be negative")))
+		})
+
+		It("should fail with a fraction of a second", func() {
+			cfg.Spec.ShutdownTimeout = 1500 * time.Millisecond
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.shutdown_timeout: Invalid value: \"1.5s\": timeout must be a whole number of seconds")))
+		})
+	})
+
+	Context("Tail Sampling processors", func() {
+		BeforeEach(func() {
+			cfg.Spec.ConfigRef = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "otelcol-config", DataKey: "config.yaml"},
+			}
+			cfg.Spec.Processors.TailSampling = config.TailSamplingProcessorConfig{
+				DecisionWait:            10 * time.Second,
+				NumTraces:               100000,
+				ExpectedNewTracesPerSec: 1000,
+			}
+		})
+
+		It("should succeed with valid settings", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without a referenced collector configuration", func() {
+			cfg.Spec.ConfigRef = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.tail_sampling: Forbidden: settings apply to the tail sampling processors of the referenced collector configuration only")))
+		})
+
+		It("should fail with a negative decision wait", func() {
+			cfg.Spec.Processors.TailSampling.DecisionWait = -time.Second
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.tail_sampling.decision_wait: Invalid value: \"-1s\": decision wait must be positive")))
+		})
+
+		It("should fail with too many traces", func() {
+			cfg.Spec.Processors.TailSampling.NumTraces = 2000000
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.tail_sampling.num_traces: Invalid value: 2000000: value must not be greater than 1000000")))
+		})
+
+		It("should fail with negative settings", func() {
+			cfg.Spec.Processors.TailSampling.NumTraces = -1
+			cfg.Spec.Processors.TailSampling.ExpectedNewTracesPerSec = -1
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.processors.tail_sampling.num_traces: Invalid value: -1: value must be positive")),
+				MatchError(ContainSubstring("spec.processors.tail_sampling.expected_new_traces_per_sec: Invalid value: -1: value cannot be negative")),
+			))
+		})
+	})
+
+	Context("Security context", func() {
+		It("should succeed with valid IDs", func() {
+			cfg.Spec.SecurityContext = config.SecurityContextConfig{RunAsUser: 65532, RunAsGroup: 65532}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with invalid IDs", func() {
+			cfg.Spec.SecurityContext = config.SecurityContextConfig{RunAsUser: -1, RunAsGroup: 1 << 32}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.security_context.run_as_user: Invalid value")),
+				MatchError(ContainSubstring("spec.security_context.run_as_group: Invalid value")),
+			))
+		})
+	})
+
+	Context("Volumes", func() {
+		BeforeEach(func() {
+			cfg.Spec.Volumes = []config.VolumeConfig{
+				{Name: "storage", MountPath: "/var/lib/otelcol", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				{Name: "ca-bundle", MountPath: "/etc/otelcol/ca", ResourceRef: &config.ResourceReferenceDetails{Name: "ca-bundle", DataKey: "ca.crt"}},
+			}
+		})
+
+		It("should succeed with valid additional volumes", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid volume name", func() {
+			cfg.Spec.Volumes[0].Name = "Storage"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[0].name: Invalid value")))
+		})
+
+		It("should fail with a duplicate volume name", func() {
+			cfg.Spec.Volumes[1].Name = "storage"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[1].name: Duplicate value")))
+		})
+
+		It("should fail with a relative mount path", func() {
+			cfg.Spec.Volumes[0].MountPath = "var/lib/otelcol"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[0].mountPath: Invalid value")))
+		})
+
+		It("should fail with a mount path overlapping with the managed volumes", func() {
+			cfg.Spec.Volumes[1].MountPath = "/etc/ssl/certs/extra"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[1].mountPath: Forbidden")))
+		})
+
+		It("should fail with a mount path overlapping with the file exporter", func() {
+			cfg.Spec.Exporters.FileExporter = config.FileExporterConfig{
+				Enabled: new(true),
+				Path:    "/var/lib/otelcol/data.json",
+				Rotation: config.FileExporterRotationConfig{
+					MaxMegabytes: 100,
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[0].mountPath: Forbidden")))
+		})
+
+		It("should fail with overlapping mount paths", func() {
+			cfg.Spec.Volumes[1].MountPath = "/var/lib/otelcol/ca"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[1].mountPath: Duplicate value")))
+		})
+
+		It("should fail without a volume source", func() {
+			cfg.Spec.Volumes[0].EmptyDir = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[0]: Required value")))
+		})
+
+		It("should fail with multiple volume sources", func() {
+			cfg.Spec.Volumes[1].EmptyDir = &corev1.EmptyDirVolumeSource{}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[1].emptyDir: Forbidden")))
+		})
+
+		It("should fail without a data key", func() {
+			cfg.Spec.Volumes[1].ResourceRef.DataKey = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.volumes[1].resourceRef.dataKey: Invalid value")))
+		})
+	})
+
+	Context("Ports", func() {
+		It("should succeed with valid additional ports", func() {
+			cfg.Spec.Ports = []config.PortConfig{
+				{Name: "statsd", Port: 8125, Protocol: corev1.ProtocolUDP},
+				{Name: "zipkin", Port: 9411, Protocol: corev1.ProtocolTCP},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid port name", func() {
+			cfg.Spec.Ports = []config.PortConfig{{Name: "Statsd_UDP", Port: 8125, Protocol: corev1.ProtocolUDP}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.ports[0].name: Invalid value")))
+		})
+
+		It("should fail with an invalid port number", func() {
+			cfg.Spec.Ports = []config.PortConfig{{Name: "statsd", Port: 70000, Protocol: corev1.ProtocolUDP}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.ports[0].port: Invalid value")))
+		})
+
+		It("should fail with an unsupported protocol", func() {
+			cfg.Spec.Ports = []config.PortConfig{{Name: "statsd", Port: 8125, Protocol: "QUIC"}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.ports[0].protocol: Unsupported value")))
+		})
+
+		It("should fail with duplicate ports", func() {
+			cfg.Spec.Ports = []config.PortConfig{
+				{Name: "statsd", Port: 8125, Protocol: corev1.ProtocolUDP},
+				{Name: "statsd", Port: 8125, Protocol: corev1.ProtocolUDP},
+			}
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.ports[1].name: Duplicate value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.ports[1].port: Duplicate value")))
+		})
+	})
+
+	Context("StatsD receiver", func() {
+		BeforeEach(func() {
+			cfg.Spec.Receivers.StatsDReceiver = config.StatsDReceiverConfig{
+				Enabled:             new(true),
+				Endpoint:            "0.0.0.0:8125",
+				AggregationInterval: time.Minute,
+			}
+		})
+
+		It("should succeed with a valid statsd receiver", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.statsd.enabled: Forbidden")))
+		})
+
+		It("should fail with an invalid endpoint", func() {
+			cfg.Spec.Receivers.StatsDReceiver.Endpoint = "0.0.0.0"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.statsd.endpoint: Invalid value")))
+		})
+
+		It("should fail with an invalid endpoint port", func() {
+			cfg.Spec.Receivers.StatsDReceiver.Endpoint = "0.0.0.0:statsd"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.statsd.endpoint: Invalid value")))
+		})
+
+		It("should fail with a non-positive aggregation interval", func() {
+			cfg.Spec.Receivers.StatsDReceiver.AggregationInterval = 0
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.statsd.aggregation_interval: Invalid value")))
+		})
+
+		It("should fail with an additional port conflicting with the receiver", func() {
+			cfg.Spec.Ports = []config.PortConfig{{Name: "statsd-udp", Port: 8125, Protocol: corev1.ProtocolUDP}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.ports[0].port: Duplicate value")))
+		})
+	})
+
+	Context("Host metrics receiver", func() {
+		BeforeEach(func() {
+			cfg.Spec.Receivers.HostMetricsReceiver = config.HostMetricsReceiverConfig{
+				Enabled:            new(true),
+				Scrapers:           []string{"cpu", "memory"},
+				CollectionInterval: time.Minute,
+			}
+		})
+
+		It("should succeed with a valid hostmetrics receiver", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.enabled: Forbidden")))
+		})
+
+		It("should fail without scrapers", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.Scrapers = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.scrapers: Required value")))
+		})
+
+		It("should fail with an unsupported scraper", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.Scrapers = []string{"cpu", "gpu"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.scrapers[1]: Unsupported value")))
+		})
+
+		It("should fail with a duplicate scraper", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.Scrapers = []string{"cpu", "cpu"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.scrapers[1]: Duplicate value")))
+		})
+
+		It("should fail with a non-positive collection interval", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.CollectionInterval = 0
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.collection_interval: Invalid value")))
+		})
+	})
+
+	Context("DNS", func() {
+		It("should succeed with a valid DNS config", func() {
+			cfg.Spec.DNS = config.DNSConfig{
+				Policy: corev1.DNSNone,
+				Config: &corev1.PodDNSConfig{
+					Nameservers: []string{"10.0.0.10"},
+					Searches:    []string{"svc.cluster.local"},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an unsupported DNS policy", func() {
+			cfg.Spec.DNS.Policy = "Custom"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.policy: Unsupported value")))
+		})
+
+		It("should fail with the None DNS policy and no nameservers", func() {
+			cfg.Spec.DNS.Policy = corev1.DNSNone
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.config.nameservers: Required value")))
+		})
+
+		It("should fail with invalid nameservers", func() {
+			cfg.Spec.DNS.Config = &corev1.PodDNSConfig{
+				Nameservers: []string{"10.0.0.10", "not-an-ip"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.config.nameservers[1]")))
+		})
+
+		It("should fail with too many nameservers", func() {
+			cfg.Spec.DNS.Config = &corev1.PodDNSConfig{
+				Nameservers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.config.nameservers: Too many")))
+		})
+	})
+
+	Context("Scrape configs", func() {
+		var credentials *config.ResourceReference
+
+		BeforeEach(func() {
+			credentials = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "scrape-token", DataKey: "token"},
+			}
+		})
+
+		It("should succeed with valid scrape configs", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:       "foo",
+					Targets:       []string{"foo.example.org:9100"},
+					Scheme:        "https",
+					Authorization: &config.ScrapeAuthorizationConfig{Type: "Bearer", Credentials: credentials},
+				},
+				{
+					JobName:   "bar",
+					Targets:   []string{"bar.example.org:9100"},
+					BasicAuth: &config.ScrapeBasicAuthConfig{Username: "bar", Password: credentials},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a duplicate job name", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: config.SelfScrapeJobName, Targets: []string{"foo.example.org:9100"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].job_name: Duplicate value")))
+		})
+
+		It("should fail with the job name of the Target Allocator", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: config.TargetAllocatorScrapeJobName, Targets: []string{"foo.example.org:9100"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].job_name: Duplicate value")))
+		})
+
+		It("should fail with invalid targets", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].targets[0]")))
+		})
+
+		It("should fail with both authorization and basic_auth", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:       "foo",
+					Targets:       []string{"foo.example.org:9100"},
+					Authorization: &config.ScrapeAuthorizationConfig{Type: "Bearer", Credentials: credentials},
+					BasicAuth:     &config.ScrapeBasicAuthConfig{Username: "foo", Password: credentials},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("at most one of authorization and basic_auth")))
+		})
+
+		It("should fail with missing credentials", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:       "foo",
+					Targets:       []string{"foo.example.org:9100"},
+					Authorization: &config.ScrapeAuthorizationConfig{Type: "Bearer"},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].authorization.credentials: Required value")))
+		})
+
+		It("should fail in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("not supported in deployment mode")))
+		})
+
+		It("should succeed with HTTP client settings", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:         "foo",
+					Targets:         []string{"foo.example.org:9100"},
+					EnableHTTP2:     new(false),
+					FollowRedirects: new(true),
+					ProxyURL:        "http://proxy.example.org:3128",
+				},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid proxy URL", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, ProxyURL: "proxy.example.org"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].proxy_url: Invalid value")))
+		})
+
+		It("should succeed with a body size limit", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, BodySizeLimit: "10MB"},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid body size limit", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, BodySizeLimit: "10Mi"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].body_size_limit: Invalid value")))
+		})
+
+		It("should succeed to track the staleness of the honored timestamps", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, TrackTimestampsStaleness: new(true)},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail to track the staleness of the timestamps, which are not honored", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, HonorTimestamps: new(false), TrackTimestampsStaleness: new(true)},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].track_timestamps_staleness: Forbidden")))
+		})
+
+		It("should fail with negative scrape limits", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.Limits = config.ScrapeLimitsConfig{SampleLimit: -1}
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, Limits: config.ScrapeLimitsConfig{LabelValueLengthLimit: -1}},
+			}
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.receivers.prometheus.limits.sample_limit: Invalid value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].limits.label_value_length_limit: Invalid value")))
+		})
+
+		It("should fail with an unsupported proxy URL scheme", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, ProxyURL: "ftp://proxy.example.org"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].proxy_url: Unsupported value")))
+		})
+	})
+
+	Context("Forward pipelines", func() {
+		It("should succeed with chained forward pipelines", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/all", From: []string{config.PipelineNameLogs, config.PipelineNameEvents}},
+				{Name: "logs/debug", From: []string{"logs/all"}, Exporters: []string{config.ExporterNameDebug}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid pipeline name", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "traces/foo", From: []string{config.PipelineNameLogs}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].name")))
+		})
+
+		It("should fail with a duplicate pipeline name", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: config.PipelineNameMetrics, From: []string{config.PipelineNameMetrics}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("Duplicate value")))
+		})
+
+		It("should fail when forwarding from an unknown pipeline", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/a", From: []string{"logs/b"}},
+				{Name: "logs/b", From: []string{"logs/a"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].from[0]: Not found")))
+		})
+
+		It("should fail when forwarding between different signal types", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "metrics/foo", From: []string{config.PipelineNameLogs}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("different signal type")))
+		})
+
+		It("should fail when forwarding from the metrics pipeline in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "metrics/foo", From: []string{config.PipelineNameMetrics}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].from[0]: Not found")))
+		})
+
+		It("should succeed with explicit processors", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameBatch}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an unsupported processor", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{"resource"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[0]: Unsupported value")))
+		})
+
+		It("should fail with a duplicate processor", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameBatch, config.ProcessorNameBatch}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[1]: Duplicate value")))
+		})
+
+		It("should fail with the batch processor, when it is disabled", func() {
+			cfg.Spec.Processors.Batch.Enabled = new(false)
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameBatch}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[0]: Unsupported value")))
+		})
+
+		It("should succeed with named processors", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "large", Batch: &config.NamedBatchProcessorConfig{SendBatchSize: 1000, SendBatchMaxSize: 2000}},
+				{Name: "strict", MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{LimitPercentage: 50, SpikeLimitPercentage: 10}},
+			}
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{"memory_limiter/strict", "batch/large"}},
+				{Name: "logs/bar", From: []string{config.PipelineNameLogs}, Processors: []string{"memory_limiter/strict", "batch/large"}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an undefined named processor", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "large", Batch: &config.NamedBatchProcessorConfig{}},
+			}
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{"memory_limiter/large"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[0]: Unsupported value: \"memory_limiter/large\"")))
+		})
+
+		It("should succeed with the memory limiter processor, when the batch processor is disabled", func() {
+			cfg.Spec.Processors.Batch.Enabled = new(false)
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameMemoryLimiter}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail when referencing a disabled exporter", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Exporters: []string{config.ExporterNameOTLPHTTP}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].exporters[0]")))
+		})
+	})
+
+	Context("Metrics transform processor", func() {
+		It("should succeed with valid transforms", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{
+					Include: "foo",
+					Action:  "update",
+					NewName: "bar",
+					Operations: []config.MetricsTransformOperationConfig{
+						{Action: "add_label", NewLabel: "env", NewValue: "prod"},
+						{Action: "aggregate_labels", LabelSet: []string{"env"}, AggregationType: "sum"},
+					},
+				},
+				{Include: "^foo_(.*)$", MatchType: "regexp", Action: "combine", NewName: "foo"},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without a metric name", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Action: "update", NewName: "bar"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].include: Required value")))
+		})
+
+		It("should fail with an invalid regular expression", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo(", MatchType: "regexp", Action: "update", NewName: "bar"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].include: Invalid value")))
+		})
+
+		It("should fail with an unsupported action", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo", Action: "delete"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].action: Unsupported value")))
+		})
+
+		It("should fail to insert a metric without a new name", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo", Action: "insert"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].new_name: Required value")))
+		})
+
+		It("should fail to combine metrics with the strict match type", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo", Action: "combine", NewName: "bar"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].match_type: Invalid value")))
+		})
+
+		It("should fail with an incomplete operation", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{
+					Include: "foo",
+					Action:  "update",
+					Operations: []config.MetricsTransformOperationConfig{
+						{Action: "update_label", NewLabel: "bar"},
+					},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].operations[0].label: Required value")))
+		})
+
+		It("should fail with an unsupported aggregation type", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{
+					Include: "foo",
+					Action:  "update",
+					Operations: []config.MetricsTransformOperationConfig{
+						{Action: "aggregate_labels", LabelSet: []string{"env"}, AggregationType: "avg"},
+					},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].operations[0].aggregation_type: Unsupported value")))
+		})
+	})
+
+	Context("File exporter", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.FileExporter = config.FileExporterConfig{
+				Enabled:  new(true),
+				Path:     "/var/lib/otelcol/file-exporter/data.json",
+				Format:   config.FileExporterFormatJSON,
+				Rotation: config.FileExporterRotationConfig{MaxMegabytes: 100},
+			}
+		})
+
+		It("should succeed with a valid file exporter", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with the file exporter as the only exporter", func() {
+			cfg.Spec.Exporters.DebugExporter.Enabled = new(false)
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a relative path", func() {
+			cfg.Spec.Exporters.FileExporter.Path = "data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.path: Invalid value")))
+		})
+
+		It("should fail with a path in the root directory", func() {
+			cfg.Spec.Exporters.FileExporter.Path = "/data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.path: Invalid value")))
+		})
+
+		It("should fail with an unsupported format", func() {
+			cfg.Spec.Exporters.FileExporter.Format = "csv"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.format: Unsupported value")))
+		})
+
+		It("should fail with invalid rotation settings", func() {
+			cfg.Spec.Exporters.FileExporter.Rotation = config.FileExporterRotationConfig{MaxBackups: -1}
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.file.rotation.max_megabytes: Invalid value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.file.rotation.max_backups: Invalid value")))
+		})
+
+		It("should fail to use the file exporter as the fallback of no other exporter", func() {
+			cfg.Spec.Exporters.DebugExporter.Enabled = new(false)
+			cfg.Spec.Exporters.FileExporter.Fallback = new(true)
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.fallback: Invalid value")))
+		})
+
+		It("should fail with a forward pipeline reserved for the fallback", func() {
+			cfg.Spec.Exporters.FileExporter.Fallback = new(true)
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/events_primary", From: []string{config.PipelineNameLogs}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].name: Forbidden")))
+		})
+	})
+
+	Context("Debug exporter writing to a file", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "/var/lib/otelcol/debug/data.json"
+		})
+
+		It("should succeed with a valid path", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed to reference the exporter by a forward pipeline", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/debug", From: []string{config.PipelineNameLogs}, Exporters: []string{config.ExporterNameDebugFile}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a relative path", func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Invalid value: \"data.json\": path must be absolute")))
+		})
+
+		It("should fail with a path in the root directory", func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "/data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Invalid value: \"/data.json\": path must not be located in the root directory")))
+		})
+
+		It("should fail with a path overlapping with the managed volumes", func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "/etc/ssl/debug/data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Forbidden: path overlaps with the volumes managed by the extension")))
+		})
+
+		It("should fail with a path overlapping with the file exporter", func() {
+			cfg.Spec.Exporters.FileExporter = config.FileExporterConfig{
+				Enabled:  new(true),
+				Path:     "/var/lib/otelcol/debug/file.json",
+				Format:   config.FileExporterFormatJSON,
+				Rotation: config.FileExporterRotationConfig{MaxMegabytes: 100},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Forbidden: path overlaps with the volume of the file exporter")))
+		})
+
+		It("should fail to reference the debug exporter by a forward pipeline", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/debug", From: []string{config.PipelineNameLogs}, Exporters: []string{config.ExporterNameDebug}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].exporters[0]: Unsupported value: \"debug\"")))
+		})
+	})
+
+	Context("Metrics push", func() {
+		BeforeEach(func() {
+			cfg.Spec.Metrics.Push = config.MetricsPushConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org/v1/metrics",
+				Protocol: config.MetricsPushProtocolHTTPProtobuf,
+				Interval: time.Minute,
+			}
+		})
+
+		It("should succeed with a valid endpoint", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with the endpoint of the OTLP HTTP exporter", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org",
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without an endpoint", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.endpoint: Required value")))
+		})
+
+		It("should succeed with the endpoint of the OTLP HTTP exporter with a token and TLS settings", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org",
+				Token: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "otlp-token", DataKey: "token"},
+				},
+				TLS: &config.TLSConfig{
+					CA: &config.ResourceReference{
+						ResourceRef: config.ResourceReferenceDetails{Name: "otlp-ca", DataKey: "ca.crt"},
+					},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail to use the endpoint of the OTLP HTTP exporter with insecureSkipVerify", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org",
+				TLS: &config.TLSConfig{
+					InsecureSkipVerify: new(true),
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.endpoint: Required value: endpoint is required, since insecureSkipVerify of the otlp_http exporter does not apply to the pushed metrics")))
+		})
+
+		It("should fail with an invalid endpoint", func() {
+			cfg.Spec.Receivers.StatsDReceiver.Endpoint = "0.0.0.0"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.statsd.endpoint: Invalid value")))
+		})
+
+		It("should fail with an invalid endpoint port", func() {
+			cfg.Spec.Receivers.StatsDReceiver.Endpoint = "0.0.0.0:statsd"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.statsd.endpoint: Invalid value")))
+		})
+
+		It("should fail with a non-positive aggregation interval", func() {
+			cfg.Spec.Receivers.StatsDReceiver.AggregationInterval = 0
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.statsd.aggregation_interval: Invalid value")))
+		})
+
+		It("should fail with an additional port conflicting with the receiver", func() {
+			cfg.Spec.Ports = []config.PortConfig{{Name: "statsd-udp", Port: 8125, Protocol: corev1.ProtocolUDP}}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.ports[0].port: Duplicate value")))
+		})
+	})
+
+	Context("Host metrics receiver", func() {
+		BeforeEach(func() {
+			cfg.Spec.Receivers.HostMetricsReceiver = config.HostMetricsReceiverConfig{
+				Enabled:            new(true),
+				Scrapers:           []string{"cpu", "memory"},
+				CollectionInterval: time.Minute,
+			}
+		})
+
+		It("should succeed with a valid hostmetrics receiver", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.enabled: Forbidden")))
+		})
+
+		It("should fail without scrapers", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.Scrapers = nil
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.scrapers: Required value")))
+		})
+
+		It("should fail with an unsupported scraper", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.Scrapers = []string{"cpu", "gpu"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.scrapers[1]: Unsupported value")))
+		})
+
+		It("should fail with a duplicate scraper", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.Scrapers = []string{"cpu", "cpu"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.scrapers[1]: Duplicate value")))
+		})
+
+		It("should fail with a non-positive collection interval", func() {
+			cfg.Spec.Receivers.HostMetricsReceiver.CollectionInterval = 0
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.hostmetrics.collection_interval: Invalid value")))
+		})
+	})
+
+	Context("DNS", func() {
+		It("should succeed with a valid DNS config", func() {
+			cfg.Spec.DNS = config.DNSConfig{
+				Policy: corev1.DNSNone,
+				Config: &corev1.PodDNSConfig{
+					Nameservers: []string{"10.0.0.10"},
+					Searches:    []string{"svc.cluster.local"},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an unsupported DNS policy", func() {
+			cfg.Spec.DNS.Policy = "Custom"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.policy: Unsupported value")))
+		})
+
+		It("should fail with the None DNS policy and no nameservers", func() {
+			cfg.Spec.DNS.Policy = corev1.DNSNone
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.config.nameservers: Required value")))
+		})
+
+		It("should fail with invalid nameservers", func() {
+			cfg.Spec.DNS.Config = &corev1.PodDNSConfig{
+				Nameservers: []string{"10.0.0.10", "not-an-ip"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.config.nameservers[1]")))
+		})
+
+		It("should fail with too many nameservers", func() {
+			cfg.Spec.DNS.Config = &corev1.PodDNSConfig{
+				Nameservers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.dns.config.nameservers: Too many")))
+		})
+	})
+
+	Context("Scrape configs", func() {
+		var credentials *config.ResourceReference
+
+		BeforeEach(func() {
+			credentials = &config.ResourceReference{
+				ResourceRef: config.ResourceReferenceDetails{Name: "scrape-token", DataKey: "token"},
+			}
+		})
+
+		It("should succeed with valid scrape configs", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:       "foo",
+					Targets:       []string{"foo.example.org:9100"},
+					Scheme:        "https",
+					Authorization: &config.ScrapeAuthorizationConfig{Type: "Bearer", Credentials: credentials},
+				},
+				{
+					JobName:   "bar",
+					Targets:   []string{"bar.example.org:9100"},
+					BasicAuth: &config.ScrapeBasicAuthConfig{Username: "bar", Password: credentials},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a duplicate job name", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: config.SelfScrapeJobName, Targets: []string{"foo.example.org:9100"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].job_name: Duplicate value")))
+		})
+
+		It("should fail with the job name of the Target Allocator", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: config.TargetAllocatorScrapeJobName, Targets: []string{"foo.example.org:9100"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].job_name: Duplicate value")))
+		})
+
+		It("should fail with invalid targets", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].targets[0]")))
+		})
+
+		It("should fail with both authorization and basic_auth", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:       "foo",
+					Targets:       []string{"foo.example.org:9100"},
+					Authorization: &config.ScrapeAuthorizationConfig{Type: "Bearer", Credentials: credentials},
+					BasicAuth:     &config.ScrapeBasicAuthConfig{Username: "foo", Password: credentials},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("at most one of authorization and basic_auth")))
+		})
+
+		It("should fail with missing credentials", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:       "foo",
+					Targets:       []string{"foo.example.org:9100"},
+					Authorization: &config.ScrapeAuthorizationConfig{Type: "Bearer"},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].authorization.credentials: Required value")))
+		})
+
+		It("should fail in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("not supported in deployment mode")))
+		})
+
+		It("should succeed with HTTP client settings", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{
+					JobName:         "foo",
+					Targets:         []string{"foo.example.org:9100"},
+					EnableHTTP2:     new(false),
+					FollowRedirects: new(true),
+					ProxyURL:        "http://proxy.example.org:3128",
+				},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid proxy URL", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, ProxyURL: "proxy.example.org"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].proxy_url: Invalid value")))
+		})
+
+		It("should succeed with a body size limit", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, BodySizeLimit: "10MB"},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid body size limit", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, BodySizeLimit: "10Mi"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].body_size_limit: Invalid value")))
+		})
+
+		It("should succeed to track the staleness of the honored timestamps", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, TrackTimestampsStaleness: new(true)},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail to track the staleness of the timestamps, which are not honored", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, HonorTimestamps: new(false), TrackTimestampsStaleness: new(true)},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].track_timestamps_staleness: Forbidden")))
+		})
+
+		It("should fail with negative scrape limits", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.Limits = config.ScrapeLimitsConfig{SampleLimit: -1}
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, Limits: config.ScrapeLimitsConfig{LabelValueLengthLimit: -1}},
+			}
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.receivers.prometheus.limits.sample_limit: Invalid value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].limits.label_value_length_limit: Invalid value")))
+		})
+
+		It("should fail with an unsupported proxy URL scheme", func() {
+			cfg.Spec.Receivers.PrometheusReceiver.ScrapeConfigs = []config.ScrapeConfig{
+				{JobName: "foo", Targets: []string{"foo.example.org:9100"}, ProxyURL: "ftp://proxy.example.org"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.receivers.prometheus.scrape_configs[0].proxy_url: Unsupported value")))
+		})
+	})
+
+	Context("Forward pipelines", func() {
+		It("should succeed with chained forward pipelines", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/all", From: []string{config.PipelineNameLogs, config.PipelineNameEvents}},
+				{Name: "logs/debug", From: []string{"logs/all"}, Exporters: []string{config.ExporterNameDebug}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid pipeline name", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "traces/foo", From: []string{config.PipelineNameLogs}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].name")))
+		})
+
+		It("should fail with a duplicate pipeline name", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: config.PipelineNameMetrics, From: []string{config.PipelineNameMetrics}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("Duplicate value")))
+		})
+
+		It("should fail when forwarding from an unknown pipeline", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/a", From: []string{"logs/b"}},
+				{Name: "logs/b", From: []string{"logs/a"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].from[0]: Not found")))
+		})
+
+		It("should fail when forwarding between different signal types", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "metrics/foo", From: []string{config.PipelineNameLogs}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("different signal type")))
+		})
+
+		It("should fail when forwarding from the metrics pipeline in deployment mode", func() {
+			cfg.Spec.Mode = config.CollectorModeDeployment
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "metrics/foo", From: []string{config.PipelineNameMetrics}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].from[0]: Not found")))
+		})
+
+		It("should succeed with explicit processors", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameBatch}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an unsupported processor", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{"resource"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[0]: Unsupported value")))
+		})
+
+		It("should fail with a duplicate processor", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameBatch, config.ProcessorNameBatch}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[1]: Duplicate value")))
+		})
+
+		It("should fail with the batch processor, when it is disabled", func() {
+			cfg.Spec.Processors.Batch.Enabled = new(false)
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameBatch}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[0]: Unsupported value")))
+		})
+
+		It("should succeed with named processors", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "large", Batch: &config.NamedBatchProcessorConfig{SendBatchSize: 1000, SendBatchMaxSize: 2000}},
+				{Name: "strict", MemoryLimiter: &config.NamedMemoryLimiterProcessorConfig{LimitPercentage: 50, SpikeLimitPercentage: 10}},
+			}
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{"memory_limiter/strict", "batch/large"}},
+				{Name: "logs/bar", From: []string{config.PipelineNameLogs}, Processors: []string{"memory_limiter/strict", "batch/large"}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an undefined named processor", func() {
+			cfg.Spec.Processors.Named = []config.NamedProcessorConfig{
+				{Name: "large", Batch: &config.NamedBatchProcessorConfig{}},
+			}
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{"memory_limiter/large"}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].processors[0]: Unsupported value: \"memory_limiter/large\"")))
+		})
+
+		It("should succeed with the memory limiter processor, when the batch processor is disabled", func() {
+			cfg.Spec.Processors.Batch.Enabled = new(false)
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Processors: []string{config.ProcessorNameMemoryLimiter}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail when referencing a disabled exporter", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/foo", From: []string{config.PipelineNameLogs}, Exporters: []string{config.ExporterNameOTLPHTTP}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].exporters[0]")))
+		})
+	})
+
+	Context("Metrics transform processor", func() {
+		It("should succeed with valid transforms", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{
+					Include: "foo",
+					Action:  "update",
+					NewName: "bar",
+					Operations: []config.MetricsTransformOperationConfig{
+						{Action: "add_label", NewLabel: "env", NewValue: "prod"},
+						{Action: "aggregate_labels", LabelSet: []string{"env"}, AggregationType: "sum"},
+					},
+				},
+				{Include: "^foo_(.*)$", MatchType: "regexp", Action: "combine", NewName: "foo"},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without a metric name", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Action: "update", NewName: "bar"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].include: Required value")))
+		})
+
+		It("should fail with an invalid regular expression", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo(", MatchType: "regexp", Action: "update", NewName: "bar"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].include: Invalid value")))
+		})
+
+		It("should fail with an unsupported action", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo", Action: "delete"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].action: Unsupported value")))
+		})
+
+		It("should fail to insert a metric without a new name", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo", Action: "insert"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].new_name: Required value")))
+		})
+
+		It("should fail to combine metrics with the strict match type", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{Include: "foo", Action: "combine", NewName: "bar"},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].match_type: Invalid value")))
+		})
+
+		It("should fail with an incomplete operation", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{
+					Include: "foo",
+					Action:  "update",
+					Operations: []config.MetricsTransformOperationConfig{
+						{Action: "update_label", NewLabel: "bar"},
+					},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].operations[0].label: Required value")))
+		})
+
+		It("should fail with an unsupported aggregation type", func() {
+			cfg.Spec.Processors.MetricsTransform.Transforms = []config.MetricsTransformConfig{
+				{
+					Include: "foo",
+					Action:  "update",
+					Operations: []config.MetricsTransformOperationConfig{
+						{Action: "aggregate_labels", LabelSet: []string{"env"}, AggregationType: "avg"},
+					},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.processors.metricstransform.transforms[0].operations[0].aggregation_type: Unsupported value")))
+		})
+	})
+
+	Context("File exporter", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.FileExporter = config.FileExporterConfig{
+				Enabled:  new(true),
+				Path:     "/var/lib/otelcol/file-exporter/data.json",
+				Format:   config.FileExporterFormatJSON,
+				Rotation: config.FileExporterRotationConfig{MaxMegabytes: 100},
+			}
+		})
+
+		It("should succeed with a valid file exporter", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with the file exporter as the only exporter", func() {
+			cfg.Spec.Exporters.DebugExporter.Enabled = new(false)
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a relative path", func() {
+			cfg.Spec.Exporters.FileExporter.Path = "data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.path: Invalid value")))
+		})
+
+		It("should fail with a path in the root directory", func() {
+			cfg.Spec.Exporters.FileExporter.Path = "/data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.path: Invalid value")))
+		})
+
+		It("should fail with an unsupported format", func() {
+			cfg.Spec.Exporters.FileExporter.Format = "csv"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.format: Unsupported value")))
+		})
+
+		It("should fail with invalid rotation settings", func() {
+			cfg.Spec.Exporters.FileExporter.Rotation = config.FileExporterRotationConfig{MaxBackups: -1}
+			err := validation.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.file.rotation.max_megabytes: Invalid value")))
+			Expect(err).To(MatchError(ContainSubstring("spec.exporters.file.rotation.max_backups: Invalid value")))
+		})
+
+		It("should fail to use the file exporter as the fallback of no other exporter", func() {
+			cfg.Spec.Exporters.DebugExporter.Enabled = new(false)
+			cfg.Spec.Exporters.FileExporter.Fallback = new(true)
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.file.fallback: Invalid value")))
+		})
+
+		It("should fail with a forward pipeline reserved for the fallback", func() {
+			cfg.Spec.Exporters.FileExporter.Fallback = new(true)
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/events_primary", From: []string{config.PipelineNameLogs}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].name: Forbidden")))
+		})
+	})
+
+	Context("Debug exporter writing to a file", func() {
+		BeforeEach(func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "/var/lib/otelcol/debug/data.json"
+		})
+
+		It("should succeed with a valid path", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed to reference the exporter by a forward pipeline", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/debug", From: []string{config.PipelineNameLogs}, Exporters: []string{config.ExporterNameDebugFile}},
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with a relative path", func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Invalid value: \"data.json\": path must be absolute")))
+		})
+
+		It("should fail with a path in the root directory", func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "/data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Invalid value: \"/data.json\": path must not be located in the root directory")))
+		})
+
+		It("should fail with a path overlapping with the managed volumes", func() {
+			cfg.Spec.Exporters.DebugExporter.Path = "/etc/ssl/debug/data.json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Forbidden: path overlaps with the volumes managed by the extension")))
+		})
+
+		It("should fail with a path overlapping with the file exporter", func() {
+			cfg.Spec.Exporters.FileExporter = config.FileExporterConfig{
+				Enabled:  new(true),
+				Path:     "/var/lib/otelcol/debug/file.json",
+				Format:   config.FileExporterFormatJSON,
+				Rotation: config.FileExporterRotationConfig{MaxMegabytes: 100},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.exporters.debug.path: Forbidden: path overlaps with the volume of the file exporter")))
+		})
+
+		It("should fail to reference the debug exporter by a forward pipeline", func() {
+			cfg.Spec.Pipelines.Forward = []config.ForwardPipelineConfig{
+				{Name: "logs/debug", From: []string{config.PipelineNameLogs}, Exporters: []string{config.ExporterNameDebug}},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.pipelines.forward[0].exporters[0]: Unsupported value: \"debug\"")))
+		})
+	})
+
+	Context("Metrics push", func() {
+		BeforeEach(func() {
+			cfg.Spec.Metrics.Push = config.MetricsPushConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org/v1/metrics",
+				Protocol: config.MetricsPushProtocolHTTPProtobuf,
+				Interval: time.Minute,
+			}
+		})
+
+		It("should succeed with a valid endpoint", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with the endpoint of the OTLP HTTP exporter", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org",
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail without an endpoint", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.endpoint: Required value")))
+		})
+
+		It("should fail to use the endpoint of the OTLP HTTP exporter with a token", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org",
+				Token: &config.ResourceReference{
+					ResourceRef: config.ResourceReferenceDetails{Name: "otlp-token", DataKey: "token"},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.endpoint: Required value: endpoint is required, since the token and TLS settings of the otlp_http exporter do not apply to the pushed metrics")))
+		})
+
+		It("should fail to use the endpoint of the OTLP HTTP exporter with TLS settings", func() {
+			cfg.Spec.Metrics.Push.Endpoint = ""
+			cfg.Spec.Exporters.OTLPHTTPExporter = config.OTLPHTTPExporterConfig{
+				Enabled:  new(true),
+				Endpoint: "https://otlp.example.org",
+				TLS: &config.TLSConfig{
+					CA: &config.ResourceReference{
+						ResourceRef: config.ResourceReferenceDetails{Name: "otlp-ca", DataKey: "ca.crt"},
+					},
+				},
+			}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.endpoint: Required value")))
+		})
+
+		It("should fail with an invalid endpoint", func() {
+			cfg.Spec.Metrics.Push.Endpoint = "otlp.example.org:4317"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.endpoint")))
+		})
+
+		It("should fail with an unsupported protocol", func() {
+			cfg.Spec.Metrics.Push.Protocol = "http/json"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.protocol: Unsupported value")))
+		})
+
+		It("should fail with disabled internal metrics", func() {
+			cfg.Spec.Metrics.Level = config.MetricsVerbosityLevelNone
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.push.enabled: Forbidden")))
+		})
+	})
+
+	Context("Metrics naming", func() {
+		BeforeEach(func() {
+			cfg.Spec.Metrics.Naming = config.MetricsNamingOTel
+		})
+
+		It("should succeed with the otel naming", func() {
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should succeed with the prometheus naming without suffixes", func() {
+			cfg.Spec.Metrics.Naming = config.MetricsNamingPrometheus
+			cfg.Spec.Metrics.Prometheus = config.MetricsPrometheusConfig{
+				WithoutUnits:      new(true),
+				WithoutTypeSuffix: new(false),
+			}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an unsupported naming", func() {
+			cfg.Spec.Metrics.Naming = "legacy"
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.naming: Unsupported value")))
+		})
+
+		It("should fail with the otel naming and the suffixes", func() {
+			cfg.Spec.Metrics.Prometheus = config.MetricsPrometheusConfig{
+				WithoutUnits:      new(false),
+				WithoutTypeSuffix: new(false),
+			}
+			Expect(validation.Validate(cfg)).To(SatisfyAll(
+				MatchError(ContainSubstring("spec.metrics.prometheus.without_units: Invalid value")),
+				MatchError(ContainSubstring("spec.metrics.prometheus.without_type_suffix: Invalid value")),
+			))
+		})
+	})
+
+	Context("Disabled metrics", func() {
+		It("should succeed with valid instrument names", func() {
+			cfg.Spec.Metrics.DisabledMetrics = []string{"otelcol_processor_batch_batch_send_size", "otelcol_exporter_*"}
+			Expect(validation.Validate(cfg)).To(Succeed())
+		})
+
+		It("should fail with an invalid instrument name", func() {
+			cfg.Spec.Metrics.DisabledMetrics = []string{"otelcol exporter"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.disabled_metrics[0]: Invalid value")))
+		})
+
+		It("should fail with a duplicate instrument name", func() {
+			cfg.Spec.Metrics.DisabledMetrics = []string{"otelcol_exporter_*", "otelcol_exporter_*"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.disabled_metrics[1]: Duplicate value")))
+		})
+
+		It("should fail with the level none", func() {
+			cfg.Spec.Metrics.Level = config.MetricsVerbosityLevelNone
+			cfg.Spec.Metrics.DisabledMetrics = []string{"otelcol_exporter_*"}
+			Expect(validation.Validate(cfg)).To(MatchError(ContainSubstring("spec.metrics.disabled_metrics: Forbidden")))
+		})
+	})
+})
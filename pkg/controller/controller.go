@@ -183,9 +183,16 @@ func WithControllerOptions(opts crctrl.Options) Option {
 }
 
 // WithMaxConcurrentReconciles is an [Option], which configures the
-// [Controller] with the given max concurrent reconciles.
+// [Controller] with the given max concurrent reconciles. This setting is
+// specific to the [Controller] and allows it to be tuned independently from
+// the other controllers registered with the same [manager.Manager]. A value
+// of zero makes the [Controller] use the max concurrent reconciles configured
+// for the [manager.Manager].
 func WithMaxConcurrentReconciles(val int) Option {
 	opt := func(m *Controller) error {
+		if val < 0 {
+			return fmt.Errorf("%w: invalid max concurrent reconciles: %d", ErrInvalidController, val)
+		}
 		m.controllerOptions.MaxConcurrentReconciles = val
 
 		return nil
@@ -80,6 +80,19 @@ var _ = Describe("Controller", Ordered, func() {
 		Expect(c).To(BeNil())
 	})
 
+	It("should fail to create controller with negative max concurrent reconciles", func() {
+		opts := []controller.Option{
+			controller.WithActuator(act),
+			controller.WithMaxConcurrentReconciles(-1),
+		}
+		c, err := controller.New(opts...)
+
+		Expect(err).Should(HaveOccurred())
+		Expect(err).To(MatchError(controller.ErrInvalidController))
+		Expect(err).To(MatchError(ContainSubstring("invalid max concurrent reconciles")))
+		Expect(c).To(BeNil())
+	})
+
 	It("should successfully create a controller and register it", func() {
 		opts := []controller.Option{
 			controller.WithActuator(act),
@@ -19,6 +19,10 @@ const (
 	// ImageNameOTelCollector specifies the name of the image for the
 	// OpenTelemetry Collector.
 	ImageNameOTelCollector = "otel-collector"
+
+	// ImageNameOTelCollectorK8s specifies the name of the image for the
+	// k8s distribution of the OpenTelemetry Collector.
+	ImageNameOTelCollectorK8s = "otel-collector-k8s"
 )
 
 var (
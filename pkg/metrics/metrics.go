@@ -35,6 +35,52 @@ var (
 		},
 		[]string{"cluster", "operation"},
 	)
+
+	// ConfigComponents tracks the number of components of each kind
+	// (receivers, processors, exporters, etc.) in the collector
+	// configuration of a cluster.
+	ConfigComponents = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "config_components",
+			Help:      "Number of components of each kind in the collector configuration",
+		},
+		[]string{"cluster", "kind"},
+	)
+
+	// ManagedResourceConsecutiveFailures tracks the number of consecutive
+	// failures of the managed resource operations for a cluster.
+	ManagedResourceConsecutiveFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "managed_resource_consecutive_failures",
+			Help:      "Number of consecutive failures of the managed resource operations",
+		},
+		[]string{"cluster"},
+	)
+
+	// CertificateExpirySeconds tracks the expiration time of the
+	// certificates managed by the extension in seconds since the epoch.
+	CertificateExpirySeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "cert_expiry_seconds",
+			Help:      "Expiration time of the certificates managed by the extension in seconds since the epoch",
+		},
+		[]string{"cluster", "cert"},
+	)
+
+	// ServiceMonitors tracks the number of ServiceMonitors in the
+	// namespace of a cluster, which match the selector of the Target
+	// Allocator.
+	ServiceMonitors = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "service_monitors",
+			Help:      "Number of ServiceMonitors matching the selector of the Target Allocator",
+		},
+		[]string{"cluster"},
+	)
 )
 
 // init registers our custom metrics with the default controller-runtime registry.
@@ -42,5 +88,9 @@ func init() {
 	ctrlmetrics.Registry.MustRegister(
 		ActuatorOperationTotal,
 		ActuatorOperationDurationSeconds,
+		ConfigComponents,
+		ManagedResourceConsecutiveFailures,
+		CertificateExpirySeconds,
+		ServiceMonitors,
 	)
 }
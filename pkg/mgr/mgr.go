@@ -8,8 +8,11 @@ package mgr
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/util"
@@ -38,6 +41,7 @@ type mgr struct {
 	metricsServerOpts       metricsserver.Options
 	healthProbeAddr         string
 	pprofAddr               string
+	pprofBearerToken        string
 	leaderElectionEnabled   bool
 	leaderElectionID        string
 	leaderElectionNamespace string
@@ -103,6 +107,15 @@ func New(opts ...Option) (manager.Manager, error) {
 	// Apply any connection config settings, if we have such
 	util.ApplyClientConnectionConfigurationToRESTConfig(m.clientConnConfig, m.restConfig)
 
+	// When a bearer token for pprof is configured, the pprof endpoints are
+	// served by a dedicated server, which requires authentication, instead
+	// of the one provided by the manager.
+	pprofAddr := m.pprofAddr
+	if m.pprofBearerToken != "" && pprofAddr != "" && pprofAddr != "0" {
+		m.runnables = append(m.runnables, newSecurePprofServer(pprofAddr, m.pprofBearerToken))
+		pprofAddr = "0"
+	}
+
 	crMgr, err := manager.New(
 		m.restConfig,
 		manager.Options{
@@ -118,7 +131,7 @@ func New(opts ...Option) (manager.Manager, error) {
 			Controller:                 m.controllerOpts,
 			WebhookServer:              m.webhookServer,
 			Logger:                     m.logger,
-			PprofBindAddress:           m.pprofAddr,
+			PprofBindAddress:           pprofAddr,
 			Client:                     m.clientOpts,
 			Cache:                      m.cacheOpts,
 		},
@@ -414,6 +427,49 @@ func WithPprofAddress(addr string) Option {
 	return opt
 }
 
+// WithPprofBearerToken is an [Option], which configures the [manager.Manager]
+// to require the given bearer token for accessing the pprof endpoints. An empty
+// token leaves the pprof endpoints unauthenticated.
+func WithPprofBearerToken(token string) Option {
+	opt := func(m *mgr) error {
+		m.pprofBearerToken = token
+
+		return nil
+	}
+
+	return opt
+}
+
+// WithPprofBearerTokenFile is an [Option], which configures the
+// [manager.Manager] to require the bearer token from the file at the given
+// path for accessing the pprof endpoints. An empty path leaves the pprof
+// endpoints unauthenticated, while an empty file is an error.
+//
+// The file is read once, hence a rotated token takes effect only after a
+// restart.
+func WithPprofBearerTokenFile(path string) Option {
+	opt := func(m *mgr) error {
+		if path == "" {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read pprof bearer token: %w", err)
+		}
+
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return errors.New("empty pprof bearer token specified")
+		}
+		m.pprofBearerToken = token
+
+		return nil
+	}
+
+	return opt
+}
+
 // WithRunnable is an [Option], which adds the given [manager.Runnable] to the
 // [manager.Manager].
 func WithRunnable(r manager.Runnable) Option {
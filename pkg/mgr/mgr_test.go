@@ -7,6 +7,9 @@ package mgr_test
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -75,6 +78,7 @@ var _ = Describe("Manager", Ordered, func() {
 			mgr.WithCacheOptions(cache.Options{HTTPClient: http.DefaultClient}),
 			mgr.WithLogger(logger),
 			mgr.WithPprofAddress(":7070"),
+			mgr.WithPprofBearerToken("s3cr3t"),
 			mgr.WithRunnable(testRunnable),
 		}
 
@@ -83,4 +87,48 @@ var _ = Describe("Manager", Ordered, func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(m).NotTo(BeNil())
 	})
+
+	It("should read the pprof bearer token from a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(path, []byte("s3cr3t\n"), 0o600)).To(Succeed())
+
+		m, err := mgr.New(mgr.WithConfig(cfg), mgr.WithPprofAddress(":7071"), mgr.WithPprofBearerTokenFile(path))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m).NotTo(BeNil())
+	})
+
+	It("should fail to read an empty or missing pprof bearer token file", func() {
+		dir := GinkgoT().TempDir()
+		path := filepath.Join(dir, "token")
+		Expect(os.WriteFile(path, []byte(" \n"), 0o600)).To(Succeed())
+
+		_, err := mgr.New(mgr.WithConfig(cfg), mgr.WithPprofBearerTokenFile(path))
+		Expect(err).To(MatchError("empty pprof bearer token specified"))
+
+		_, err = mgr.New(mgr.WithConfig(cfg), mgr.WithPprofBearerTokenFile(filepath.Join(dir, "missing")))
+		Expect(err).To(MatchError(ContainSubstring("failed to read pprof bearer token")))
+	})
+
+	It("should require a bearer token for the wrapped handler", func() {
+		okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := mgr.NewBearerTokenAuthHandler("s3cr3t", okHandler)
+
+		for header, wantCode := range map[string]int{
+			"":               http.StatusUnauthorized,
+			"s3cr3t":         http.StatusUnauthorized,
+			"Bearer invalid": http.StatusUnauthorized,
+			"Basic s3cr3t":   http.StatusUnauthorized,
+			"Bearer s3cr3t":  http.StatusOK,
+		} {
+			req := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			Expect(rec.Code).To(Equal(wantCode), "Authorization: %q", header)
+		}
+	})
 })
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mgr
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// pprofServerReadHeaderTimeout is the amount of time allowed to read the
+// request headers by the pprof server.
+const pprofServerReadHeaderTimeout = 32 * time.Second
+
+// NewBearerTokenAuthHandler returns an [http.Handler], which requires the
+// requests to provide the given bearer token via the Authorization header,
+// before handing them over to the next [http.Handler].
+func NewBearerTokenAuthHandler(token string, next http.Handler) http.Handler {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="pprof"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+
+	return http.HandlerFunc(handler)
+}
+
+// newPprofHandler returns an [http.Handler], which serves the pprof
+// endpoints.
+func newPprofHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	return mux
+}
+
+// newSecurePprofServer returns a [manager.Server], which serves the pprof
+// endpoints on the given address and requires the given bearer token for
+// authentication.
+func newSecurePprofServer(addr string, token string) *manager.Server {
+	srv := &manager.Server{
+		Name: "pprof",
+		Server: &http.Server{
+			Addr:              addr,
+			Handler:           NewBearerTokenAuthHandler(token, newPprofHandler()),
+			ReadHeaderTimeout: pprofServerReadHeaderTimeout,
+		},
+	}
+
+	return srv
+}